@@ -0,0 +1,75 @@
+// Command dedupe-venues merges the free-text locations of existing events into venues.
+//
+// Events without a venue that lie close together and have similar location names
+// ("Central Park Ct 3", "central park court #3", ...) are grouped; each group is linked
+// to a matching existing venue or to a new venue named after its most common spelling.
+// Without -apply it only prints the groups it found.
+//
+// Usage:
+//
+//	go run ./cmd/dedupe-venues [-radius 75] [-min-events 2] [-apply]
+package main
+
+import (
+	"backend/src/config"
+	"backend/src/services"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func main() {
+	radius := flag.Float64("radius", 75, "maximum distance in meters between locations of the same venue")
+	minEvents := flag.Int("min-events", 2, "minimum number of events for a new venue to be created")
+	apply := flag.Bool("apply", false, "create the venues and link the events (default is a dry run)")
+	flag.Parse()
+
+	if err := config.InitDatabase(); err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer config.CloseDatabase()
+	db := config.DB.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Warn)})
+
+	clusters, err := services.FindDuplicateLocations(db, *radius/1000, *minEvents)
+	if err != nil {
+		log.Fatalf("Failed to find duplicate locations: %v", err)
+	}
+	if len(clusters) == 0 {
+		fmt.Println("No duplicate locations found")
+		return
+	}
+
+	events := 0
+	for i := range clusters {
+		cluster := &clusters[i]
+		events += len(cluster.EventIDs)
+
+		target := "new venue"
+		if cluster.Venue != nil {
+			target = fmt.Sprintf("venue %d (%s)", cluster.Venue.ID, cluster.Venue.Name)
+		}
+		fmt.Printf("%q: %d events at %.6f,%.6f -> %s\n", cluster.Name, len(cluster.EventIDs), cluster.Latitude, cluster.Longitude, target)
+		if len(cluster.Spellings) > 1 {
+			fmt.Printf("    spellings: %s\n", strings.Join(cluster.Spellings, " | "))
+		}
+
+		if !*apply {
+			continue
+		}
+		venue, linked, err := services.MergeLocationCluster(db, cluster)
+		if err != nil {
+			log.Fatalf("Failed to merge %q: %v", cluster.Name, err)
+		}
+		fmt.Printf("    linked %d events to venue %d\n", linked, venue.ID)
+	}
+
+	if *apply {
+		fmt.Printf("Merged %d events into %d venues\n", events, len(clusters))
+	} else {
+		fmt.Printf("Found %d events in %d groups; run with -apply to merge them\n", events, len(clusters))
+	}
+}
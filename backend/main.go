@@ -74,7 +74,7 @@ func main() {
 	defer config.CloseDatabase()
 
 	// Run database migrations
-	if err := config.AutoMigrate(&models.User{}, &models.Follow{}, &models.Event{}, &models.EventParticipant{}, &models.Sport{}, &models.Post{}, &models.PostMention{}, &models.PostLike{}, &models.Comment{}, &models.Notification{}); err != nil {
+	if err := config.AutoMigrate(&models.User{}, &models.Follow{}, &models.FollowRequest{}, &models.UserBlock{}, &models.UserMute{}, &models.SuggestionDismissal{}, &models.Event{}, &models.EventParticipant{}, &models.EventWaitlistEntry{}, &models.EventSeries{}, &models.Venue{}, &models.VenuePhoto{}, &models.VenueResource{}, &models.VenueOpeningHours{}, &models.Tournament{}, &models.TournamentEntry{}, &models.TournamentEntryMember{}, &models.TournamentMatch{}, &models.League{}, &models.LeagueTeam{}, &models.LeagueTeamMember{}, &models.LeagueSeason{}, &models.LeagueFixture{}, &models.Team{}, &models.TeamMember{}, &models.TeamInvitation{}, &models.TeamJoinRequest{}, &models.Group{}, &models.GroupMember{}, &models.GroupJoinRequest{}, &models.Conversation{}, &models.DirectMessage{}, &models.EventMessage{}, &models.EventInvitation{}, &models.EventJoinRequest{}, &models.EventBan{}, &models.EventReminder{}, &models.CalendarFeedToken{}, &models.EventSide{}, &models.GameResult{}, &models.GameResultScore{}, &models.GameResultConfirmation{}, &models.SportRating{}, &models.SportRatingHistory{}, &models.Sport{}, &models.Post{}, &models.PostMention{}, &models.PostLike{}, &models.Comment{}, &models.Notification{}); err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
 
@@ -111,9 +111,19 @@ func main() {
 	searchController := controllers.NewSearchController()
 	followController := controllers.NewFollowController()
 	eventController := controllers.NewEventController()
+	eventSeriesController := controllers.NewEventSeriesController()
 	sportController := controllers.NewSportController()
 	postController := controllers.NewPostController()
 	notificationController := controllers.NewNotificationController()
+	calendarController := controllers.NewCalendarController()
+	venueController := controllers.NewVenueController()
+	tournamentController := controllers.NewTournamentController()
+	leagueController := controllers.NewLeagueController()
+	teamController := controllers.NewTeamController()
+	groupController := controllers.NewGroupController()
+	messageController := controllers.NewMessageController()
+	blockController := controllers.NewBlockController()
+	suggestionController := controllers.NewSuggestionController()
 
 	// Setup routes
 	routes.SetupAuthRoutes(r, authController)
@@ -121,10 +131,20 @@ func main() {
 	routes.SetupUploadRoutes(r, uploadController)
 	routes.SetupSearchRoutes(r, searchController)
 	routes.SetupFollowRoutes(r, followController)
+	routes.SetupBlockRoutes(r, blockController)
+	routes.SetupSuggestionRoutes(r, suggestionController)
 	routes.SetupEventRoutes(r, eventController)
+	routes.SetupEventSeriesRoutes(r, eventSeriesController)
 	routes.SetupSportRoutes(r, sportController)
 	routes.SetupPostRoutes(r, postController)
 	routes.SetupNotificationRoutes(r, notificationController)
+	routes.SetupCalendarRoutes(r, calendarController)
+	routes.SetupVenueRoutes(r, venueController)
+	routes.SetupTournamentRoutes(r, tournamentController)
+	routes.SetupLeagueRoutes(r, leagueController)
+	routes.SetupTeamRoutes(r, teamController)
+	routes.SetupGroupRoutes(r, groupController)
+	routes.SetupMessageRoutes(r, messageController)
 
 	// Debug: log registered post routes
 	for _, ri := range r.Routes() {
@@ -143,6 +163,14 @@ func main() {
 	statusUpdater := services.NewEventStatusUpdater()
 	statusUpdater.Start()
 
+	// Initialize and start the recurring series materializer
+	seriesMaterializer := services.NewEventSeriesMaterializer()
+	seriesMaterializer.Start()
+
+	// Initialize and start the event reminder scheduler
+	reminderScheduler := services.NewEventReminderScheduler()
+	reminderScheduler.Start()
+
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -160,5 +188,7 @@ func main() {
 
 	// Stop the event status updater service
 	statusUpdater.Stop()
+	seriesMaterializer.Stop()
+	reminderScheduler.Stop()
 	log.Println("Server exited")
 }
@@ -0,0 +1,262 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BlockController struct{}
+
+func NewBlockController() *BlockController {
+	return &BlockController{}
+}
+
+// BlockUser godoc
+// @Summary      Block a user
+// @Description  Block a user. Follows between you are removed; the user can no longer follow you, mention you, comment on your posts, join your events or message you, and you are hidden from each other's search and feeds.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID to block"
+// @Success      200 {object} types.BlockResponse "User blocked"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID or trying to block yourself"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "User not found"
+// @Router       /users/{id}/block [post]
+func (bc *BlockController) BlockUser(c *gin.Context) {
+	userID, targetID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.BlockUser(config.DB, userID, targetID); err != nil {
+		respondBlockError(c, err, "Failed to block user")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildBlockResponse(userID, targetID, "Successfully blocked user"))
+}
+
+// UnblockUser godoc
+// @Summary      Unblock a user
+// @Description  Lift a block. Follows removed by the block are not restored.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID to unblock"
+// @Success      200 {object} types.BlockResponse "User unblocked"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/{id}/block [delete]
+func (bc *BlockController) UnblockUser(c *gin.Context) {
+	userID, targetID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.UnblockUser(config.DB, userID, targetID); err != nil {
+		respondBlockError(c, err, "Failed to unblock user")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildBlockResponse(userID, targetID, "Successfully unblocked user"))
+}
+
+// MuteUser godoc
+// @Summary      Mute a user
+// @Description  Mute a user: their posts, events and notifications are hidden from you. They are not told and can still interact with you.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID to mute"
+// @Success      200 {object} types.BlockResponse "User muted"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID or trying to mute yourself"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "User not found"
+// @Router       /users/{id}/mute [post]
+func (bc *BlockController) MuteUser(c *gin.Context) {
+	userID, targetID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.MuteUser(config.DB, userID, targetID); err != nil {
+		respondBlockError(c, err, "Failed to mute user")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildBlockResponse(userID, targetID, "Successfully muted user"))
+}
+
+// UnmuteUser godoc
+// @Summary      Unmute a user
+// @Description  Lift a mute
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID to unmute"
+// @Success      200 {object} types.BlockResponse "User unmuted"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/{id}/mute [delete]
+func (bc *BlockController) UnmuteUser(c *gin.Context) {
+	userID, targetID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.UnmuteUser(config.DB, userID, targetID); err != nil {
+		respondBlockError(c, err, "Failed to unmute user")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildBlockResponse(userID, targetID, "Successfully unmuted user"))
+}
+
+// GetBlockedUsers godoc
+// @Summary      List blocked users
+// @Description  List the users the current user has blocked, most recent first
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.BlockedUserResponse "Blocked users"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/blocked [get]
+func (bc *BlockController) GetBlockedUsers(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var blocks []models.UserBlock
+	if err := config.DB.Preload("Blocked").Where("blocker_id = ?", userID).Order("created_at DESC").Find(&blocks).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch blocked users",
+		})
+		return
+	}
+
+	response := make([]types.BlockedUserResponse, 0, len(blocks))
+	for _, b := range blocks {
+		response = append(response, buildBlockedUserResponse(b.Blocked, b.CreatedAt))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMutedUsers godoc
+// @Summary      List muted users
+// @Description  List the users the current user has muted, most recent first
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.BlockedUserResponse "Muted users"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/muted [get]
+func (bc *BlockController) GetMutedUsers(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var mutes []models.UserMute
+	if err := config.DB.Preload("Muted").Where("muter_id = ?", userID).Order("created_at DESC").Find(&mutes).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch muted users",
+		})
+		return
+	}
+
+	response := make([]types.BlockedUserResponse, 0, len(mutes))
+	for _, m := range mutes {
+		response = append(response, buildBlockedUserResponse(m.Muted, m.CreatedAt))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// parseRelationTarget reads the current user and the user ID in the path
+func parseRelationTarget(c *gin.Context) (uint, uint, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return 0, 0, false
+	}
+
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return 0, 0, false
+	}
+
+	return userID.(uint), uint(targetID), true
+}
+
+// respondBlockError maps block and mute service errors to responses
+func respondBlockError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrCannotBlockSelf):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid Request",
+			Message: "You cannot block or mute yourself",
+		})
+	case errors.Is(err, services.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "User not found",
+			Message: "The requested user does not exist",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildBlockResponse reports the current block and mute state towards the target
+func buildBlockResponse(userID, targetID uint, message string) types.BlockResponse {
+	var blocked, muted int64
+	config.DB.Model(&models.UserBlock{}).Where("blocker_id = ? AND blocked_id = ?", userID, targetID).Count(&blocked)
+	config.DB.Model(&models.UserMute{}).Where("muter_id = ? AND muted_id = ?", userID, targetID).Count(&muted)
+	return types.BlockResponse{
+		Success:   true,
+		Message:   message,
+		IsBlocked: blocked > 0,
+		IsMuted:   muted > 0,
+	}
+}
+
+// buildBlockedUserResponse converts a blocked or muted user
+func buildBlockedUserResponse(user models.User, since time.Time) types.BlockedUserResponse {
+	return types.BlockedUserResponse{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", user.ID),
+		HasAvatar:   len(user.AvatarData) > 0,
+		Since:       since,
+	}
+}
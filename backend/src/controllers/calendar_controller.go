@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/types"
+	"backend/src/utils"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// calendarFeedLookback keeps recently finished activities in the feed so calendars don't drop them right away
+const calendarFeedLookback = 30 * 24 * time.Hour
+
+type CalendarController struct{}
+
+func NewCalendarController() *CalendarController {
+	return &CalendarController{}
+}
+
+// CreateFeedToken godoc
+// @Summary      Create calendar feed URL
+// @Description  Create a secret calendar feed URL for the current user. Any previous URL stops working.
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      201 {object} types.CalendarFeedResponse "Feed URL created"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /calendar/token [post]
+func (cc *CalendarController) CreateFeedToken(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Internal error",
+			Message: "Failed to generate feed token",
+		})
+		return
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	feedToken := models.CalendarFeedToken{
+		UserID:    userID.(uint),
+		TokenHash: hashFeedToken(token),
+	}
+
+	// Replace any existing token so old URLs are revoked
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", feedToken.UserID).Delete(&models.CalendarFeedToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&feedToken).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create feed token",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.CalendarFeedResponse{
+		Token:     token,
+		FeedURL:   fmt.Sprintf("/api/calendar/feed/%s.ics", token),
+		CreatedAt: feedToken.CreatedAt,
+	})
+}
+
+// RevokeFeedToken godoc
+// @Summary      Revoke calendar feed URL
+// @Description  Revoke the current user's calendar feed URL
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      204 "Feed URL revoked"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "No feed URL exists"
+// @Router       /calendar/token [delete]
+func (cc *CalendarController) RevokeFeedToken(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	result := config.DB.Where("user_id = ?", userID).Delete(&models.CalendarFeedToken{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to revoke feed token",
+		})
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not found",
+			Message: "No calendar feed exists",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetFeed godoc
+// @Summary      Calendar feed
+// @Description  iCalendar feed of the activities the token owner organizes or participates in, including cancellations.
+// @Description  Authenticated by the secret token in the URL instead of a JWT so calendar apps can subscribe to it.
+// @Tags         Calendar
+// @Produce      text/calendar
+// @Param        token path string true "Feed token (optionally suffixed with .ics)"
+// @Success      200 {string} string "iCalendar document"
+// @Failure      404 {object} types.ErrorResponse "Unknown or revoked token"
+// @Router       /calendar/feed/{token} [get]
+func (cc *CalendarController) GetFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	var feedToken models.CalendarFeedToken
+	if err := config.DB.Where("token_hash = ?", hashFeedToken(token)).First(&feedToken).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not found",
+			Message: "Unknown calendar feed",
+		})
+		return
+	}
+
+	now := time.Now()
+	config.DB.Model(&feedToken).Update("last_used_at", now)
+
+	// Cancelled events are soft-deleted, so read unscoped and keep them only if cancelled
+	participating := config.DB.Model(&models.EventParticipant{}).Select("event_id").Where("user_id = ?", feedToken.UserID)
+	var events []models.Event
+	if err := config.DB.Unscoped().
+		Where("organizer_id = ? OR id IN (?)", feedToken.UserID, participating).
+		Where("deleted_at IS NULL OR status = ?", types.EventStatusCancelled).
+		Where("start_at >= ?", now.Add(-calendarFeedLookback)).
+		Order("start_at ASC").
+		Find(&events).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch events",
+		})
+		return
+	}
+
+	items := make([]utils.ICalEvent, 0, len(events))
+	for _, event := range events {
+		items = append(items, eventToICal(event))
+	}
+
+	c.Header("Cache-Control", "private, max-age=900")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(utils.BuildICalendar("Link2Sport", items)))
+}
+
+// hashFeedToken returns the hex SHA-256 of a feed token, which is what gets stored
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// eventToICal maps an event to its calendar entry; events without an end time last one hour
+func eventToICal(event models.Event) utils.ICalEvent {
+	endAt := event.StartAt.Add(1 * time.Hour)
+	if event.EndAt != nil {
+		endAt = *event.EndAt
+	}
+
+	return utils.ICalEvent{
+		UID:          fmt.Sprintf("event-%d@link2sport", event.ID),
+		Title:        event.Title,
+		Description:  event.Description,
+		LocationName: event.LocationName,
+		Latitude:     event.Latitude,
+		Longitude:    event.Longitude,
+		StartAt:      event.StartAt,
+		EndAt:        endAt,
+		Cancelled:    event.Status == string(types.EventStatusCancelled),
+		CreatedAt:    event.CreatedAt,
+		UpdatedAt:    event.UpdatedAt,
+	}
+}
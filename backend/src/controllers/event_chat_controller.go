@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetEventMessages godoc
+// @Summary      Get event chat messages
+// @Description  Page through an event's chat, newest first (organizer and participants only). Pass next_cursor as before to load older messages.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        before query int false "Only messages older than this message ID"
+// @Param        limit query int false "Maximum number of messages" default(30)
+// @Success      200 {object} types.EventMessagePageResponse "Messages"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not in the event chat"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/messages [get]
+func (ec *EventController) GetEventMessages(c *gin.Context) {
+	event, ok := loadEventChat(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "30"))
+	if err != nil || limit <= 0 {
+		limit = 30
+	}
+	limit = min(limit, 100)
+
+	// Messages of blocked and muted users are left out
+	query := config.DB.Preload("Sender").Where("event_id = ?", event.ID).
+		Scopes(services.HiddenUsersScope(c.GetUint("userID"), "event_messages.sender_id"))
+	if before, err := strconv.ParseUint(c.Query("before"), 10, 32); err == nil {
+		query = query.Where("id < ?", before)
+	}
+
+	// Fetch one extra row to know whether there is an older page
+	var messages []models.EventMessage
+	if err := query.Order("id DESC").Limit(limit + 1).Find(&messages).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch messages",
+		})
+		return
+	}
+
+	page := types.EventMessagePageResponse{Messages: make([]types.EventMessageResponse, 0, min(len(messages), limit))}
+	if len(messages) > limit {
+		messages = messages[:limit]
+		page.NextCursor = &messages[limit-1].ID
+	}
+	for _, message := range messages {
+		page.Messages = append(page.Messages, buildEventMessageResponse(message))
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// SendEventMessage godoc
+// @Summary      Send an event chat message
+// @Description  Post in an event's chat (organizer and participants only). Members get it live over /notifications/stream as an event_message event.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        message body types.SendMessageRequest true "Message"
+// @Success      201 {object} types.EventMessageResponse "Message sent"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not in the event chat"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/messages [post]
+func (ec *EventController) SendEventMessage(c *gin.Context) {
+	event, ok := loadEventChat(c)
+	if !ok {
+		return
+	}
+
+	var req types.SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Message cannot be empty",
+		})
+		return
+	}
+
+	message, err := services.PostEventMessage(config.DB, &event, c.GetUint("userID"), body)
+	if err != nil {
+		if errors.Is(err, services.ErrNotEventChatMember) {
+			c.JSON(http.StatusForbidden, types.ErrorResponse{
+				Error:   "Forbidden",
+				Message: "Only the organizer and participants can use the event chat",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to send message",
+		})
+		return
+	}
+
+	config.DB.First(&message.Sender, message.SenderID)
+	c.JSON(http.StatusCreated, buildEventMessageResponse(*message))
+}
+
+// loadEventChat loads the event in the path and checks the current user is in its chat
+func loadEventChat(c *gin.Context) (models.Event, bool) {
+	var event models.Event
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return event, false
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return event, false
+	}
+
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil || !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return event, false
+	}
+
+	if !services.IsEventChatMember(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the organizer and participants can use the event chat",
+		})
+		return event, false
+	}
+
+	return event, true
+}
+
+// buildEventMessageResponse converts an event chat message with its Sender loaded
+func buildEventMessageResponse(message models.EventMessage) types.EventMessageResponse {
+	return types.EventMessageResponse{
+		ID:          message.ID,
+		EventID:     message.EventID,
+		SenderID:    message.SenderID,
+		Username:    message.Sender.Username,
+		DisplayName: message.Sender.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", message.SenderID),
+		Body:        message.Body,
+		CreatedAt:   message.CreatedAt,
+	}
+}
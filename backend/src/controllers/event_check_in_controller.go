@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenCheckIn godoc
+// @Summary      Open check-in
+// @Description  Generate a short-lived check-in code (and QR payload) for an event (by organizers, co-organizers and referees).
+// @Description  Available while the event is active or starts within 30 minutes. Generating a new code invalidates the previous one.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      201 {object} types.CheckInCodeResponse "Check-in code"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage check-in"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.ErrorResponse "Check-in cannot be opened now"
+// @Router       /events/{id}/check-in/code [post]
+func (ec *EventController) OpenCheckIn(c *gin.Context) {
+	event, ok := loadManagedEvent(c, types.ParticipantRoleReferee)
+	if !ok {
+		return
+	}
+
+	code, expiresAt, err := services.OpenCheckIn(config.DB, &event)
+	if err != nil {
+		respondCheckInError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.CheckInCodeResponse{
+		Code:      code,
+		QRPayload: fmt.Sprintf("link2sport://check-in?event=%d&code=%s", event.ID, code),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// CheckIn godoc
+// @Summary      Check in to an event
+// @Description  Check in as a participant with the code shown by the organizer while the event is active
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        body body types.CheckInRequest true "Check-in code"
+// @Success      200 {object} gin.H "Checked in"
+// @Failure      400 {object} types.ErrorResponse "Invalid or expired code"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.ErrorResponse "Check-in is not open"
+// @Failure      429 {object} types.ErrorResponse "Too many wrong codes, locked out for a while"
+// @Router       /events/{id}/check-in [post]
+func (ec *EventController) CheckIn(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var req types.CheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.Code) == "" {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "A check-in code is required",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	if err := services.CheckInWithCode(config.DB, &event, userID.(uint), strings.TrimSpace(req.Code)); err != nil {
+		respondCheckInError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Checked in",
+		"event_id": event.ID,
+	})
+}
+
+// SetParticipantCheckIn godoc
+// @Summary      Set participant attendance
+// @Description  Manually mark a participant as present or absent (by organizers, co-organizers and referees). Works while the event is active
+// @Description  and after it completed, so the roster can be corrected.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Participant user ID"
+// @Param        body body types.SetCheckInRequest true "Attendance"
+// @Success      200 {object} gin.H "Attendance updated"
+// @Failure      400 {object} types.ErrorResponse "Invalid request"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage check-in"
+// @Failure      404 {object} types.ErrorResponse "Event or participant not found"
+// @Failure      409 {object} types.ErrorResponse "Event has not started"
+// @Router       /events/{id}/participants/{userId}/check-in [put]
+func (ec *EventController) SetParticipantCheckIn(c *gin.Context) {
+	event, ok := loadManagedEvent(c, types.ParticipantRoleReferee)
+	if !ok {
+		return
+	}
+
+	participantID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	var req types.SetCheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := services.SetCheckedIn(config.DB, &event, uint(participantID), req.CheckedIn); err != nil {
+		respondCheckInError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Attendance updated",
+		"event_id":   event.ID,
+		"user_id":    participantID,
+		"checked_in": req.CheckedIn,
+	})
+}
+
+// loadManagedEvent loads the event in the path and checks the current user is its organizer,
+// a co-organizer or has one of the extra roles
+func loadManagedEvent(c *gin.Context, extraRoles ...types.ParticipantRole) (models.Event, bool) {
+	var event models.Event
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return event, false
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return event, false
+	}
+
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return event, false
+	}
+
+	roles := append([]types.ParticipantRole{types.ParticipantRoleOrganizer, types.ParticipantRoleCoOrganizer}, extraRoles...)
+	if !services.HasEventRole(config.DB, &event, userID.(uint), roles...) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You are not allowed to manage this event",
+		})
+		return event, false
+	}
+
+	return event, true
+}
+
+// respondCheckInError maps check-in service errors to responses
+func respondCheckInError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrCheckInClosed):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Check-in closed",
+			Message: "Check-in is not open for this event",
+		})
+	case errors.Is(err, services.ErrInvalidCheckInCode):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid code",
+			Message: "The check-in code is invalid or has expired",
+		})
+	case errors.Is(err, services.ErrCheckInLocked):
+		c.JSON(http.StatusTooManyRequests, types.ErrorResponse{
+			Error:   "Too many attempts",
+			Message: "Too many wrong check-in codes, try again in a few minutes",
+		})
+	case errors.Is(err, services.ErrNotParticipating):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not participating",
+			Message: "The user is not participating in this event",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to update check-in",
+		})
+	}
+}
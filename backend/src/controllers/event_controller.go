@@ -5,14 +5,19 @@ import (
 	"backend/src/models"
 	"backend/src/services"
 	"backend/src/types"
+	"backend/src/utils"
+	"errors"
 	"fmt"
+	"log"
+	"math"
 	"net/http"
 	"strconv"
-	"time"
 	"strings"
-	"math"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventController struct{}
@@ -50,7 +55,8 @@ func (ec *EventController) GetUserEventsByID(c *gin.Context) {
 	}
 
 	var events []models.Event
-	if err := config.DB.Preload("Organizer").Where("organizer_id = ? AND deleted_at IS NULL", uint(uid)).Order("start_at DESC").Find(&events).Error; err != nil {
+	// Only include the events the current user is allowed to see
+	if err := config.DB.Preload("Organizer").Scopes(services.VisibleEventsScope(userVal.(uint))).Where("organizer_id = ? AND deleted_at IS NULL", uint(uid)).Order("start_at DESC").Find(&events).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
 			Error:   "Database error",
 			Message: "Failed to fetch user events",
@@ -60,44 +66,8 @@ func (ec *EventController) GetUserEventsByID(c *gin.Context) {
 
 	response := make([]types.EventWithOrganizerResponse, 0, len(events))
 	for _, event := range events {
-		var participantCount int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
 		// Relative to current authenticated user
-		isOrganizer := event.OrganizerID == userVal.(uint)
-		var participantExists int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userVal).Count(&participantExists)
-		isParticipant := participantExists > 0
-
-		avatarURL := fmt.Sprintf("/api/user/%d/avatar", event.Organizer.ID)
-
-		eventResponse := types.EventWithOrganizerResponse{
-			EventResponse: types.EventResponse{
-				ID:           event.ID,
-				OrganizerID:  event.OrganizerID,
-				Type:         types.EventType(event.Type),
-				Title:        event.Title,
-				Description:  event.Description,
-				Sport:        event.Sport,
-				StartAt:      event.StartAt,
-				EndAt:        event.EndAt,
-				LocationName: event.LocationName,
-				Latitude:     *event.Latitude,
-				Longitude:    *event.Longitude,
-				Capacity:     event.Capacity,
-				Participants: int(participantCount),
-				Status:       types.EventStatus(event.Status),
-				CreatedAt:    event.CreatedAt,
-				UpdatedAt:    event.UpdatedAt,
-			},
-			OrganizerName:     event.Organizer.DisplayName,
-			OrganizerUsername: event.Organizer.Username,
-			OrganizerAvatar:   &avatarURL,
-			IsOrganizer:       isOrganizer,
-			IsParticipant:     isParticipant,
-		}
-
-		response = append(response, eventResponse)
+		response = append(response, buildEventWithOrganizerResponse(event, userVal.(uint)))
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -118,6 +88,7 @@ func NewEventController() *EventController {
 // @Success      201 {object} types.EventResponse "Event created successfully"
 // @Failure      400 {object} types.ErrorResponse "Invalid request data"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      409 {object} types.BookingConflictErrorResponse "The booked resource is taken or the venue is closed"
 // @Router       /events [post]
 func (ec *EventController) CreateEvent(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -138,54 +109,93 @@ func (ec *EventController) CreateEvent(c *gin.Context) {
 		return
 	}
 
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = types.EventVisibilityPublic
+	}
+	if !visibility.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Visibility must be one of public, followers or invite",
+		})
+		return
+	}
+
+	joinMode := req.JoinMode
+	if joinMode == "" {
+		joinMode = types.JoinModeOpen
+	}
+	if !joinMode.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Join mode must be one of open or approval",
+		})
+		return
+	}
+
+	// Only members can post events to a group
+	if req.GroupID != nil && !services.IsGroupMember(config.DB, *req.GroupID, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only group members can create events in this group",
+		})
+		return
+	}
 	// Calculate initial status based on event timing
 	initialStatus := types.CalculateEventStatus(req.StartAt, req.EndAt)
 
 	event := models.Event{
-		OrganizerID:  userID.(uint),
-		Type:         string(req.Type),
-		Title:        req.Title,
-		Description:  req.Description,
-		Sport:        req.Sport,
-		StartAt:      req.StartAt,
-		EndAt:        req.EndAt,
-		LocationName: req.LocationName,
-		Latitude:     &req.Latitude,
-		Longitude:    &req.Longitude,
-		Capacity:     req.Capacity,
-		Status:       string(initialStatus),
-	}
-
-	if err := config.DB.Create(&event).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
-			Error:   "Database error",
-			Message: "Failed to create event",
-		})
-		return
+		OrganizerID:    userID.(uint),
+		Type:           string(req.Type),
+		Title:          req.Title,
+		Description:    req.Description,
+		Sport:          req.Sport,
+		StartAt:        req.StartAt,
+		EndAt:          req.EndAt,
+		LocationName:   req.LocationName,
+		Latitude:       &req.Latitude,
+		Longitude:      &req.Longitude,
+		Capacity:       req.Capacity,
+		Status:         string(initialStatus),
+		Visibility:     string(visibility),
+		MinReliability: normalizeMinReliability(req.MinReliability),
+		JoinMode:       string(joinMode),
+		GroupID:        req.GroupID,
+	}
+
+	// Booking a resource implies its venue
+	if req.ResourceID != nil {
+		resource, err := services.GetVenueResource(config.DB, *req.ResourceID)
+		if err != nil {
+			respondEventVenueError(c, err)
+			return
+		}
+		if req.VenueID != nil && *req.VenueID != resource.VenueID {
+			respondResourceVenueMismatch(c)
+			return
+		}
+		req.VenueID = &resource.VenueID
+		event.ResourceID = &resource.ID
 	}
 
-	var participantCount int64
-	config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
+	if req.VenueID != nil {
+		if err := services.LinkEventToVenue(config.DB, &event, *req.VenueID); err != nil {
+			respondEventVenueError(c, err)
+			return
+		}
+	}
 
-	response := types.EventResponse{
-		ID:           event.ID,
-		OrganizerID:  event.OrganizerID,
-		Type:         types.EventType(event.Type),
-		Title:        event.Title,
-		Description:  event.Description,
-		Sport:        event.Sport,
-		StartAt:      event.StartAt,
-		EndAt:        event.EndAt,
-		LocationName: event.LocationName,
-		Latitude:     *event.Latitude,
-		Longitude:    *event.Longitude,
-		Capacity:     event.Capacity,
-		Participants: int(participantCount),
-		Status:       types.EventStatus(event.Status),
-		CreatedAt:    event.CreatedAt,
-		UpdatedAt:    event.UpdatedAt,
+	conflicts, err := saveEventBooking(&event, req.AllowConflicts, func(tx *gorm.DB) error {
+		return tx.Create(&event).Error
+	})
+	if err != nil {
+		respondEventSaveError(c, err, conflicts, "Failed to create event")
+		return
 	}
 
+	response := buildEventResponse(event)
+	response.Conflicts = conflicts
+
 	c.JSON(http.StatusCreated, response)
 }
 
@@ -199,12 +209,15 @@ func (ec *EventController) CreateEvent(c *gin.Context) {
 // @Param        sport query string false "Filter by sport"
 // @Param        type query string false "Filter by type (game, event, training)"
 // @Param        location query string false "Filter by location"
-// @Param        scope query string false "Filter by scope (all, following)"
+// @Param        venue_id query int false "Only events at this venue"
+// @Param        scope query string false "Filter by scope (all, following, group:<id>)"
 // @Param        limit query int false "Limit number of results" default(20)
 // @Param        offset query int false "Offset for pagination" default(0)
-// @Param        lat query float false "Latitude for geospatial filtering"
-// @Param        lng query float false "Longitude for geospatial filtering"
-// @Param        radius_km query float false "Radius in kilometers for geospatial filtering"
+// @Param        lat query float false "Latitude of the reference point; results then include distance_km"
+// @Param        lng query float false "Longitude of the reference point"
+// @Param        radius_km query float false "Only events within this great-circle distance (km) of lat/lng"
+// @Param        sort query string false "Sort order: start_at (default, newest first) or distance (nearest first, requires lat/lng)"
+// @Param        rating_range query int false "Only events whose average player rating (organizer and participants) is within this many points of the current user's rating in the event's sport"
 // @Success      200 {array} types.EventWithOrganizerResponse "List of events"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
 // @Router       /events [get]
@@ -218,106 +231,42 @@ func (ec *EventController) GetEvents(c *gin.Context) {
 		return
 	}
 
-	sport := c.Query("sport")
-	eventType := c.Query("type")
-	location := c.Query("location")
-	scope := c.DefaultQuery("scope", "all")
-	latStr := c.Query("lat")
-	lngStr := c.Query("lng")
-	radiusStr := c.Query("radius_km")
-	statusParam := c.Query("status")
-	startAfterStr := c.Query("start_after")
-	startBeforeStr := c.Query("start_before")
-	minCapacityStr := c.Query("min_capacity")
-	maxCapacityStr := c.Query("max_capacity")
+	sortParam := c.Query("sort")
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
-	query := config.DB.Preload("Organizer").Where("deleted_at IS NULL")
-	if sport != "" {
-		query = query.Where("sport = ?", sport)
-	}
-	if eventType != "" {
-		query = query.Where("type = ?", eventType)
-	}
-	if location != "" {
-		// Case-insensitive contains match on location_name
-		query = query.Where("LOWER(location_name) LIKE ?", "%"+strings.ToLower(location)+"%")
-	}
-	if scope == "following" {
-		// Only events organized by users the current user follows
-		sub := config.DB.Model(&models.Follow{}).Select("followed_id").Where("follower_id = ?", userID)
-		query = query.Where("organizer_id IN (?)", sub)
-	}
-
-	// Status filter (supports comma-separated list)
-	if statusParam != "" {
-		parts := strings.Split(statusParam, ",")
-		statuses := make([]string, 0, len(parts))
-		for _, p := range parts {
-			s := strings.TrimSpace(strings.ToLower(p))
-			if s == "upcoming" || s == "active" || s == "complete" || s == "cancelled" {
-				statuses = append(statuses, s)
-			}
-		}
-		if len(statuses) > 0 {
-			query = query.Where("status IN (?)", statuses)
-		}
-	}
+	query := config.DB.Preload("Organizer").Where("deleted_at IS NULL").Scopes(services.VisibleEventsScope(userID.(uint)), eventFiltersScope(c, userID.(uint)))
 
-	// Date range filters (RFC3339)
-	if startAfterStr != "" {
-		if t, err := time.Parse(time.RFC3339, startAfterStr); err == nil {
-			query = query.Where("start_at >= ?", t)
-		}
-	}
-	if startBeforeStr != "" {
-		if t, err := time.Parse(time.RFC3339, startBeforeStr); err == nil {
-			query = query.Where("start_at <= ?", t)
-		}
-	}
-
-	// Capacity filters (only consider events that define capacity)
-	if minCapacityStr != "" {
-		if v, err := strconv.Atoi(minCapacityStr); err == nil {
-			query = query.Where("capacity IS NOT NULL AND capacity >= ?", v)
-		}
-	}
-	if maxCapacityStr != "" {
-		if v, err := strconv.Atoi(maxCapacityStr); err == nil {
-			query = query.Where("capacity IS NOT NULL AND capacity <= ?", v)
-		}
+	// Great-circle distance from a reference point, optionally limited to a radius
+	origin, ok := parseEventOrigin(c)
+	if !ok {
+		return
 	}
+	query = query.Scopes(eventRadiusScope(origin, c.Query("radius_km")))
 
-	// Geospatial bounding box filtering if lat/lng/radius provided
-	if latStr != "" && lngStr != "" && radiusStr != "" {
-		if lat, err1 := strconv.ParseFloat(latStr, 64); err1 == nil {
-			if lng, err2 := strconv.ParseFloat(lngStr, 64); err2 == nil {
-				if radiusKm, err3 := strconv.ParseFloat(radiusStr, 64); err3 == nil && radiusKm > 0 {
-					// Approximate deltas (Earth ~ 6371km). 1 deg lat ~= 111.32 km
-					latDelta := radiusKm / 111.32
-					// Avoid division by zero at poles
-					cosLat := math.Cos(lat * math.Pi / 180)
-					if cosLat < 1e-6 {
-						cosLat = 1e-6
-					}
-					lngDelta := radiusKm / (111.32 * cosLat)
-
-					minLat := lat - latDelta
-					maxLat := lat + latDelta
-					minLng := lng - lngDelta
-					maxLng := lng + lngDelta
-
-					// Basic bounding box; note: does not handle antimeridian wrap
-					query = query.Where("latitude BETWEEN ? AND ?", minLat, maxLat)
-					query = query.Where("longitude BETWEEN ? AND ?", minLng, maxLng)
-				}
-			}
+	distanceSQL := utils.HaversineSQL("latitude", "longitude")
+	switch sortParam {
+	case "distance":
+		if origin == nil {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "Sorting by distance requires lat and lng",
+			})
+			return
 		}
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{SQL: distanceSQL + " ASC, start_at ASC", Vars: []any{origin[0], origin[0], origin[1]}}})
+	case "", "start_at":
+		query = query.Order("start_at DESC")
+	default:
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Sort must be one of start_at or distance",
+		})
+		return
 	}
 
 	var events []models.Event
-	if err := query.Limit(limit).Offset(offset).Order("start_at DESC").Find(&events).Error; err != nil {
+	if err := query.Limit(limit).Offset(offset).Find(&events).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
 			Error:   "Database error",
 			Message: "Failed to fetch events",
@@ -327,43 +276,12 @@ func (ec *EventController) GetEvents(c *gin.Context) {
 
 	response := make([]types.EventWithOrganizerResponse, 0)
 	for _, event := range events {
-		var participantCount int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
-		isOrganizer := event.OrganizerID == userID.(uint)
-
-		var participantExists int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&participantExists)
-		isParticipant := participantExists > 0
-		avatarURL := fmt.Sprintf("/api/user/%d/avatar", event.Organizer.ID)
-
-		eventResponse := types.EventWithOrganizerResponse{
-			EventResponse: types.EventResponse{
-				ID:           event.ID,
-				OrganizerID:  event.OrganizerID,
-				Type:         types.EventType(event.Type),
-				Title:        event.Title,
-				Description:  event.Description,
-				Sport:        event.Sport,
-				StartAt:      event.StartAt,
-				EndAt:        event.EndAt,
-				LocationName: event.LocationName,
-				Latitude:     *event.Latitude,
-				Longitude:    *event.Longitude,
-				Capacity:     event.Capacity,
-				Participants: int(participantCount),
-				Status:       types.EventStatus(event.Status),
-				CreatedAt:    event.CreatedAt,
-				UpdatedAt:    event.UpdatedAt,
-			},
-			OrganizerName:     event.Organizer.DisplayName,
-			OrganizerUsername: event.Organizer.Username,
-			OrganizerAvatar:   &avatarURL,
-			IsOrganizer:       isOrganizer,
-			IsParticipant:     isParticipant,
+		item := buildEventWithOrganizerResponse(event, userID.(uint))
+		if origin != nil && event.Latitude != nil && event.Longitude != nil {
+			distance := math.Round(utils.HaversineKm(origin[0], origin[1], *event.Latitude, *event.Longitude)*100) / 100
+			item.DistanceKm = &distance
 		}
-
-		response = append(response, eventResponse)
+		response = append(response, item)
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -400,29 +318,7 @@ func (ec *EventController) GetUserEvents(c *gin.Context) {
 
 	response := make([]types.EventResponse, 0)
 	for _, event := range events {
-		var participantCount int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
-		eventResponse := types.EventResponse{
-			ID:           event.ID,
-			OrganizerID:  event.OrganizerID,
-			Type:         types.EventType(event.Type),
-			Title:        event.Title,
-			Description:  event.Description,
-			Sport:        event.Sport,
-			StartAt:      event.StartAt,
-			EndAt:        event.EndAt,
-			LocationName: event.LocationName,
-			Latitude:     *event.Latitude,
-			Longitude:    *event.Longitude,
-			Capacity:     event.Capacity,
-			Participants: int(participantCount),
-			Status:       types.EventStatus(event.Status),
-			CreatedAt:    event.CreatedAt,
-			UpdatedAt:    event.UpdatedAt,
-		}
-
-		response = append(response, eventResponse)
+		response = append(response, buildEventResponse(event))
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -469,44 +365,19 @@ func (ec *EventController) GetEvent(c *gin.Context) {
 		return
 	}
 
-	// Get participant count
-	var participantCount int64
-	config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
-	// Check if user is organizer
-	isOrganizer := event.OrganizerID == userID.(uint)
-
-	// Check if user is participant
-	var participantExists int64
-	config.DB.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&participantExists)
-	isParticipant := participantExists > 0
-
-	avatarURL := fmt.Sprintf("/api/user/%d/avatar", event.Organizer.ID)
+	// Private events are reported as missing to users who may not see them
+	if !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
 
-	response := types.EventWithOrganizerResponse{
-		EventResponse: types.EventResponse{
-			ID:           event.ID,
-			OrganizerID:  event.OrganizerID,
-			Type:         types.EventType(event.Type),
-			Title:        event.Title,
-			Description:  event.Description,
-			Sport:        event.Sport,
-			StartAt:      event.StartAt,
-			EndAt:        event.EndAt,
-			LocationName: event.LocationName,
-			Latitude:     *event.Latitude,
-			Longitude:    *event.Longitude,
-			Capacity:     event.Capacity,
-			Participants: int(participantCount),
-			Status:       types.EventStatus(event.Status),
-			CreatedAt:    event.CreatedAt,
-			UpdatedAt:    event.UpdatedAt,
-		},
-		OrganizerName:     event.Organizer.DisplayName,
-		OrganizerUsername: event.Organizer.Username,
-		OrganizerAvatar:   &avatarURL,
-		IsOrganizer:       isOrganizer,
-		IsParticipant:     isParticipant,
+	response := buildEventWithOrganizerResponse(event, userID.(uint))
+	if event.Type == string(types.EventTypeGame) {
+		response.Sides = buildEventSides(event.ID)
+		response.Result = buildGameResultResponse(event.ID)
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -514,7 +385,7 @@ func (ec *EventController) GetEvent(c *gin.Context) {
 
 // UpdateEvent godoc
 // @Summary      Update event
-// @Description  Update an existing event (only by organizer)
+// @Description  Update an existing event (by organizers and co-organizers)
 // @Tags         Events
 // @Accept       json
 // @Produce      json
@@ -526,6 +397,7 @@ func (ec *EventController) GetEvent(c *gin.Context) {
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
 // @Failure      403 {object} types.ErrorResponse "Not authorized to update this event"
 // @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.BookingConflictErrorResponse "The booked resource is taken or the venue is closed"
 // @Router       /events/{id} [put]
 func (ec *EventController) UpdateEvent(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -556,11 +428,11 @@ func (ec *EventController) UpdateEvent(c *gin.Context) {
 		return
 	}
 
-	// Check if user is the organizer
-	if event.OrganizerID != userID.(uint) {
+	// Check if user is the organizer or a co-organizer
+	if !services.CanManageEvent(config.DB, &event, userID.(uint)) {
 		c.JSON(http.StatusForbidden, types.ErrorResponse{
 			Error:   "Forbidden",
-			Message: "You can only update your own events",
+			Message: "You can only update events you organize",
 		})
 		return
 	}
@@ -574,6 +446,10 @@ func (ec *EventController) UpdateEvent(c *gin.Context) {
 		return
 	}
 
+	if !resolveUpdateResource(c, &req) || !resolveUpdateVenue(c, &req) {
+		return
+	}
+
 	// Update fields if provided
 	if req.Type != nil {
 		event.Type = string(*req.Type)
@@ -602,19 +478,45 @@ func (ec *EventController) UpdateEvent(c *gin.Context) {
 	if req.Longitude != nil {
 		event.Longitude = req.Longitude
 	}
+	previousVenueID := event.VenueID
+	event.VenueID = updatedVenueID(event.VenueID, &req)
+	event.ResourceID = updatedResourceID(event.ResourceID, previousVenueID, event.VenueID, &req)
 	if req.Capacity != nil {
 		event.Capacity = req.Capacity
 	}
+	if req.Visibility != nil {
+		if !req.Visibility.IsValid() {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "Visibility must be one of public, followers or invite",
+			})
+			return
+		}
+		event.Visibility = string(*req.Visibility)
+	}
+	if req.MinReliability != nil {
+		event.MinReliability = normalizeMinReliability(req.MinReliability)
+	}
+	if req.JoinMode != nil {
+		if !req.JoinMode.IsValid() {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "Join mode must be one of open or approval",
+			})
+			return
+		}
+		event.JoinMode = string(*req.JoinMode)
+	}
 
 	// Recalculate status based on updated times (don't allow manual status changes)
 	updatedStatus := types.CalculateEventStatus(event.StartAt, event.EndAt)
 	event.Status = string(updatedStatus)
 
-	if err := config.DB.Save(&event).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
-			Error:   "Database error",
-			Message: "Failed to update event",
-		})
+	conflicts, err := saveEventBooking(&event, req.AllowConflicts, func(tx *gorm.DB) error {
+		return tx.Save(&event).Error
+	})
+	if err != nil {
+		respondEventSaveError(c, err, conflicts, "Failed to update event")
 		return
 	}
 
@@ -636,29 +538,16 @@ func (ec *EventController) UpdateEvent(c *gin.Context) {
 		}
 	}
 
-	// Get participant count
-	var participantCount int64
-	config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
-	response := types.EventResponse{
-		ID:           event.ID,
-		OrganizerID:  event.OrganizerID,
-		Type:         types.EventType(event.Type),
-		Title:        event.Title,
-		Description:  event.Description,
-		Sport:        event.Sport,
-		StartAt:      event.StartAt,
-		EndAt:        event.EndAt,
-		LocationName: event.LocationName,
-		Latitude:     *event.Latitude,
-		Longitude:    *event.Longitude,
-		Capacity:     event.Capacity,
-		Participants: int(participantCount),
-		Status:       types.EventStatus(event.Status),
-		CreatedAt:    event.CreatedAt,
-		UpdatedAt:    event.UpdatedAt,
+	// A raised capacity frees spots for waitlisted users
+	if req.Capacity != nil {
+		if _, err := services.PromoteFromWaitlist(config.DB, event.ID); err != nil {
+			log.Printf("Failed to promote waitlisted users for event %d: %v", event.ID, err)
+		}
 	}
 
+	response := buildEventResponse(event)
+	response.Conflicts = conflicts
+
 	c.JSON(http.StatusOK, response)
 }
 
@@ -741,6 +630,24 @@ func (ec *EventController) DeleteEvent(c *gin.Context) {
 		}
 	}
 
+	// Waitlisted users are told too, then the queue is dropped
+	var waitlist []models.EventWaitlistEntry
+	if err := config.DB.Where("event_id = ?", event.ID).Find(&waitlist).Error; err == nil {
+		for _, w := range waitlist {
+			payload := types.JSON{
+				"title":       "Activity cancelled",
+				"body":        event.Title,
+				"target_type": "activity",
+				"target_id":   fmt.Sprintf("%d", event.ID),
+			}
+			notif := models.Notification{UserID: w.UserID, ActorID: &event.OrganizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+			if err := config.DB.Create(&notif).Error; err == nil {
+				services.GetNotificationHub().Publish(notif)
+			}
+		}
+		config.DB.Where("event_id = ?", event.ID).Delete(&models.EventWaitlistEntry{})
+	}
+
 	if err := config.DB.Delete(&event).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
 			Error:   "Database error",
@@ -754,17 +661,22 @@ func (ec *EventController) DeleteEvent(c *gin.Context) {
 
 // JoinEvent godoc
 // @Summary      Join an event
-// @Description  Join an event as a participant
+// @Description  Join an event as a participant. If the event is full the user is added to its waitlist instead.
+// @Description  Approval-required events create a pending request the organizer accepts or rejects, unless the user was invited.
+// @Description  With team_id, a captain signs up the whole team at once: everyone who can join gets a spot, or nobody does if the event can't take them all.
 // @Tags         Events
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "Event ID"
+// @Param        team_id query int false "Team to sign up (captains only)"
 // @Success      201 {object} gin.H "Successfully joined event"
+// @Success      202 {object} gin.H "Event full, added to waitlist, or join request sent"
 // @Failure      400 {object} types.ErrorResponse "Invalid request or already joined"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Banned, reliability too low or join request rejected"
 // @Failure      404 {object} types.ErrorResponse "Event not found"
-// @Failure      409 {object} types.ErrorResponse "Event is full or cannot join"
+// @Failure      409 {object} types.ErrorResponse "Event cannot be joined"
 // @Router       /events/{id}/join [post]
 func (ec *EventController) JoinEvent(c *gin.Context) {
 	userID, exists := c.Get("userID")
@@ -795,6 +707,21 @@ func (ec *EventController) JoinEvent(c *gin.Context) {
 		return
 	}
 
+	// Invite-only and followers-only events can only be joined by those who can see them
+	if !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	// Captains can sign up their whole team at once; the organizer's teammates may join too
+	if c.Query("team_id") != "" {
+		joinEventAsTeam(c, &event, userID.(uint))
+		return
+	}
+
 	// Check if user is the organizer
 	if event.OrganizerID == userID.(uint) {
 		c.JSON(http.StatusBadRequest, types.ErrorResponse{
@@ -813,41 +740,76 @@ func (ec *EventController) JoinEvent(c *gin.Context) {
 		return
 	}
 
-	// Check if already joined
-	var existingParticipant models.EventParticipant
-	if err := config.DB.Where("event_id = ? AND user_id = ?", eventIDInt, userID).First(&existingParticipant).Error; err == nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{
-			Error:   "Already joined",
-			Message: "You are already participating in this event",
+	// Organizers can keep out users who often don't show up; users without a tracked history may join
+	if event.MinReliability != nil {
+		if reliability, _ := services.ReliabilityFor(config.DB, userID.(uint)); reliability != nil && *reliability < *event.MinReliability {
+			c.JSON(http.StatusForbidden, types.ErrorResponse{
+				Error:   "Reliability too low",
+				Message: fmt.Sprintf("This event requires an attendance reliability of at least %d%%", *event.MinReliability),
+			})
+			return
+		}
+	}
+
+	if services.IsBannedFromEvent(config.DB, event.ID, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Banned",
+			Message: "You have been removed from this event and cannot join it again",
 		})
 		return
 	}
 
-	// Check capacity if it exists
-	if event.Capacity != nil {
-		var currentParticipants int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", eventIDInt).Count(&currentParticipants)
-		if currentParticipants >= int64(*event.Capacity) {
-			c.JSON(http.StatusConflict, types.ErrorResponse{
-				Error:   "Event full",
-				Message: "This event has reached its maximum capacity",
+	// Approval-required events take a request the organizer answers; invited users skip the queue
+	if event.JoinMode == string(types.JoinModeApproval) {
+		invitation := services.InvitationStatusFor(config.DB, event.ID, userID.(uint))
+		if invitation == nil || *invitation == types.InvitationStatusDeclined {
+			request, err := services.RequestToJoin(config.DB, &event, userID.(uint))
+			if err != nil {
+				respondJoinRequestError(c, err)
+				return
+			}
+			c.JSON(http.StatusAccepted, gin.H{
+				"message":    "Your request to join has been sent to the organizer",
+				"event_id":   eventIDInt,
+				"status":     request.Status,
+				"waitlisted": false,
 			})
 			return
 		}
 	}
 
-	// Create participation record
-	participant := models.EventParticipant{
-		EventID:  uint(eventIDInt),
-		UserID:   userID.(uint),
-		Role:     "participant",
-		JoinedAt: time.Now(),
+	// Join, or queue up on the waitlist if the event is full
+	participant, waitlistEntry, err := services.JoinOrWaitlist(config.DB, uint(eventIDInt), userID.(uint))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAlreadyParticipating):
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Already joined",
+				Message: "You are already participating in this event",
+			})
+		case errors.Is(err, services.ErrAlreadyWaitlisted):
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Already waitlisted",
+				Message: "You are already on the waitlist for this event",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to join event",
+			})
+		}
+		return
 	}
 
-	if err := config.DB.Create(&participant).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
-			Error:   "Database error",
-			Message: "Failed to join event",
+	// Joining directly also answers a pending invitation
+	markInvitationAccepted(event.ID, userID.(uint))
+
+	if waitlistEntry != nil {
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":           "Event is full, you have been added to the waitlist",
+			"event_id":          eventIDInt,
+			"waitlisted":        true,
+			"waitlist_position": waitlistEntry.Position,
 		})
 		return
 	}
@@ -868,15 +830,23 @@ func (ec *EventController) JoinEvent(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
-		"message":  "Successfully joined event",
-		"event_id": eventIDInt,
+		"message":        "Successfully joined event",
+		"event_id":       eventIDInt,
+		"participant_id": participant.ID,
+		"waitlisted":     false,
 	})
 }
+
+// LeaveEvent godoc
+// @Summary      Leave an event
+// @Description  Leave an event or its waitlist, or withdraw a pending join request. Freed spots are given to the next waitlisted user.
+// @Description  With team_id, a captain withdraws every player the team signed up.
 // @Tags         Events
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "Event ID"
+// @Param        team_id query int false "Team to withdraw (captains only)"
 // @Success      200 {object} gin.H "Successfully left event"
 // @Failure      400 {object} types.ErrorResponse "Invalid request or not participating"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
@@ -911,18 +881,50 @@ func (ec *EventController) LeaveEvent(c *gin.Context) {
 		return
 	}
 
-	// Find participation record
-	var participant models.EventParticipant
-	if err := config.DB.Where("event_id = ? AND user_id = ?", eventIDInt, userID).First(&participant).Error; err != nil {
-		c.JSON(http.StatusBadRequest, types.ErrorResponse{
-			Error:   "Not participating",
-			Message: "You are not participating in this event",
+	if c.Query("team_id") != "" {
+		leaveEventAsTeam(c, &event, userID.(uint))
+		return
+	}
+
+	// Withdrawing a pending request to join
+	if err := services.CancelJoinRequest(config.DB, event.ID, userID.(uint)); err == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"message":  "Successfully withdrew join request",
+			"event_id": eventIDInt,
+		})
+		return
+	} else if !errors.Is(err, services.ErrNoJoinRequest) {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to withdraw join request",
 		})
 		return
 	}
 
-	// Delete participation record
-	if err := config.DB.Delete(&participant).Error; err != nil {
+	// Leaving the waitlist only needs the queue to be compacted
+	if err := services.LeaveWaitlist(config.DB, event.ID, userID.(uint)); err == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"message":  "Successfully left waitlist",
+			"event_id": eventIDInt,
+		})
+		return
+	} else if !errors.Is(err, services.ErrNotWaitlisted) {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to leave waitlist",
+		})
+		return
+	}
+
+	// Delete participation record and hand the spot to the next in line
+	if _, err := services.RemoveParticipant(config.DB, event.ID, userID.(uint)); err != nil {
+		if errors.Is(err, services.ErrNotParticipating) {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Not participating",
+				Message: "You are not participating in this event",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
 			Error:   "Database error",
 			Message: "Failed to leave event",
@@ -938,18 +940,20 @@ func (ec *EventController) LeaveEvent(c *gin.Context) {
 
 // GetEventParticipants godoc
 // @Summary      Get event participants
-// @Description  Get list of participants for an event
+// @Description  Get list of participants for an event. Organizers and co-organizers also see pending and rejected
+// @Description  join requests of approval-required events; other users only see their own request.
 // @Tags         Events
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "Event ID"
-// @Success      200 {array} models.EventParticipant "List of participants"
+// @Param        status query string false "Filter by status (pending, accepted, rejected)"
+// @Success      200 {array} types.EventParticipantResponse "List of participants and join requests"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
 // @Failure      404 {object} types.ErrorResponse "Event not found"
 // @Router       /events/{id}/participants [get]
 func (ec *EventController) GetEventParticipants(c *gin.Context) {
-	_, exists := c.Get("userID")
+	userID, exists := c.Get("userID")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
 			Error:   "Unauthorized",
@@ -978,16 +982,182 @@ func (ec *EventController) GetEventParticipants(c *gin.Context) {
 		return
 	}
 
-	var participants []models.EventParticipant
-	if err := config.DB.Preload("User").Where("event_id = ?", eventIDInt).Find(&participants).Error; err != nil {
+	if !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	status := types.ParticipationStatus(c.Query("status"))
+	if status != "" && status != types.ParticipationStatusPending && status != types.ParticipationStatusAccepted && status != types.ParticipationStatusRejected {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Status must be one of pending, accepted or rejected",
+		})
+		return
+	}
+
+	response := make([]types.EventParticipantResponse, 0)
+
+	if status == "" || status == types.ParticipationStatusAccepted {
+		var participants []models.EventParticipant
+		if err := config.DB.Preload("User").Where("event_id = ?", eventIDInt).Order("joined_at ASC").Find(&participants).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to fetch participants",
+			})
+			return
+		}
+		for _, p := range participants {
+			response = append(response, buildEventParticipantResponse(p))
+		}
+	}
+
+	// Open join requests are only shown to those who manage the event, and to the requester
+	if status != types.ParticipationStatusAccepted {
+		query := config.DB.Preload("User").Where("event_id = ? AND status <> ?", event.ID, types.ParticipationStatusAccepted)
+		if status != "" {
+			query = query.Where("status = ?", status)
+		}
+		if !services.CanManageEvent(config.DB, &event, userID.(uint)) {
+			query = query.Where("user_id = ?", userID)
+		}
+
+		var requests []models.EventJoinRequest
+		if err := query.Order("created_at ASC").Find(&requests).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to fetch join requests",
+			})
+			return
+		}
+		for _, r := range requests {
+			response = append(response, buildJoinRequestResponse(r))
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventWaitlist godoc
+// @Summary      Get event waitlist
+// @Description  Get the ordered waitlist of a full event
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {array} types.WaitlistEntryResponse "Waitlisted users in queue order"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/waitlist [get]
+func (ec *EventController) GetEventWaitlist(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	eventID := c.Param("id")
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	if !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	var entries []models.EventWaitlistEntry
+	if err := config.DB.Preload("User").Where("event_id = ?", event.ID).Order("position ASC").Find(&entries).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
 			Error:   "Database error",
-			Message: "Failed to fetch participants",
+			Message: "Failed to fetch waitlist",
+		})
+		return
+	}
+
+	response := make([]types.WaitlistEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		response = append(response, types.WaitlistEntryResponse{
+			UserID:       e.UserID,
+			Username:     e.User.Username,
+			DisplayName:  e.User.DisplayName,
+			AvatarURL:    fmt.Sprintf("/api/user/%d/avatar", e.UserID),
+			Position:     e.Position,
+			WaitingSince: e.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventICS godoc
+// @Summary      Export event to calendar
+// @Description  Download a single event as an iCalendar (.ics) file
+// @Tags         Events
+// @Produce      text/calendar
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {string} string "iCalendar document"
+// @Failure      400 {object} types.ErrorResponse "Invalid event ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/ics [get]
+func (ec *EventController) GetEventICS(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
 		})
 		return
 	}
 
-	c.JSON(http.StatusOK, participants)
+	eventID := c.Param("id")
+	eventIDInt, err := strconv.ParseUint(eventID, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil || !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	ics := utils.BuildICalendar("", []utils.ICalEvent{eventToICal(event)})
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%d.ics\"", event.ID))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(ics))
 }
 
 // UpdateEventStatuses manually triggers event status updates
@@ -1057,30 +1227,385 @@ func (ec *EventController) GetEventsNeedingUpdate(c *gin.Context) {
 	// Convert to response format
 	var eventResponses []types.EventResponse
 	for _, event := range events {
-		// Get participant count
-		var participantCount int64
-		config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
-
-		eventResponse := types.EventResponse{
-			ID:           event.ID,
-			OrganizerID:  event.OrganizerID,
-			Type:         types.EventType(event.Type),
-			Title:        event.Title,
-			Description:  event.Description,
-			Sport:        event.Sport,
-			StartAt:      event.StartAt,
-			EndAt:        event.EndAt,
-			LocationName: event.LocationName,
-			Latitude:     *event.Latitude,
-			Longitude:    *event.Longitude,
-			Capacity:     event.Capacity,
-			Participants: int(participantCount),
-			Status:       types.EventStatus(event.Status),
-			CreatedAt:    event.CreatedAt,
-			UpdatedAt:    event.UpdatedAt,
-		}
-		eventResponses = append(eventResponses, eventResponse)
+		eventResponses = append(eventResponses, buildEventResponse(event))
 	}
 
 	c.JSON(http.StatusOK, eventResponses)
 }
+
+// buildEventResponse converts an event model into its API representation
+func buildEventResponse(event models.Event) types.EventResponse {
+	var participantCount int64
+	config.DB.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&participantCount)
+
+	var waitlistCount int64
+	config.DB.Model(&models.EventWaitlistEntry{}).Where("event_id = ?", event.ID).Count(&waitlistCount)
+
+	return types.EventResponse{
+		ID:             event.ID,
+		OrganizerID:    event.OrganizerID,
+		Type:           types.EventType(event.Type),
+		Title:          event.Title,
+		Description:    event.Description,
+		Sport:          event.Sport,
+		StartAt:        event.StartAt,
+		EndAt:          event.EndAt,
+		LocationName:   event.LocationName,
+		Latitude:       *event.Latitude,
+		Longitude:      *event.Longitude,
+		VenueID:        event.VenueID,
+		ResourceID:     event.ResourceID,
+		Capacity:       event.Capacity,
+		Participants:   int(participantCount),
+		WaitlistCount:  int(waitlistCount),
+		Status:         types.EventStatus(event.Status),
+		Visibility:     types.EventVisibility(event.Visibility),
+		MinReliability: event.MinReliability,
+		JoinMode:       types.JoinMode(event.JoinMode),
+		CheckInOpen:    event.CheckInCodeExpiresAt != nil && time.Now().Before(*event.CheckInCodeExpiresAt),
+		SeriesID:       event.SeriesID,
+		ParentEventID:  event.ParentEventID,
+		GroupID:        event.GroupID,
+		CreatedAt:      event.CreatedAt,
+		UpdatedAt:      event.UpdatedAt,
+	}
+}
+
+// buildEventWithOrganizerResponse adds organizer details and the current user's relation to the event.
+// The event must have its Organizer preloaded.
+func buildEventWithOrganizerResponse(event models.Event, userID uint) types.EventWithOrganizerResponse {
+	var participant models.EventParticipant
+	participantExists := config.DB.Where("event_id = ? AND user_id = ?", event.ID, userID).Limit(1).Find(&participant).RowsAffected
+
+	var role *types.ParticipantRole
+	if event.OrganizerID == userID {
+		r := types.ParticipantRoleOrganizer
+		role = &r
+	} else if participantExists > 0 {
+		r := types.ParticipantRole(participant.Role)
+		role = &r
+	}
+
+	waitlistPosition := services.WaitlistPosition(config.DB, event.ID, userID)
+	avatarURL := fmt.Sprintf("/api/user/%d/avatar", event.Organizer.ID)
+
+	return types.EventWithOrganizerResponse{
+		EventResponse:     buildEventResponse(event),
+		OrganizerName:     event.Organizer.DisplayName,
+		OrganizerUsername: event.Organizer.Username,
+		OrganizerAvatar:   &avatarURL,
+		IsOrganizer:       event.OrganizerID == userID,
+		IsParticipant:     participantExists > 0,
+		IsWaitlisted:      waitlistPosition != nil,
+		IsCheckedIn:       participant.CheckedInAt != nil,
+		WaitlistPosition:  waitlistPosition,
+		JoinRequestStatus: services.JoinRequestStatusFor(config.DB, event.ID, userID),
+		InvitationStatus:  services.InvitationStatusFor(config.DB, event.ID, userID),
+		Role:              role,
+		CanManage:         role != nil && (*role == types.ParticipantRoleOrganizer || *role == types.ParticipantRoleCoOrganizer),
+	}
+}
+
+// normalizeMinReliability treats 0 as "no restriction" and clamps the value to a percentage
+func normalizeMinReliability(value *int) *int {
+	if value == nil || *value <= 0 {
+		return nil
+	}
+	v := min(*value, 100)
+	return &v
+}
+
+// eventFiltersScope applies the sport, type, location, scope, status, date, capacity and
+// rating_range query filters shared by the event list and map endpoints
+func eventFiltersScope(c *gin.Context, userID uint) func(*gorm.DB) *gorm.DB {
+	sport := c.Query("sport")
+	eventType := c.Query("type")
+	location := c.Query("location")
+	venueIDStr := c.Query("venue_id")
+	scope := c.DefaultQuery("scope", "all")
+	statusParam := c.Query("status")
+	startAfterStr := c.Query("start_after")
+	startBeforeStr := c.Query("start_before")
+	minCapacityStr := c.Query("min_capacity")
+	maxCapacityStr := c.Query("max_capacity")
+	ratingRangeStr := c.Query("rating_range")
+
+	return func(db *gorm.DB) *gorm.DB {
+		// Events of blocked and muted organizers stay out of the lists
+		db = db.Scopes(services.HiddenUsersScope(userID, "events.organizer_id"))
+		if sport != "" {
+			db = db.Where("sport = ?", sport)
+		}
+		if eventType != "" {
+			db = db.Where("type = ?", eventType)
+		}
+		if location != "" {
+			// Case-insensitive contains match on location_name
+			db = db.Where("LOWER(location_name) LIKE ?", "%"+strings.ToLower(location)+"%")
+		}
+		if venueID, err := strconv.ParseUint(venueIDStr, 10, 32); err == nil {
+			db = db.Where("venue_id = ?", venueID)
+		}
+		if scope == "following" {
+			// Only events organized by users the current user follows
+			sub := config.DB.Model(&models.Follow{}).Select("followed_id").Where("follower_id = ?", userID)
+			db = db.Where("organizer_id IN (?)", sub)
+		} else if groupIDStr, ok := strings.CutPrefix(scope, "group:"); ok {
+			// Only events of the given group; an invalid ID matches nothing
+			groupID, _ := strconv.ParseUint(groupIDStr, 10, 32)
+			db = db.Where("events.group_id = ?", groupID)
+		}
+
+		// Status filter (supports comma-separated list)
+		if statusParam != "" {
+			parts := strings.Split(statusParam, ",")
+			statuses := make([]string, 0, len(parts))
+			for _, p := range parts {
+				s := strings.TrimSpace(strings.ToLower(p))
+				if s == "upcoming" || s == "active" || s == "complete" || s == "cancelled" {
+					statuses = append(statuses, s)
+				}
+			}
+			if len(statuses) > 0 {
+				db = db.Where("status IN (?)", statuses)
+			}
+		}
+
+		// Date range filters (RFC3339)
+		if startAfterStr != "" {
+			if t, err := time.Parse(time.RFC3339, startAfterStr); err == nil {
+				db = db.Where("start_at >= ?", t)
+			}
+		}
+		if startBeforeStr != "" {
+			if t, err := time.Parse(time.RFC3339, startBeforeStr); err == nil {
+				db = db.Where("start_at <= ?", t)
+			}
+		}
+
+		// Capacity filters (only consider events that define capacity)
+		if minCapacityStr != "" {
+			if v, err := strconv.Atoi(minCapacityStr); err == nil {
+				db = db.Where("capacity IS NOT NULL AND capacity >= ?", v)
+			}
+		}
+		if maxCapacityStr != "" {
+			if v, err := strconv.Atoi(maxCapacityStr); err == nil {
+				db = db.Where("capacity IS NOT NULL AND capacity <= ?", v)
+			}
+		}
+
+		// Skill filter: compare the average player rating with the user's own, per event sport.
+		// Players without a rating count as new players.
+		if ratingRangeStr != "" {
+			if v, err := strconv.Atoi(ratingRangeStr); err == nil && v >= 0 {
+				db = db.Where(`ABS(
+					(SELECT AVG(COALESCE(r.rating, ?)) FROM (
+						SELECT events.organizer_id AS user_id
+						UNION
+						SELECT p.user_id FROM event_participants p WHERE p.event_id = events.id
+					) AS players
+					LEFT JOIN sport_ratings r ON r.user_id = players.user_id AND r.sport = events.sport)
+					- COALESCE((SELECT own.rating FROM sport_ratings own WHERE own.user_id = ? AND own.sport = events.sport), ?)
+				) <= ?`, services.DefaultRating, userID, services.DefaultRating, v)
+			}
+		}
+
+		return db
+	}
+}
+
+// parseEventOrigin reads the optional lat/lng reference point of event queries, wrapping the
+// longitude into [-180, 180]. Responds with 400 and returns false when it is invalid.
+func parseEventOrigin(c *gin.Context) (*[2]float64, bool) {
+	latStr := c.Query("lat")
+	lngStr := c.Query("lng")
+	if latStr == "" && lngStr == "" {
+		return nil, true
+	}
+
+	lat, err1 := strconv.ParseFloat(latStr, 64)
+	lng, err2 := strconv.ParseFloat(lngStr, 64)
+	if err1 != nil || err2 != nil || lat < -90 || lat > 90 || math.IsNaN(lng) || math.IsInf(lng, 0) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "lat and lng must be valid coordinates (lat between -90 and 90)",
+		})
+		return nil, false
+	}
+	return &[2]float64{lat, utils.NormalizeLongitude(lng)}, true
+}
+
+// eventRadiusScope limits events to a great-circle radius around the origin. A bounding box
+// pre-filters rows (wrapping across the ±180° meridian and spanning all longitudes near the
+// poles) before the exact haversine check. No-op without an origin or a positive radius.
+func eventRadiusScope(origin *[2]float64, radiusStr string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if origin == nil || radiusStr == "" {
+			return db
+		}
+		radiusKm, err := strconv.ParseFloat(radiusStr, 64)
+		if err != nil || radiusKm <= 0 {
+			return db
+		}
+
+		bounds := utils.BoundingBox(origin[0], origin[1], radiusKm)
+		db = db.Where("latitude BETWEEN ? AND ?", bounds.MinLat, bounds.MaxLat)
+		if bounds.CrossesAntimeridian() {
+			db = db.Where("(longitude >= ? OR longitude <= ?)", bounds.MinLng, bounds.MaxLng)
+		} else {
+			db = db.Where("longitude BETWEEN ? AND ?", bounds.MinLng, bounds.MaxLng)
+		}
+		return db.Where(utils.HaversineSQL("latitude", "longitude")+" <= ?", origin[0], origin[0], origin[1], radiusKm)
+	}
+}
+
+// respondEventVenueError maps errors from linking an event to a venue or resource to HTTP responses
+func respondEventVenueError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrVenueNotFound):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Venue not found",
+		})
+		return
+	case errors.Is(err, services.ErrResourceNotFound):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Venue resource not found",
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+		Error:   "Database error",
+		Message: "Failed to load venue",
+	})
+}
+
+// resolveUpdateVenue replaces the location in an update with the requested venue's name and coordinates
+func resolveUpdateVenue(c *gin.Context, req *types.UpdateEventRequest) bool {
+	if req.VenueID == nil || *req.VenueID == 0 {
+		return true
+	}
+	venue, err := services.GetVenue(config.DB, *req.VenueID)
+	if err != nil {
+		respondEventVenueError(c, err)
+		return false
+	}
+	req.LocationName = &venue.Name
+	req.Latitude = venue.Latitude
+	req.Longitude = venue.Longitude
+	return true
+}
+
+// updatedVenueID returns the venue after an update: the requested one (0 removes it), none when
+// the location was changed by hand, otherwise the current one
+func updatedVenueID(current *uint, req *types.UpdateEventRequest) *uint {
+	switch {
+	case req.VenueID != nil && *req.VenueID == 0:
+		return nil
+	case req.VenueID != nil:
+		id := *req.VenueID
+		return &id
+	case req.LocationName != nil || req.Latitude != nil || req.Longitude != nil:
+		return nil
+	}
+	return current
+}
+
+// resolveUpdateResource makes an update booking a resource move the event to the resource's venue
+func resolveUpdateResource(c *gin.Context, req *types.UpdateEventRequest) bool {
+	if req.ResourceID == nil || *req.ResourceID == 0 {
+		return true
+	}
+	resource, err := services.GetVenueResource(config.DB, *req.ResourceID)
+	if err != nil {
+		respondEventVenueError(c, err)
+		return false
+	}
+	if req.VenueID != nil && *req.VenueID != resource.VenueID {
+		respondResourceVenueMismatch(c)
+		return false
+	}
+	req.VenueID = &resource.VenueID
+	return true
+}
+
+// updatedResourceID returns the booked resource after an update: the requested one (0 removes it),
+// none when the event moved away from the resource's venue, otherwise the current one
+func updatedResourceID(current, previousVenueID, venueID *uint, req *types.UpdateEventRequest) *uint {
+	switch {
+	case req.ResourceID != nil && *req.ResourceID == 0:
+		return nil
+	case req.ResourceID != nil:
+		id := *req.ResourceID
+		return &id
+	case venueID == nil || previousVenueID == nil || *venueID != *previousVenueID:
+		return nil
+	}
+	return current
+}
+
+// saveEventBooking runs save in a transaction, first checking the event's resource booking.
+// Conflicts abort with services.ErrResourceBooked unless allowConflicts is set; they are
+// returned either way.
+func saveEventBooking(event *models.Event, allowConflicts bool, save func(tx *gorm.DB) error) ([]types.BookingConflictResponse, error) {
+	var conflicts []types.BookingConflictResponse
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if event.ResourceID != nil {
+			found, err := services.FindResourceConflicts(tx, *event.ResourceID, services.BookingWindow(event.StartAt, event.EndAt), event.ID)
+			if err != nil {
+				return err
+			}
+			conflicts = buildBookingConflicts(found)
+			if len(conflicts) > 0 && !allowConflicts {
+				return services.ErrResourceBooked
+			}
+		}
+		return save(tx)
+	})
+	return conflicts, err
+}
+
+// respondEventSaveError maps errors from saveEventBooking to HTTP responses
+func respondEventSaveError(c *gin.Context, err error, conflicts []types.BookingConflictResponse, message string) {
+	switch {
+	case errors.Is(err, services.ErrResourceBooked):
+		c.JSON(http.StatusConflict, types.BookingConflictErrorResponse{
+			Error:     "Booking conflict",
+			Message:   "The resource is not available at this time; set allow_conflicts to book it anyway",
+			Conflicts: conflicts,
+		})
+	case errors.Is(err, services.ErrResourceNotFound):
+		respondEventVenueError(c, err)
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: message,
+		})
+	}
+}
+
+func respondResourceVenueMismatch(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, types.ErrorResponse{
+		Error:   "Invalid request",
+		Message: "The resource does not belong to the venue",
+	})
+}
+
+// buildBookingConflicts converts booking conflicts into their API representation
+func buildBookingConflicts(found []services.ResourceConflict) []types.BookingConflictResponse {
+	conflicts := make([]types.BookingConflictResponse, 0, len(found))
+	for _, conflict := range found {
+		response := types.BookingConflictResponse{Reason: conflict.Reason}
+		if conflict.Event != nil {
+			window := services.BookingWindow(conflict.Event.StartAt, conflict.Event.EndAt)
+			response.EventID = &conflict.Event.ID
+			response.Title = conflict.Event.Title
+			response.StartAt = &window.Start
+			response.EndAt = &window.End
+		}
+		conflicts = append(conflicts, response)
+	}
+	return conflicts
+}
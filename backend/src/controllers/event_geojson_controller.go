@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"backend/src/utils"
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// geoJSONMaxLimit caps a single (non-streamed) GeoJSON page
+	geoJSONMaxLimit = 1000
+	// geoJSONBatchSize is the number of events loaded and flushed at once when streaming
+	geoJSONBatchSize = 500
+)
+
+// GetEventsGeoJSON godoc
+// @Summary      Export events as GeoJSON
+// @Description  Return events as an RFC 7946 GeoJSON FeatureCollection of points with the event fields as properties.
+// @Description  Takes the same filters as listing events. Pages are limited to 1000 events; with stream=true every
+// @Description  matching event is written in batches (ordered by ID) for large exports.
+// @Tags         Events
+// @Produce      application/geo+json
+// @Security     BearerAuth
+// @Param        sport query string false "Filter by sport"
+// @Param        type query string false "Filter by type (game, event, training)"
+// @Param        location query string false "Filter by location"
+// @Param        venue_id query int false "Only events at this venue"
+// @Param        scope query string false "Filter by scope (all, following, group:<id>)"
+// @Param        status query string false "Filter by status (comma-separated)"
+// @Param        start_after query string false "Only events starting at or after this time (RFC3339)"
+// @Param        start_before query string false "Only events starting at or before this time (RFC3339)"
+// @Param        min_capacity query int false "Minimum capacity"
+// @Param        max_capacity query int false "Maximum capacity"
+// @Param        rating_range query int false "Only events whose average player rating is within this many points of the current user's rating"
+// @Param        lat query float false "Latitude of the reference point; features then include distance_km"
+// @Param        lng query float false "Longitude of the reference point"
+// @Param        radius_km query float false "Only events within this great-circle distance (km) of lat/lng"
+// @Param        limit query int false "Limit number of results (max 1000, ignored when streaming)" default(100)
+// @Param        offset query int false "Offset for pagination (ignored when streaming)" default(0)
+// @Param        stream query bool false "Stream every matching event"
+// @Success      200 {object} types.GeoJSONFeatureCollection "Events as GeoJSON"
+// @Failure      400 {object} types.ErrorResponse "Invalid request"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /events/geojson [get]
+func (ec *EventController) GetEventsGeoJSON(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	origin, ok := parseEventOrigin(c)
+	if !ok {
+		return
+	}
+
+	query := config.DB.Where("deleted_at IS NULL").
+		Scopes(services.VisibleEventsScope(userID.(uint)), eventFiltersScope(c, userID.(uint)), eventRadiusScope(origin, c.Query("radius_km")))
+
+	if stream, _ := strconv.ParseBool(c.Query("stream")); stream {
+		streamEventsGeoJSON(c, query, origin)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	limit = min(limit, geoJSONMaxLimit)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	var events []models.Event
+	if err := query.Order("start_at DESC").Limit(limit).Offset(offset).Find(&events).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch events",
+		})
+		return
+	}
+
+	c.Header("Content-Type", "application/geo+json")
+	c.JSON(http.StatusOK, types.GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: buildEventFeatures(events, origin),
+	})
+}
+
+// streamEventsGeoJSON writes every event matching the query as a FeatureCollection, flushing
+// after each batch. Errors after the first byte can't change the status anymore: the stream
+// is cut short, which leaves invalid JSON for the client to notice.
+func streamEventsGeoJSON(c *gin.Context, query *gorm.DB, origin *[2]float64) {
+	c.Header("Content-Type", "application/geo+json")
+	c.Header("Content-Disposition", `attachment; filename="events.geojson"`)
+	c.Status(http.StatusOK)
+
+	if _, err := c.Writer.WriteString(`{"type":"FeatureCollection","features":[`); err != nil {
+		return
+	}
+
+	written := 0
+	var batch []models.Event
+	result := query.FindInBatches(&batch, geoJSONBatchSize, func(tx *gorm.DB, _ int) error {
+		for _, feature := range buildEventFeatures(batch, origin) {
+			data, err := json.Marshal(feature)
+			if err != nil {
+				return err
+			}
+			if written > 0 {
+				if _, err := c.Writer.WriteString(","); err != nil {
+					return err
+				}
+			}
+			if _, err := c.Writer.Write(data); err != nil {
+				return err
+			}
+			written++
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if result.Error != nil {
+		log.Printf("GeoJSON export aborted after %d events: %v", written, result.Error)
+		return
+	}
+
+	_, _ = c.Writer.WriteString("]}")
+	c.Writer.Flush()
+}
+
+// buildEventFeatures converts events into GeoJSON features, counting participants in one query
+func buildEventFeatures(events []models.Event, origin *[2]float64) []types.GeoJSONFeature {
+	features := make([]types.GeoJSONFeature, 0, len(events))
+	if len(events) == 0 {
+		return features
+	}
+
+	ids := make([]uint, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	var counts []struct {
+		EventID uint
+		Count   int
+	}
+	config.DB.Model(&models.EventParticipant{}).Select("event_id, COUNT(*) AS count").
+		Where("event_id IN ?", ids).Group("event_id").Scan(&counts)
+	participants := make(map[uint]int, len(counts))
+	for _, row := range counts {
+		participants[row.EventID] = row.Count
+	}
+
+	for _, event := range events {
+		if event.Latitude == nil || event.Longitude == nil {
+			continue
+		}
+		properties := types.EventFeatureProperties{
+			ID:           event.ID,
+			OrganizerID:  event.OrganizerID,
+			Type:         types.EventType(event.Type),
+			Title:        event.Title,
+			Description:  event.Description,
+			Sport:        event.Sport,
+			StartAt:      event.StartAt,
+			EndAt:        event.EndAt,
+			LocationName: event.LocationName,
+			VenueID:      event.VenueID,
+			Capacity:     event.Capacity,
+			Participants: participants[event.ID],
+			Status:       types.EventStatus(event.Status),
+			Visibility:   types.EventVisibility(event.Visibility),
+			SeriesID:     event.SeriesID,
+		}
+		if origin != nil {
+			distance := math.Round(utils.HaversineKm(origin[0], origin[1], *event.Latitude, *event.Longitude)*100) / 100
+			properties.DistanceKm = &distance
+		}
+		features = append(features, types.GeoJSONFeature{
+			Type:       "Feature",
+			ID:         event.ID,
+			Geometry:   types.NewGeoJSONPoint(*event.Latitude, *event.Longitude),
+			Properties: properties,
+		})
+	}
+
+	return features
+}
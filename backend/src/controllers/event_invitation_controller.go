@@ -0,0 +1,484 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm/clause"
+)
+
+// InviteToEvent godoc
+// @Summary      Invite users to an event
+// @Description  Invite users to an event (by organizers and co-organizers). Invited users can see the event even if it is invite-only or followers-only.
+// @Description  Re-inviting a user who declined resets their invitation to pending.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        invitation body types.InviteUsersRequest true "Users to invite"
+// @Success      201 {array} types.EventInvitationResponse "Invitations sent"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to invite to this event"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/invitations [post]
+func (ec *EventController) InviteToEvent(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	if !services.CanManageEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only invite users to events you organize",
+		})
+		return
+	}
+
+	if event.Status != string(types.EventStatusUpcoming) {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Event unavailable",
+			Message: "Invitations can only be sent for upcoming events",
+		})
+		return
+	}
+
+	var req types.InviteUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if len(req.UserIDs) == 0 || len(req.UserIDs) > 100 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Between 1 and 100 user IDs must be provided",
+		})
+		return
+	}
+
+	// Only invite existing users who aren't the organizer or already taking part
+	var invitees []models.User
+	config.DB.Where("id IN ? AND id <> ?", req.UserIDs, event.OrganizerID).
+		Where("id NOT IN (?)", config.DB.Model(&models.EventParticipant{}).Select("user_id").Where("event_id = ?", event.ID)).
+		Scopes(services.BlockedUsersScope(event.OrganizerID, "id"), services.BlockedUsersScope(userID.(uint), "id")).
+		Find(&invitees)
+	if len(invitees) == 0 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "None of the given users can be invited",
+		})
+		return
+	}
+
+	invitations := make([]models.EventInvitation, 0, len(invitees))
+	for _, u := range invitees {
+		invitations = append(invitations, models.EventInvitation{
+			EventID:   event.ID,
+			InviteeID: u.ID,
+			InviterID: userID.(uint),
+			Status:    string(types.InvitationStatusPending),
+		})
+	}
+
+	// Pending and accepted invitations stay as they are; declined ones are re-opened
+	if err := config.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "invitee_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"status": types.InvitationStatusPending, "responded_at": nil, "updated_at": time.Now()}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "event_invitations.status", Value: types.InvitationStatusDeclined}}},
+	}).Create(&invitations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to send invitations",
+		})
+		return
+	}
+
+	var inviter models.User
+	_ = config.DB.First(&inviter, userID.(uint)).Error
+	inviterName := inviter.DisplayName
+	if inviterName == "" {
+		inviterName = inviter.Username
+	}
+
+	response := make([]types.EventInvitationResponse, 0, len(invitees))
+	for _, u := range invitees {
+		var invitation models.EventInvitation
+		if err := config.DB.Where("event_id = ? AND invitee_id = ?", event.ID, u.ID).First(&invitation).Error; err != nil {
+			continue
+		}
+		invitation.Invitee = u
+		invitation.Event = event
+		response = append(response, buildEventInvitationResponse(invitation))
+
+		if invitation.Status != string(types.InvitationStatusPending) {
+			continue
+		}
+		payload := types.JSON{
+			"title":       fmt.Sprintf("%s invited you to an activity", inviterName),
+			"body":        event.Title,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", event.ID),
+		}
+		notif := models.Notification{UserID: u.ID, ActorID: &inviter.ID, Type: types.NotificationTypeInvite, Payload: payload, Read: false}
+		if err := config.DB.Create(&notif).Error; err == nil {
+			services.GetNotificationHub().Publish(notif)
+		}
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetEventInvitations godoc
+// @Summary      Get event invitations
+// @Description  List everyone invited to an event with their response (by organizers and co-organizers)
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {array} types.EventInvitationResponse "Invitations of the event"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to view invitations"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/invitations [get]
+func (ec *EventController) GetEventInvitations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	if !services.CanManageEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only view invitations of events you organize",
+		})
+		return
+	}
+
+	var invitations []models.EventInvitation
+	if err := config.DB.Preload("Invitee").Where("event_id = ?", event.ID).Order("created_at ASC").Find(&invitations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch invitations",
+		})
+		return
+	}
+
+	response := make([]types.EventInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		invitation.Event = event
+		response = append(response, buildEventInvitationResponse(invitation))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyInvitations godoc
+// @Summary      Get my invitations
+// @Description  List the current user's pending invitations to upcoming events
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.EventInvitationResponse "Pending invitations"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /events/invitations [get]
+func (ec *EventController) GetMyInvitations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var invitations []models.EventInvitation
+	if err := config.DB.Preload("Event").Preload("Invitee").
+		Joins("JOIN events ON events.id = event_invitations.event_id AND events.deleted_at IS NULL").
+		Where("event_invitations.invitee_id = ? AND event_invitations.status = ? AND events.status = ?", userID, types.InvitationStatusPending, types.EventStatusUpcoming).
+		Order("events.start_at ASC").
+		Find(&invitations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch invitations",
+		})
+		return
+	}
+
+	response := make([]types.EventInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		response = append(response, buildEventInvitationResponse(invitation))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptInvitation godoc
+// @Summary      Accept an event invitation
+// @Description  Accept an invitation and join the event, or its waitlist if the event is full
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      201 {object} gin.H "Invitation accepted and event joined"
+// @Success      202 {object} gin.H "Invitation accepted, event full, added to waitlist"
+// @Failure      400 {object} types.ErrorResponse "Invalid request or already joined"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Invitation not found"
+// @Failure      409 {object} types.ErrorResponse "Event cannot be joined"
+// @Router       /events/{id}/invitations/accept [post]
+func (ec *EventController) AcceptInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	invitation, ok := loadOwnInvitation(c, userID.(uint))
+	if !ok {
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, invitation.EventID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	if event.Status != string(types.EventStatusUpcoming) {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Event unavailable",
+			Message: "Cannot join this event",
+		})
+		return
+	}
+
+	if services.IsBannedFromEvent(config.DB, event.ID, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Banned",
+			Message: "You have been removed from this event and cannot join it again",
+		})
+		return
+	}
+
+	participant, waitlistEntry, err := services.JoinOrWaitlist(config.DB, event.ID, userID.(uint))
+	if err != nil && !errors.Is(err, services.ErrAlreadyParticipating) && !errors.Is(err, services.ErrAlreadyWaitlisted) {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to join event",
+		})
+		return
+	}
+
+	markInvitationAccepted(event.ID, userID.(uint))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Already joined",
+			Message: "You are already participating in this event",
+		})
+		return
+	}
+
+	if waitlistEntry != nil {
+		c.JSON(http.StatusAccepted, gin.H{
+			"message":           "Invitation accepted, the event is full so you have been added to the waitlist",
+			"event_id":          event.ID,
+			"waitlisted":        true,
+			"waitlist_position": waitlistEntry.Position,
+		})
+		return
+	}
+
+	// Let the organizer know the invitation was accepted
+	var actor models.User
+	_ = config.DB.First(&actor, userID).Error
+	actorName := actor.DisplayName
+	if actorName == "" {
+		actorName = actor.Username
+	}
+	payload := types.JSON{
+		"title":       fmt.Sprintf("%s accepted your invitation", actorName),
+		"body":        event.Title,
+		"target_type": "activity",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+	}
+	notif := models.Notification{UserID: event.OrganizerID, ActorID: &actor.ID, Type: types.NotificationTypeMessage, Payload: payload, Read: false}
+	if err := config.DB.Create(&notif).Error; err == nil {
+		services.GetNotificationHub().Publish(notif)
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":        "Invitation accepted",
+		"event_id":       event.ID,
+		"participant_id": participant.ID,
+		"waitlisted":     false,
+	})
+}
+
+// DeclineInvitation godoc
+// @Summary      Decline an event invitation
+// @Description  Decline an invitation. Declined invite-only events are hidden from the user again.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {object} gin.H "Invitation declined"
+// @Failure      400 {object} types.ErrorResponse "Invitation already answered"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Invitation not found"
+// @Router       /events/{id}/invitations/decline [post]
+func (ec *EventController) DeclineInvitation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	invitation, ok := loadOwnInvitation(c, userID.(uint))
+	if !ok {
+		return
+	}
+
+	if invitation.Status != string(types.InvitationStatusPending) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "This invitation has already been answered",
+		})
+		return
+	}
+
+	now := time.Now()
+	if err := config.DB.Model(&invitation).Updates(map[string]any{
+		"status":       types.InvitationStatusDeclined,
+		"responded_at": now,
+	}).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to decline invitation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Invitation declined",
+		"event_id": invitation.EventID,
+	})
+}
+
+// loadOwnInvitation finds the current user's invitation to the event in the path
+func loadOwnInvitation(c *gin.Context, userID uint) (models.EventInvitation, bool) {
+	var invitation models.EventInvitation
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return invitation, false
+	}
+
+	if err := config.DB.Where("event_id = ? AND invitee_id = ?", eventIDInt, userID).First(&invitation).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Invitation not found",
+			Message: "You have not been invited to this event",
+		})
+		return invitation, false
+	}
+
+	return invitation, true
+}
+
+// markInvitationAccepted records that the user accepted their invitation, if they had one
+func markInvitationAccepted(eventID, userID uint) {
+	config.DB.Model(&models.EventInvitation{}).
+		Where("event_id = ? AND invitee_id = ? AND status <> ?", eventID, userID, types.InvitationStatusAccepted).
+		Updates(map[string]any{"status": types.InvitationStatusAccepted, "responded_at": time.Now()})
+}
+
+// buildEventInvitationResponse converts an invitation with its Event and Invitee loaded
+func buildEventInvitationResponse(invitation models.EventInvitation) types.EventInvitationResponse {
+	return types.EventInvitationResponse{
+		ID:          invitation.ID,
+		EventID:     invitation.EventID,
+		EventTitle:  invitation.Event.Title,
+		StartAt:     invitation.Event.StartAt,
+		InviterID:   invitation.InviterID,
+		InviteeID:   invitation.InviteeID,
+		Username:    invitation.Invitee.Username,
+		DisplayName: invitation.Invitee.DisplayName,
+		Status:      types.InvitationStatus(invitation.Status),
+		RespondedAt: invitation.RespondedAt,
+		CreatedAt:   invitation.CreatedAt,
+	}
+}
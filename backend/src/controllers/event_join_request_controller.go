@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// AcceptJoinRequest godoc
+// @Summary      Accept a join request
+// @Description  Accept a pending request to join an approval-required event (by organizers and co-organizers).
+// @Description  The user joins the event, or its waitlist if the event is full, and is notified.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} gin.H "Request accepted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this event"
+// @Failure      404 {object} types.ErrorResponse "Event or pending request not found"
+// @Failure      409 {object} types.ErrorResponse "Event cannot be joined anymore"
+// @Router       /events/{id}/join-requests/{userId}/accept [post]
+func (ec *EventController) AcceptJoinRequest(c *gin.Context) {
+	respondToJoinRequest(c, true)
+}
+
+// RejectJoinRequest godoc
+// @Summary      Reject a join request
+// @Description  Reject a pending request to join an approval-required event (by organizers and co-organizers). The user is notified.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} gin.H "Request rejected"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this event"
+// @Failure      404 {object} types.ErrorResponse "Event or pending request not found"
+// @Router       /events/{id}/join-requests/{userId}/reject [post]
+func (ec *EventController) RejectJoinRequest(c *gin.Context) {
+	respondToJoinRequest(c, false)
+}
+
+// GetEventBans godoc
+// @Summary      Get banned users
+// @Description  List the users banned from an event (by organizers and co-organizers)
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {array} types.EventBanResponse "Banned users"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this event"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Router       /events/{id}/bans [get]
+func (ec *EventController) GetEventBans(c *gin.Context) {
+	event, ok := loadManagedEvent(c)
+	if !ok {
+		return
+	}
+
+	var bans []models.EventBan
+	if err := config.DB.Preload("User").Where("event_id = ?", event.ID).Order("created_at DESC").Find(&bans).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch bans",
+		})
+		return
+	}
+
+	response := make([]types.EventBanResponse, 0, len(bans))
+	for _, b := range bans {
+		response = append(response, types.EventBanResponse{
+			UserID:      b.UserID,
+			Username:    b.User.Username,
+			DisplayName: b.User.DisplayName,
+			Reason:      b.Reason,
+			BannedByID:  b.BannedByID,
+			CreatedAt:   b.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UnbanEventUser godoc
+// @Summary      Lift a ban
+// @Description  Allow a banned user to join the event again (by organizers and co-organizers)
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Banned user ID"
+// @Success      200 {object} gin.H "Ban lifted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this event"
+// @Failure      404 {object} types.ErrorResponse "Event or ban not found"
+// @Router       /events/{id}/bans/{userId} [delete]
+func (ec *EventController) UnbanEventUser(c *gin.Context) {
+	event, ok := loadManagedEvent(c)
+	if !ok {
+		return
+	}
+
+	bannedID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	if err := services.UnbanFromEvent(config.DB, event.ID, uint(bannedID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, types.ErrorResponse{
+				Error:   "Ban not found",
+				Message: "The user is not banned from this event",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to lift ban",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Ban lifted",
+		"event_id": event.ID,
+		"user_id":  bannedID,
+	})
+}
+
+// respondToJoinRequest handles accepting or rejecting a join request
+func respondToJoinRequest(c *gin.Context, accept bool) {
+	event, ok := loadManagedEvent(c)
+	if !ok {
+		return
+	}
+
+	requesterID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	if accept && event.Status != string(types.EventStatusUpcoming) {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Event unavailable",
+			Message: "Requests can only be accepted for upcoming events",
+		})
+		return
+	}
+
+	waitlistEntry, err := services.RespondToJoinRequest(config.DB, &event, c.GetUint("userID"), uint(requesterID), accept)
+	if err != nil {
+		respondJoinRequestError(c, err)
+		return
+	}
+
+	response := gin.H{
+		"message":  "Request rejected",
+		"event_id": event.ID,
+		"user_id":  requesterID,
+		"status":   types.ParticipationStatusRejected,
+	}
+	if accept {
+		response["message"] = "Request accepted"
+		response["status"] = types.ParticipationStatusAccepted
+		response["waitlisted"] = waitlistEntry != nil
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// respondJoinRequestError maps join request service errors to responses
+func respondJoinRequestError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrAlreadyParticipating):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Already joined",
+			Message: "You are already participating in this event",
+		})
+	case errors.Is(err, services.ErrAlreadyWaitlisted):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Already waitlisted",
+			Message: "You are already on the waitlist for this event",
+		})
+	case errors.Is(err, services.ErrJoinRequestPending):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Request pending",
+			Message: "Your request to join this event is waiting for the organizer",
+		})
+	case errors.Is(err, services.ErrJoinRequestRejected):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Request rejected",
+			Message: "Your request to join this event was declined",
+		})
+	case errors.Is(err, services.ErrNoJoinRequest):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Request not found",
+			Message: "There is no pending request to join this event",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to process the join request",
+		})
+	}
+}
+
+// buildEventParticipantResponse converts an accepted participation; the User must be preloaded
+func buildEventParticipantResponse(p models.EventParticipant) types.EventParticipantResponse {
+	role := types.ParticipantRole(p.Role)
+	if role == "" {
+		role = types.ParticipantRoleParticipant
+	}
+	return types.EventParticipantResponse{
+		ID:          p.ID,
+		EventID:     p.EventID,
+		UserID:      p.UserID,
+		Username:    p.User.Username,
+		DisplayName: p.User.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", p.UserID),
+		Role:        role,
+		TeamID:      p.TeamID,
+		Status:      types.ParticipationStatusAccepted,
+		CheckedInAt: p.CheckedInAt,
+		JoinedAt:    p.JoinedAt,
+	}
+}
+
+// buildJoinRequestResponse converts a pending or rejected join request; the User must be preloaded
+func buildJoinRequestResponse(r models.EventJoinRequest) types.EventParticipantResponse {
+	return types.EventParticipantResponse{
+		ID:          r.ID,
+		EventID:     r.EventID,
+		UserID:      r.UserID,
+		Username:    r.User.Username,
+		DisplayName: r.User.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", r.UserID),
+		Status:      types.ParticipationStatus(r.Status),
+		JoinedAt:    r.CreatedAt,
+		RespondedAt: r.RespondedAt,
+	}
+}
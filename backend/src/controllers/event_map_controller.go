@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"backend/src/utils"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// mapClusterMaxZoom is the zoom level from which individual events are returned
+	mapClusterMaxZoom = 15
+	// mapMaxZoom is the highest zoom level accepted
+	mapMaxZoom = 22
+	// mapCellsPerTile is the number of clustering cells along each side of a 256px map tile
+	mapCellsPerTile = 4
+	// mapMaxEvents bounds the number of individual pins returned
+	mapMaxEvents = 500
+)
+
+// GetEventMap godoc
+// @Summary      Get events on the map
+// @Description  Return the events inside a map viewport. Below zoom level 15 nearby events are grouped into clusters on a
+// @Description  grid of Web Mercator cells (a quarter of a map tile); events alone in their cell are returned as pins.
+// @Description  From zoom level 15 every event is returned as a pin. Viewports crossing the ±180° meridian have min_lng > max_lng.
+// @Description  Supports the same filters as listing events.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        min_lat query number true "Southern edge of the viewport"
+// @Param        min_lng query number true "Western edge of the viewport"
+// @Param        max_lat query number true "Northern edge of the viewport"
+// @Param        max_lng query number true "Eastern edge of the viewport"
+// @Param        zoom query int true "Map zoom level (0-22)"
+// @Param        sport query string false "Filter by sport"
+// @Param        type query string false "Filter by type (game, event, training)"
+// @Param        location query string false "Filter by location"
+// @Param        venue_id query int false "Only events at this venue"
+// @Param        scope query string false "Filter by scope (all, following, group:<id>)"
+// @Param        status query string false "Filter by status (comma-separated)"
+// @Param        start_after query string false "Only events starting at or after this time (RFC3339)"
+// @Param        start_before query string false "Only events starting at or before this time (RFC3339)"
+// @Param        min_capacity query int false "Minimum capacity"
+// @Param        max_capacity query int false "Maximum capacity"
+// @Param        rating_range query int false "Only events whose average player rating is within this many points of the current user's rating"
+// @Success      200 {object} types.EventMapResponse "Clusters and event pins"
+// @Failure      400 {object} types.ErrorResponse "Invalid viewport or zoom level"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /events/map [get]
+func (ec *EventController) GetEventMap(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	bounds, ok := parseMapViewport(c)
+	if !ok {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "min_lat, min_lng, max_lat and max_lng must describe a valid viewport",
+		})
+		return
+	}
+
+	zoom, err := strconv.Atoi(c.Query("zoom"))
+	if err != nil || zoom < 0 || zoom > mapMaxZoom {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: fmt.Sprintf("zoom must be a number between 0 and %d", mapMaxZoom),
+		})
+		return
+	}
+
+	query := config.DB.Model(&models.Event{}).Where("deleted_at IS NULL").
+		Scopes(services.VisibleEventsScope(userID.(uint)), eventFiltersScope(c, userID.(uint)), mapViewportScope(bounds))
+
+	response := types.EventMapResponse{
+		Zoom:     zoom,
+		Clusters: make([]types.MapClusterResponse, 0),
+		Events:   make([]types.MapEventResponse, 0),
+	}
+
+	if zoom >= mapClusterMaxZoom {
+		var events []models.Event
+		if err := query.Order("start_at ASC").Limit(mapMaxEvents + 1).Find(&events).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to fetch events",
+			})
+			return
+		}
+		if len(events) > mapMaxEvents {
+			events = events[:mapMaxEvents]
+			response.Truncated = true
+		}
+		for _, event := range events {
+			response.Events = append(response.Events, buildMapEventResponse(event))
+		}
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	// Cells follow the Web Mercator tile grid, so clusters look evenly sized on the map.
+	// Latitudes are clamped to the projection's limits.
+	cells := float64(int64(1)<<zoom) * mapCellsPerTile
+	cellX := fmt.Sprintf("FLOOR((longitude + 180) / 360 * %g)", cells)
+	cellY := fmt.Sprintf("FLOOR((1 - LN(TAN(RADIANS(45 + GREATEST(-85.05112878, LEAST(85.05112878, latitude)) / 2))) / PI()) / 2 * %g)", cells)
+
+	var rows []struct {
+		Count         int
+		Latitude      float64
+		Longitude     float64
+		DominantSport string
+		MinLatitude   float64
+		MinLongitude  float64
+		MaxLatitude   float64
+		MaxLongitude  float64
+		EventID       uint
+	}
+	if err := query.Select(fmt.Sprintf(`%s AS cell_x, %s AS cell_y, COUNT(*) AS count,
+		AVG(latitude) AS latitude, AVG(longitude) AS longitude,
+		MODE() WITHIN GROUP (ORDER BY sport) AS dominant_sport,
+		MIN(latitude) AS min_latitude, MIN(longitude) AS min_longitude,
+		MAX(latitude) AS max_latitude, MAX(longitude) AS max_longitude,
+		MIN(id) AS event_id`, cellX, cellY)).
+		Group("cell_x, cell_y").
+		Scan(&rows).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to cluster events",
+		})
+		return
+	}
+
+	response.Clustered = true
+	singles := make([]uint, 0)
+	for _, row := range rows {
+		if row.Count == 1 {
+			singles = append(singles, row.EventID)
+			continue
+		}
+		response.Clusters = append(response.Clusters, types.MapClusterResponse{
+			Count:         row.Count,
+			Latitude:      row.Latitude,
+			Longitude:     row.Longitude,
+			DominantSport: row.DominantSport,
+			MinLatitude:   row.MinLatitude,
+			MinLongitude:  row.MinLongitude,
+			MaxLatitude:   row.MaxLatitude,
+			MaxLongitude:  row.MaxLongitude,
+		})
+	}
+
+	if len(singles) > 0 {
+		var events []models.Event
+		if err := config.DB.Where("id IN ?", singles).Order("start_at ASC").Find(&events).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to fetch events",
+			})
+			return
+		}
+		for _, event := range events {
+			response.Events = append(response.Events, buildMapEventResponse(event))
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseMapViewport reads the viewport from the query. Longitudes are wrapped into [-180, 180];
+// a viewport at least 360° wide covers every longitude.
+func parseMapViewport(c *gin.Context) (utils.GeoBounds, bool) {
+	var values [4]float64
+	for i, key := range []string{"min_lat", "min_lng", "max_lat", "max_lng"} {
+		v, err := strconv.ParseFloat(c.Query(key), 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return utils.GeoBounds{}, false
+		}
+		values[i] = v
+	}
+
+	bounds := utils.GeoBounds{
+		MinLat: math.Max(values[0], -90),
+		MinLng: utils.NormalizeLongitude(values[1]),
+		MaxLat: math.Min(values[2], 90),
+		MaxLng: utils.NormalizeLongitude(values[3]),
+	}
+	if bounds.MinLat > bounds.MaxLat {
+		return utils.GeoBounds{}, false
+	}
+	if values[3]-values[1] >= 360 {
+		bounds.MinLng, bounds.MaxLng = -180, 180
+	}
+	return bounds, true
+}
+
+// mapViewportScope limits events to the viewport, wrapping across the ±180° meridian
+func mapViewportScope(bounds utils.GeoBounds) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("latitude BETWEEN ? AND ?", bounds.MinLat, bounds.MaxLat)
+		if bounds.CrossesAntimeridian() {
+			return db.Where("(longitude >= ? OR longitude <= ?)", bounds.MinLng, bounds.MaxLng)
+		}
+		return db.Where("longitude BETWEEN ? AND ?", bounds.MinLng, bounds.MaxLng)
+	}
+}
+
+// buildMapEventResponse converts an event into a map pin
+func buildMapEventResponse(event models.Event) types.MapEventResponse {
+	return types.MapEventResponse{
+		ID:        event.ID,
+		Title:     event.Title,
+		Type:      types.EventType(event.Type),
+		Sport:     event.Sport,
+		Status:    types.EventStatus(event.Status),
+		StartAt:   event.StartAt,
+		Latitude:  *event.Latitude,
+		Longitude: *event.Longitude,
+	}
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/services"
+	"backend/src/types"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetRecommendedEvents godoc
+// @Summary      Get "for you" events
+// @Description  Rank the upcoming events you can see and haven't joined by your sports, the distance from your home location, people you follow taking part, the organizer's attendance reliability and the times of the week you joined events before. Each event carries its score breakdown.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Limit number of results (1-50)" default(20)
+// @Param        offset query int false "Offset for pagination" default(0)
+// @Success      200 {array} types.EventRecommendationResponse "Recommended events, best match first"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /events/for-you [get]
+func (ec *EventController) GetRecommendedEvents(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if offset < 0 {
+		offset = 0
+	}
+
+	recommendations, err := services.RecommendEvents(config.DB, userID.(uint))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch recommended events",
+		})
+		return
+	}
+
+	recommendations = recommendations[min(offset, len(recommendations)):]
+	recommendations = recommendations[:min(limit, len(recommendations))]
+
+	response := make([]types.EventRecommendationResponse, 0, len(recommendations))
+	for _, r := range recommendations {
+		breakdown := types.EventScoreBreakdownResponse{
+			Sport:                roundScore(r.SportScore),
+			Distance:             roundScore(r.DistanceScore),
+			Friends:              roundScore(r.FriendsScore),
+			OrganizerReliability: roundScore(r.ReliabilityScore),
+			TimeOfWeek:           roundScore(r.TimeOfWeekScore),
+			SportMatch:           r.SportMatch,
+			FriendsAttending:     r.FriendsAttending,
+			ReliabilityPercent:   r.OrganizerReliability,
+			TimeSlot:             r.TimeSlot,
+			TimeSlotJoins:        r.TimeSlotJoins,
+		}
+		if r.DistanceKm != nil {
+			distance := roundScore(*r.DistanceKm)
+			breakdown.DistanceKm = &distance
+		}
+
+		response = append(response, types.EventRecommendationResponse{
+			EventWithOrganizerResponse: buildEventWithOrganizerResponse(r.Event, userID.(uint)),
+			Score:                      roundScore(r.Score),
+			ScoreBreakdown:             breakdown,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// roundScore rounds a score or distance to two decimals for display
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
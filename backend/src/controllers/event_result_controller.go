@@ -0,0 +1,323 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetEventSides godoc
+// @Summary      Split participants into sides
+// @Description  Define the sides/teams of a game and assign participants to them (by organizers and co-organizers).
+// @Description  Replaces existing sides and discards an unconfirmed result.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        sides body types.SetEventSidesRequest true "Sides and their players"
+// @Success      200 {array} types.EventSideResponse "Sides of the game"
+// @Failure      400 {object} types.ErrorResponse "Invalid sides"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this game"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.ErrorResponse "Result already confirmed"
+// @Router       /events/{id}/sides [put]
+func (ec *EventController) SetEventSides(c *gin.Context) {
+	event, ok := loadManagedGame(c)
+	if !ok {
+		return
+	}
+
+	var req types.SetEventSidesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if _, err := services.SetEventSides(config.DB, &event, req.Sides); err != nil {
+		respondResultError(c, err, "Failed to save sides")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildEventSides(event.ID))
+}
+
+// RecordResult godoc
+// @Summary      Record a game result
+// @Description  Record the final score of a game (by organizers, co-organizers and referees). The score format depends on the sport:
+// @Description  sets (tennis, badminton, ...) take the games won per set, goals/points take a single score.
+// @Description  Players are asked to confirm or dispute the result; re-recording resets their answers.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        result body types.RecordResultRequest true "Final scores"
+// @Success      201 {object} types.GameResultResponse "Result recorded"
+// @Failure      400 {object} types.ErrorResponse "Invalid scores"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to manage this game"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.ErrorResponse "Game not played yet or result already confirmed"
+// @Router       /events/{id}/result [post]
+func (ec *EventController) RecordResult(c *gin.Context) {
+	event, ok := loadManagedGame(c, types.ParticipantRoleReferee)
+	if !ok {
+		return
+	}
+
+	if event.Status != string(types.EventStatusActive) && event.Status != string(types.EventStatusComplete) {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Game not played",
+			Message: "Results can only be recorded once the game has started",
+		})
+		return
+	}
+
+	var req types.RecordResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	result, err := services.RecordResult(config.DB, &event, c.GetUint("userID"), req.Scores)
+	if err != nil {
+		respondResultError(c, err, "Failed to record result")
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildGameResultResponse(result.EventID))
+}
+
+// ConfirmResult godoc
+// @Summary      Confirm a game result
+// @Description  Confirm the recorded result as a player. It becomes final once a player of every side confirmed it,
+// @Description  or automatically after 48 hours without a dispute.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        body body types.ResultResponseRequest false "Optional comment"
+// @Success      200 {object} types.GameResultResponse "Updated result"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a player of this game"
+// @Failure      404 {object} types.ErrorResponse "Event or result not found"
+// @Failure      409 {object} types.ErrorResponse "Result already confirmed"
+// @Router       /events/{id}/result/confirm [post]
+func (ec *EventController) ConfirmResult(c *gin.Context) {
+	respondToResult(c, types.ResultStatusConfirmed)
+}
+
+// DisputeResult godoc
+// @Summary      Dispute a game result
+// @Description  Dispute the recorded result as a player. The organizer is notified and can record a corrected result.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        body body types.ResultResponseRequest false "Why the result is wrong"
+// @Success      200 {object} types.GameResultResponse "Updated result"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a player of this game"
+// @Failure      404 {object} types.ErrorResponse "Event or result not found"
+// @Failure      409 {object} types.ErrorResponse "Result already confirmed"
+// @Router       /events/{id}/result/dispute [post]
+func (ec *EventController) DisputeResult(c *gin.Context) {
+	respondToResult(c, types.ResultStatusDisputed)
+}
+
+// respondToResult handles a player's confirmation or dispute
+func respondToResult(c *gin.Context, response types.ResultStatus) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	eventIDInt, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, eventIDInt).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	// The body is optional
+	var req types.ResultResponseRequest
+	_ = c.ShouldBindJSON(&req)
+	if len(req.Comment) > 500 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Comment must be at most 500 characters",
+		})
+		return
+	}
+
+	if _, err := services.RespondToResult(config.DB, &event, userID.(uint), response, req.Comment); err != nil {
+		respondResultError(c, err, "Failed to save your response")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildGameResultResponse(event.ID))
+}
+
+// loadManagedGame loads the game event in the path and checks the current user may manage it
+func loadManagedGame(c *gin.Context, extraRoles ...types.ParticipantRole) (models.Event, bool) {
+	event, ok := loadManagedEvent(c, extraRoles...)
+	if !ok {
+		return event, false
+	}
+
+	if event.Type != string(types.EventTypeGame) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Sides and results are only available for games",
+		})
+		return event, false
+	}
+
+	return event, true
+}
+
+// respondResultError maps game result service errors to responses
+func respondResultError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrInvalidSides), errors.Is(err, services.ErrInvalidScores):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrResultConfirmed):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Result confirmed",
+			Message: "The result of this game has already been confirmed",
+		})
+	case errors.Is(err, services.ErrNoResult):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Result not found",
+			Message: "No result has been recorded for this game yet",
+		})
+	case errors.Is(err, services.ErrNotOnASide):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only players of this game can confirm or dispute its result",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildEventSides lists the sides of an event with their players
+func buildEventSides(eventID uint) []types.EventSideResponse {
+	var sides []models.EventSide
+	config.DB.Where("event_id = ?", eventID).Order("position ASC").Find(&sides)
+	if len(sides) == 0 {
+		return nil
+	}
+
+	var participants []models.EventParticipant
+	config.DB.Preload("User").Where("event_id = ? AND side_id IS NOT NULL", eventID).Find(&participants)
+
+	response := make([]types.EventSideResponse, 0, len(sides))
+	for _, side := range sides {
+		players := make([]types.SidePlayerResponse, 0)
+		for _, p := range participants {
+			if *p.SideID == side.ID {
+				players = append(players, types.SidePlayerResponse{
+					UserID:      p.UserID,
+					Username:    p.User.Username,
+					DisplayName: p.User.DisplayName,
+				})
+			}
+		}
+		response = append(response, types.EventSideResponse{ID: side.ID, Name: side.Name, Players: players})
+	}
+
+	return response
+}
+
+// buildGameResultResponse loads the result of an event with its scores and confirmations, or nil if none
+func buildGameResultResponse(eventID uint) *types.GameResultResponse {
+	var result models.GameResult
+	if err := config.DB.Preload("Scores").Preload("Confirmations").Where("event_id = ?", eventID).First(&result).Error; err != nil {
+		return nil
+	}
+
+	var sides []models.EventSide
+	config.DB.Where("event_id = ?", eventID).Find(&sides)
+	names := make(map[uint]string, len(sides))
+	for _, s := range sides {
+		names[s.ID] = s.Name
+	}
+
+	return &types.GameResultResponse{
+		ID:            result.ID,
+		EventID:       result.EventID,
+		Format:        types.ScoreFormat(result.Format),
+		Status:        types.ResultStatus(result.Status),
+		WinnerSideID:  result.WinnerSideID,
+		Scores:        buildSideScores(result.Scores, names),
+		Confirmations: buildResultConfirmations(result.Confirmations),
+		RecordedByID:  result.RecordedByID,
+		ConfirmedAt:   result.ConfirmedAt,
+		CreatedAt:     result.CreatedAt,
+		UpdatedAt:     result.UpdatedAt,
+	}
+}
+
+func buildSideScores(scores []models.GameResultScore, names map[uint]string) []types.SideScoreResponse {
+	response := make([]types.SideScoreResponse, 0, len(scores))
+	for _, s := range scores {
+		response = append(response, types.SideScoreResponse{
+			SideID: s.SideID,
+			Name:   names[s.SideID],
+			Score:  s.Score,
+			Sets:   services.ParseSets(s.Sets),
+		})
+	}
+	return response
+}
+
+func buildResultConfirmations(confirmations []models.GameResultConfirmation) []types.ResultConfirmationResponse {
+	response := make([]types.ResultConfirmationResponse, 0, len(confirmations))
+	for _, c := range confirmations {
+		response = append(response, types.ResultConfirmationResponse{
+			UserID:    c.UserID,
+			Response:  types.ResultStatus(c.Response),
+			Comment:   c.Comment,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+	return response
+}
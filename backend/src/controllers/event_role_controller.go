@@ -0,0 +1,260 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetParticipantRole godoc
+// @Summary      Change a participant's role
+// @Description  Make a participant a co-organizer, captain, referee or plain participant (only by organizer).
+// @Description  Co-organizers can edit the event and manage its roster; referees can manage check-in and record results.
+// @Description  The participant is notified of the change.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Participant user ID"
+// @Param        body body types.SetParticipantRoleRequest true "New role"
+// @Success      200 {object} gin.H "Role updated"
+// @Failure      400 {object} types.ErrorResponse "Invalid role"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the organizer"
+// @Failure      404 {object} types.ErrorResponse "Event or participant not found"
+// @Router       /events/{id}/participants/{userId}/role [put]
+func (ec *EventController) SetParticipantRole(c *gin.Context) {
+	event, ok := loadOwnedEvent(c)
+	if !ok {
+		return
+	}
+
+	participantID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	var req types.SetParticipantRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !req.Role.IsAssignable() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Role must be one of co_organizer, captain, referee or participant",
+		})
+		return
+	}
+
+	previous, err := services.SetParticipantRole(config.DB, &event, event.OrganizerID, uint(participantID), req.Role)
+	if err != nil {
+		respondRoleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Role updated",
+		"event_id":      event.ID,
+		"user_id":       participantID,
+		"role":          req.Role,
+		"previous_role": previous,
+	})
+}
+
+// RemoveEventParticipant godoc
+// @Summary      Remove a participant
+// @Description  Remove a participant from an event (by organizers and co-organizers). Only the organizer can remove co-organizers.
+// @Description  The freed spot goes to the next user on the waitlist and the removed user is notified, with the reason if given.
+// @Description  With ban set the user can't join or ask to join again; this also works for users who only asked to join.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        userId path int true "Participant user ID"
+// @Param        body body types.RemoveParticipantRequest false "Optional reason and ban"
+// @Success      200 {object} gin.H "Participant removed"
+// @Failure      400 {object} types.ErrorResponse "Invalid request"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to remove this participant"
+// @Failure      404 {object} types.ErrorResponse "Event or participant not found"
+// @Router       /events/{id}/participants/{userId} [delete]
+func (ec *EventController) RemoveEventParticipant(c *gin.Context) {
+	event, ok := loadManagedEvent(c)
+	if !ok {
+		return
+	}
+
+	participantID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	actorID := c.GetUint("userID")
+	if uint(participantID) == actorID {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Use the leave endpoint to leave an event yourself",
+		})
+		return
+	}
+
+	role := services.ParticipantRoleFor(config.DB, &event, uint(participantID))
+	if role == types.ParticipantRoleOrganizer || (role == types.ParticipantRoleCoOrganizer && actorID != event.OrganizerID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the organizer can remove co-organizers",
+		})
+		return
+	}
+
+	// The body is optional
+	var req types.RemoveParticipantRequest
+	_ = c.ShouldBindJSON(&req)
+	req.Reason = strings.TrimSpace(req.Reason)
+	if len(req.Reason) > 500 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Reason must be at most 500 characters",
+		})
+		return
+	}
+
+	// Banning also takes the user off the roster, the waitlist and pending requests
+	if req.Ban {
+		err = services.BanFromEvent(config.DB, &event, actorID, uint(participantID), req.Reason)
+	} else {
+		_, err = services.RemoveParticipant(config.DB, event.ID, uint(participantID))
+	}
+	if err != nil {
+		respondRoleError(c, err)
+		return
+	}
+
+	title := "You were removed from an activity"
+	if req.Ban {
+		title = "You were removed from an activity and can't join it again"
+	}
+	body := event.Title
+	if req.Reason != "" {
+		body = fmt.Sprintf("%s: %s", event.Title, req.Reason)
+	}
+	payload := types.JSON{
+		"title":       title,
+		"body":        body,
+		"target_type": "activity",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+	}
+	notif := models.Notification{UserID: uint(participantID), ActorID: &actorID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+	if err := config.DB.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about removal from event %d: %v", participantID, event.ID, err)
+	} else {
+		services.GetNotificationHub().Publish(notif)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Participant removed",
+		"event_id": event.ID,
+		"user_id":  participantID,
+		"banned":   req.Ban,
+	})
+}
+
+// TransferEventOwnership godoc
+// @Summary      Transfer event ownership
+// @Description  Hand the event over to one of its participants (only by organizer). The previous organizer stays on as co-organizer
+// @Description  and the new organizer is notified. Only this event changes hands, not the rest of its series.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Param        body body types.TransferOwnershipRequest true "New organizer"
+// @Success      200 {object} types.EventResponse "Event with its new organizer"
+// @Failure      400 {object} types.ErrorResponse "Invalid request"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the organizer"
+// @Failure      404 {object} types.ErrorResponse "Event or participant not found"
+// @Router       /events/{id}/transfer [post]
+func (ec *EventController) TransferEventOwnership(c *gin.Context) {
+	event, ok := loadOwnedEvent(c)
+	if !ok {
+		return
+	}
+
+	var req types.TransferOwnershipRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.UserID == 0 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "The user ID of the new organizer is required",
+		})
+		return
+	}
+
+	if err := services.TransferOwnership(config.DB, &event, req.UserID); err != nil {
+		respondRoleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, buildEventResponse(event))
+}
+
+// loadOwnedEvent loads the event in the path and checks the current user is its organizer.
+// Co-organizers are not enough: used for role changes and ownership transfer.
+func loadOwnedEvent(c *gin.Context) (models.Event, bool) {
+	event, ok := loadManagedEvent(c)
+	if !ok {
+		return event, false
+	}
+
+	if event.OrganizerID != c.GetUint("userID") {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the organizer can change roles or transfer the event",
+		})
+		return event, false
+	}
+
+	return event, true
+}
+
+// respondRoleError maps role service errors to responses
+func respondRoleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidRole):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Role must be one of co_organizer, captain, referee or participant",
+		})
+	case errors.Is(err, services.ErrAlreadyOrganizer):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "The user already organizes this event",
+		})
+	case errors.Is(err, services.ErrNotParticipating):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not participating",
+			Message: "The user is not participating in this event",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to update the event roster",
+		})
+	}
+}
@@ -0,0 +1,782 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type EventSeriesController struct{}
+
+func NewEventSeriesController() *EventSeriesController {
+	return &EventSeriesController{}
+}
+
+// CreateSeries godoc
+// @Summary      Create a recurring event series
+// @Description  Create a weekly, biweekly or monthly repeating activity. Occurrences are created ahead of time as regular events.
+// @Tags         Event Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        series body types.CreateEventSeriesRequest true "Series data"
+// @Success      201 {object} types.EventSeriesResponse "Series created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /series [post]
+func (sc *EventSeriesController) CreateSeries(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateEventSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if !req.Frequency.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Frequency must be one of weekly, biweekly or monthly",
+		})
+		return
+	}
+	if req.Until != nil && req.Count != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Only one of until or count can be set",
+		})
+		return
+	}
+	if req.Count != nil && (*req.Count < 1 || *req.Count > 520) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Count must be between 1 and 520",
+		})
+		return
+	}
+	if req.Until != nil && req.Until.Before(req.StartAt) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Until must be after the first occurrence",
+		})
+		return
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = types.EventVisibilityPublic
+	}
+	if !visibility.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Visibility must be one of public, followers or invite",
+		})
+		return
+	}
+
+	joinMode := req.JoinMode
+	if joinMode == "" {
+		joinMode = types.JoinModeOpen
+	}
+	if !joinMode.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Join mode must be one of open or approval",
+		})
+		return
+	}
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Unknown time zone",
+		})
+		return
+	}
+
+	var durationMinutes *int
+	if req.EndAt != nil {
+		if !req.EndAt.After(req.StartAt) {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "End time must be after start time",
+			})
+			return
+		}
+		minutes := int(req.EndAt.Sub(req.StartAt).Minutes())
+		durationMinutes = &minutes
+	}
+
+	series := models.EventSeries{
+		OrganizerID:     userID.(uint),
+		Type:            string(req.Type),
+		Title:           req.Title,
+		Description:     req.Description,
+		Sport:           req.Sport,
+		LocationName:    req.LocationName,
+		Latitude:        &req.Latitude,
+		Longitude:       &req.Longitude,
+		Capacity:        req.Capacity,
+		Visibility:      string(visibility),
+		MinReliability:  normalizeMinReliability(req.MinReliability),
+		JoinMode:        string(joinMode),
+		Frequency:       string(req.Frequency),
+		Timezone:        timezone,
+		StartAt:         req.StartAt,
+		DurationMinutes: durationMinutes,
+		Until:           req.Until,
+		Count:           req.Count,
+		Status:          string(types.SeriesStatusActive),
+	}
+
+	if req.ResourceID != nil {
+		respondSeriesResourceUnsupported(c)
+		return
+	}
+	if req.VenueID != nil {
+		venue, err := services.GetVenue(config.DB, *req.VenueID)
+		if err != nil {
+			respondEventVenueError(c, err)
+			return
+		}
+		series.VenueID = &venue.ID
+		series.LocationName = venue.Name
+		series.Latitude = venue.Latitude
+		series.Longitude = venue.Longitude
+	}
+
+	if err := config.DB.Create(&series).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create event series",
+		})
+		return
+	}
+
+	// Create the first occurrences right away instead of waiting for the next run
+	if _, err := services.NewEventSeriesMaterializer().MaterializeSeries(&series); err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create series occurrences",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildEventSeriesResponse(series))
+}
+
+// GetSeries godoc
+// @Summary      Get a recurring event series
+// @Description  Retrieve a series with its upcoming occurrences
+// @Tags         Event Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Series ID"
+// @Success      200 {object} types.EventSeriesResponse "Series details"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Series not found"
+// @Router       /series/{id} [get]
+func (sc *EventSeriesController) GetSeries(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	seriesID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid series ID",
+			Message: "Series ID must be a valid number",
+		})
+		return
+	}
+
+	var series models.EventSeries
+	if err := config.DB.First(&series, seriesID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Series not found",
+			Message: "The requested event series does not exist",
+		})
+		return
+	}
+
+	// Hide series whose occurrences the user may not see
+	if series.OrganizerID != userID.(uint) && series.Visibility != string(types.EventVisibilityPublic) {
+		var visible int64
+		config.DB.Model(&models.Event{}).Scopes(services.VisibleEventsScope(userID.(uint))).Where("series_id = ?", series.ID).Count(&visible)
+		if visible == 0 {
+			c.JSON(http.StatusNotFound, types.ErrorResponse{
+				Error:   "Series not found",
+				Message: "The requested event series does not exist",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, buildEventSeriesResponse(series))
+}
+
+// UpdateSeriesOccurrence godoc
+// @Summary      Update occurrences of a series
+// @Description  Update a single occurrence (scope=this), this and all later occurrences (scope=following) or every upcoming occurrence (scope=all).
+// @Description  For following/all, a changed start or end time is applied as a shift relative to the selected occurrence.
+// @Description  Changing the recurrence rule itself is not supported; cancel the following occurrences and create a new series instead.
+// @Tags         Event Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Series ID"
+// @Param        eventId path int true "Occurrence (event) ID"
+// @Param        scope query string false "this, following or all" default(this)
+// @Param        event body types.UpdateEventRequest true "Updated event data"
+// @Success      200 {object} types.EventSeriesResponse "Series after the update"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to update this series"
+// @Failure      404 {object} types.ErrorResponse "Series or occurrence not found"
+// @Failure      409 {object} types.BookingConflictErrorResponse "An occurrence's booked resource is taken or the venue is closed"
+// @Router       /series/{id}/occurrences/{eventId} [put]
+func (sc *EventSeriesController) UpdateSeriesOccurrence(c *gin.Context) {
+	series, event, scope, ok := sc.loadOccurrenceForEdit(c)
+	if !ok {
+		return
+	}
+
+	var req types.UpdateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if req.Visibility != nil && !req.Visibility.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Visibility must be one of public, followers or invite",
+		})
+		return
+	}
+	if req.JoinMode != nil && !req.JoinMode.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Join mode must be one of open or approval",
+		})
+		return
+	}
+
+	if req.ResourceID != nil {
+		respondSeriesResourceUnsupported(c)
+		return
+	}
+	if !resolveUpdateVenue(c, &req) {
+		return
+	}
+
+	// Time changes are expressed relative to the selected occurrence
+	var shift time.Duration
+	if req.StartAt != nil {
+		shift = req.StartAt.Sub(event.StartAt)
+	}
+	var duration *time.Duration
+	if req.EndAt != nil {
+		d := req.EndAt.Sub(event.StartAt.Add(shift))
+		if d <= 0 {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "End time must be after start time",
+			})
+			return
+		}
+		duration = &d
+	}
+
+	if scope == types.SeriesScopeThis {
+		previousCapacity := event.Capacity
+		applyOccurrenceUpdate(&event, &req, shift, duration)
+		if conflicts, err := saveEventBooking(&event, req.AllowConflicts, func(tx *gorm.DB) error {
+			return tx.Save(&event).Error
+		}); err != nil {
+			respondEventSaveError(c, err, conflicts, "Failed to update event")
+			return
+		}
+		notifySeriesParticipants([]uint{event.ID}, event.OrganizerID, "Activity updated", event.Title)
+		if capacityRaised(previousCapacity, event.Capacity) {
+			promoteSeriesWaitlists([]uint{event.ID})
+		}
+		c.JSON(http.StatusOK, buildEventSeriesResponse(series))
+		return
+	}
+
+	target := series
+	var updatedIDs, raisedIDs []uint
+	var conflicts []types.BookingConflictResponse
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		index := *event.OccurrenceIndex
+		if scope == types.SeriesScopeFollowing && index > 0 {
+			var err error
+			target, err = splitSeries(tx, &series, index)
+			if err != nil {
+				return err
+			}
+		}
+
+		applySeriesTemplateUpdate(&target, &req, shift, duration)
+		if err := tx.Save(&target).Error; err != nil {
+			return err
+		}
+
+		// Past occurrences keep their history; only upcoming ones change
+		var occurrences []models.Event
+		if err := tx.Where("series_id = ? AND status = ?", target.ID, types.EventStatusUpcoming).Find(&occurrences).Error; err != nil {
+			return err
+		}
+		for i := range occurrences {
+			previousCapacity := occurrences[i].Capacity
+			applyOccurrenceUpdate(&occurrences[i], &req, shift, duration)
+			// Occurrences that booked a resource on their own must stay free of conflicts
+			if occurrences[i].ResourceID != nil && !req.AllowConflicts {
+				found, err := services.FindResourceConflicts(tx, *occurrences[i].ResourceID, services.BookingWindow(occurrences[i].StartAt, occurrences[i].EndAt), occurrences[i].ID)
+				if err != nil {
+					return err
+				}
+				conflicts = append(conflicts, buildBookingConflicts(found)...)
+			}
+			if err := tx.Save(&occurrences[i]).Error; err != nil {
+				return err
+			}
+			updatedIDs = append(updatedIDs, occurrences[i].ID)
+			if capacityRaised(previousCapacity, occurrences[i].Capacity) {
+				raisedIDs = append(raisedIDs, occurrences[i].ID)
+			}
+		}
+		if len(conflicts) > 0 {
+			return services.ErrResourceBooked
+		}
+		return nil
+	})
+	if err != nil {
+		respondEventSaveError(c, err, conflicts, "Failed to update event series")
+		return
+	}
+
+	notifySeriesParticipants(updatedIDs, target.OrganizerID, "Activity series updated", target.Title)
+	// A raised capacity frees spots for waitlisted users
+	promoteSeriesWaitlists(raisedIDs)
+	c.JSON(http.StatusOK, buildEventSeriesResponse(target))
+}
+
+// CancelSeriesOccurrence godoc
+// @Summary      Cancel occurrences of a series
+// @Description  Cancel a single occurrence (scope=this), this and all later occurrences (scope=following) or the whole series (scope=all)
+// @Tags         Event Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Series ID"
+// @Param        eventId path int true "Occurrence (event) ID"
+// @Param        scope query string false "this, following or all" default(this)
+// @Success      204 "Occurrences cancelled successfully"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not authorized to cancel this series"
+// @Failure      404 {object} types.ErrorResponse "Series or occurrence not found"
+// @Router       /series/{id}/occurrences/{eventId} [delete]
+func (sc *EventSeriesController) CancelSeriesOccurrence(c *gin.Context) {
+	series, event, scope, ok := sc.loadOccurrenceForEdit(c)
+	if !ok {
+		return
+	}
+
+	var toCancel []models.Event
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		switch scope {
+		case types.SeriesScopeThis:
+			toCancel = []models.Event{event}
+		case types.SeriesScopeFollowing:
+			// End the series just before this occurrence
+			index := *event.OccurrenceIndex
+			if err := tx.Model(&series).Updates(map[string]any{"count": index, "until": nil}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("series_id = ? AND occurrence_index >= ? AND status = ?", series.ID, index, types.EventStatusUpcoming).Find(&toCancel).Error; err != nil {
+				return err
+			}
+		case types.SeriesScopeAll:
+			if err := tx.Model(&series).Update("status", types.SeriesStatusCancelled).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("series_id = ? AND status = ?", series.ID, types.EventStatusUpcoming).Find(&toCancel).Error; err != nil {
+				return err
+			}
+		}
+
+		for i := range toCancel {
+			if err := tx.Model(&toCancel[i]).Update("status", types.EventStatusCancelled).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&toCancel[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to cancel occurrences",
+		})
+		return
+	}
+
+	cancelledIDs := make([]uint, 0, len(toCancel))
+	for _, e := range toCancel {
+		cancelledIDs = append(cancelledIDs, e.ID)
+	}
+
+	title := "Activity cancelled"
+	if scope != types.SeriesScopeThis {
+		title = "Activity series cancelled"
+	}
+	notifySeriesParticipants(cancelledIDs, series.OrganizerID, title, series.Title)
+
+	// Nobody is waiting for a cancelled occurrence any more
+	if len(cancelledIDs) > 0 {
+		config.DB.Where("event_id IN ?", cancelledIDs).Delete(&models.EventWaitlistEntry{})
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// loadOccurrenceForEdit resolves the series, occurrence and scope for organizer-only edits
+func (sc *EventSeriesController) loadOccurrenceForEdit(c *gin.Context) (models.EventSeries, models.Event, types.SeriesEditScope, bool) {
+	var series models.EventSeries
+	var event models.Event
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return series, event, "", false
+	}
+
+	seriesID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid series ID",
+			Message: "Series ID must be a valid number",
+		})
+		return series, event, "", false
+	}
+	eventID, err := strconv.ParseUint(c.Param("eventId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid event ID",
+			Message: "Event ID must be a valid number",
+		})
+		return series, event, "", false
+	}
+
+	scope := types.SeriesEditScope(c.DefaultQuery("scope", string(types.SeriesScopeThis)))
+	if !scope.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid scope",
+			Message: "Scope must be one of this, following or all",
+		})
+		return series, event, "", false
+	}
+
+	if err := config.DB.First(&series, seriesID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Series not found",
+			Message: "The requested event series does not exist",
+		})
+		return series, event, "", false
+	}
+
+	if series.OrganizerID != userID.(uint) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only edit your own event series",
+		})
+		return series, event, "", false
+	}
+
+	if err := config.DB.Where("id = ? AND series_id = ?", eventID, series.ID).First(&event).Error; err != nil || event.OccurrenceIndex == nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested occurrence does not belong to this series",
+		})
+		return series, event, "", false
+	}
+
+	return series, event, scope, true
+}
+
+// splitSeries ends the series before the occurrence at index and moves that occurrence and
+// every later one into a new series, which is returned. Cancelled occurrences move too so
+// they are not re-created.
+func splitSeries(tx *gorm.DB, series *models.EventSeries, index int) (models.EventSeries, error) {
+	next := *series
+	next.ID = 0
+	next.CreatedAt = time.Time{}
+	next.UpdatedAt = time.Time{}
+	next.StartAt = services.SeriesOccurrenceStart(series, index).UTC()
+	next.MaterializedCount = max(series.MaterializedCount-index, 0)
+	if series.Count != nil {
+		remaining := *series.Count - index
+		next.Count = &remaining
+	}
+
+	if err := tx.Create(&next).Error; err != nil {
+		return next, err
+	}
+
+	if err := tx.Model(series).Updates(map[string]any{
+		"count":              index,
+		"until":              nil,
+		"materialized_count": min(series.MaterializedCount, index),
+	}).Error; err != nil {
+		return next, err
+	}
+
+	if err := tx.Unscoped().Model(&models.Event{}).
+		Where("series_id = ? AND occurrence_index >= ?", series.ID, index).
+		Updates(map[string]any{
+			"series_id":        next.ID,
+			"occurrence_index": gorm.Expr("occurrence_index - ?", index),
+		}).Error; err != nil {
+		return next, err
+	}
+
+	return next, nil
+}
+
+// capacityRaised reports whether a capacity change frees spots; a nil capacity is unlimited
+func capacityRaised(previous, updated *int) bool {
+	if previous == nil {
+		return false
+	}
+	return updated == nil || *updated > *previous
+}
+
+// promoteSeriesWaitlists fills the spots freed on the given occurrences from their waitlists
+func promoteSeriesWaitlists(eventIDs []uint) {
+	for _, id := range eventIDs {
+		if _, err := services.PromoteFromWaitlist(config.DB, id); err != nil {
+			log.Printf("Failed to promote waitlisted users for event %d: %v", id, err)
+		}
+	}
+}
+
+// applyOccurrenceUpdate applies the provided fields to one occurrence; start/end are shifted
+// by the same offset as the edited occurrence so every occurrence keeps its own date
+func applyOccurrenceUpdate(event *models.Event, req *types.UpdateEventRequest, shift time.Duration, duration *time.Duration) {
+	if req.Type != nil {
+		event.Type = string(*req.Type)
+	}
+	if req.Title != nil {
+		event.Title = *req.Title
+	}
+	if req.Description != nil {
+		event.Description = *req.Description
+	}
+	if req.Sport != nil {
+		event.Sport = *req.Sport
+	}
+	if req.LocationName != nil {
+		event.LocationName = *req.LocationName
+	}
+	if req.Latitude != nil {
+		event.Latitude = req.Latitude
+	}
+	if req.Longitude != nil {
+		event.Longitude = req.Longitude
+	}
+	previousVenueID := event.VenueID
+	event.VenueID = updatedVenueID(event.VenueID, req)
+	event.ResourceID = updatedResourceID(event.ResourceID, previousVenueID, event.VenueID, req)
+	if req.Capacity != nil {
+		event.Capacity = req.Capacity
+	}
+	if req.Visibility != nil {
+		event.Visibility = string(*req.Visibility)
+	}
+	if req.MinReliability != nil {
+		event.MinReliability = normalizeMinReliability(req.MinReliability)
+	}
+	if req.JoinMode != nil {
+		event.JoinMode = string(*req.JoinMode)
+	}
+
+	if shift != 0 {
+		event.StartAt = event.StartAt.Add(shift)
+		if event.EndAt != nil && duration == nil {
+			end := event.EndAt.Add(shift)
+			event.EndAt = &end
+		}
+	}
+	if duration != nil {
+		end := event.StartAt.Add(*duration)
+		event.EndAt = &end
+	}
+
+	event.Status = string(types.CalculateEventStatus(event.StartAt, event.EndAt))
+}
+
+// applySeriesTemplateUpdate keeps the series template in line with its updated occurrences
+func applySeriesTemplateUpdate(series *models.EventSeries, req *types.UpdateEventRequest, shift time.Duration, duration *time.Duration) {
+	if req.Type != nil {
+		series.Type = string(*req.Type)
+	}
+	if req.Title != nil {
+		series.Title = *req.Title
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+	if req.Sport != nil {
+		series.Sport = *req.Sport
+	}
+	if req.LocationName != nil {
+		series.LocationName = *req.LocationName
+	}
+	if req.Latitude != nil {
+		series.Latitude = req.Latitude
+	}
+	if req.Longitude != nil {
+		series.Longitude = req.Longitude
+	}
+	series.VenueID = updatedVenueID(series.VenueID, req)
+	if req.Capacity != nil {
+		series.Capacity = req.Capacity
+	}
+	if req.Visibility != nil {
+		series.Visibility = string(*req.Visibility)
+	}
+	if req.MinReliability != nil {
+		series.MinReliability = normalizeMinReliability(req.MinReliability)
+	}
+	if req.JoinMode != nil {
+		series.JoinMode = string(*req.JoinMode)
+	}
+
+	if shift != 0 {
+		series.StartAt = series.StartAt.Add(shift)
+		if series.Until != nil {
+			until := series.Until.Add(shift)
+			series.Until = &until
+		}
+	}
+	if duration != nil {
+		minutes := int(duration.Minutes())
+		series.DurationMinutes = &minutes
+	}
+}
+
+// notifySeriesParticipants sends one notification to every participant or waitlisted user
+// of the given occurrences, even if they are signed up for several of them
+func notifySeriesParticipants(eventIDs []uint, organizerID uint, title, body string) {
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	var userIDs []uint
+	config.DB.Model(&models.EventParticipant{}).Where("event_id IN ?", eventIDs).Distinct().Pluck("user_id", &userIDs)
+	var waitlisted []uint
+	config.DB.Model(&models.EventWaitlistEntry{}).Where("event_id IN ?", eventIDs).Distinct().Pluck("user_id", &waitlisted)
+
+	seen := make(map[uint]bool)
+	for _, uid := range append(userIDs, waitlisted...) {
+		if uid == organizerID || seen[uid] {
+			continue
+		}
+		seen[uid] = true
+
+		payload := types.JSON{
+			"title":       title,
+			"body":        body,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", eventIDs[0]),
+		}
+		notif := models.Notification{UserID: uid, ActorID: &organizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := config.DB.Create(&notif).Error; err == nil {
+			services.GetNotificationHub().Publish(notif)
+		}
+	}
+}
+
+// buildEventSeriesResponse converts a series into its API representation with upcoming occurrences
+func buildEventSeriesResponse(series models.EventSeries) types.EventSeriesResponse {
+	var events []models.Event
+	config.DB.Where("series_id = ? AND status = ?", series.ID, types.EventStatusUpcoming).Order("start_at ASC").Find(&events)
+
+	occurrences := make([]types.EventResponse, 0, len(events))
+	for _, event := range events {
+		occurrences = append(occurrences, buildEventResponse(event))
+	}
+
+	return types.EventSeriesResponse{
+		ID:              series.ID,
+		OrganizerID:     series.OrganizerID,
+		Type:            types.EventType(series.Type),
+		Title:           series.Title,
+		Description:     series.Description,
+		Sport:           series.Sport,
+		LocationName:    series.LocationName,
+		Latitude:        *series.Latitude,
+		Longitude:       *series.Longitude,
+		VenueID:         series.VenueID,
+		Capacity:        series.Capacity,
+		Visibility:      types.EventVisibility(series.Visibility),
+		Frequency:       types.RecurrenceFrequency(series.Frequency),
+		Timezone:        series.Timezone,
+		StartAt:         series.StartAt,
+		DurationMinutes: series.DurationMinutes,
+		Until:           series.Until,
+		Count:           series.Count,
+		RRule:           types.BuildRRule(types.RecurrenceFrequency(series.Frequency), series.Until, series.Count),
+		Status:          types.SeriesStatus(series.Status),
+		Occurrences:     occurrences,
+		CreatedAt:       series.CreatedAt,
+		UpdatedAt:       series.UpdatedAt,
+	}
+}
+
+// respondSeriesResourceUnsupported rejects booking resources for recurring series: every
+// occurrence would need its own conflict check. Single occurrences can be booked as events.
+func respondSeriesResourceUnsupported(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, types.ErrorResponse{
+		Error:   "Invalid request",
+		Message: "Resources can't be booked for a whole series; book them on individual occurrences instead",
+	})
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// joinEventAsTeam signs up the captain's whole team for the event in one go
+func joinEventAsTeam(c *gin.Context, event *models.Event, userID uint) {
+	team, ok := loadTeamFromQuery(c, userID)
+	if !ok {
+		return
+	}
+
+	joined, skipped, err := services.RegisterTeamForEvent(config.DB, event, &team, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTeamDoesNotFit):
+			c.JSON(http.StatusConflict, types.ErrorResponse{
+				Error:   "Event full",
+				Message: "There are not enough free spots for the whole team",
+			})
+		case errors.Is(err, services.ErrTeamNeedsApproval):
+			c.JSON(http.StatusForbidden, types.ErrorResponse{
+				Error:   "Approval required",
+				Message: "This event requires approval; teams can only join once their captain has been invited",
+			})
+		case errors.Is(err, services.ErrNoEligibleTeamMembers):
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Nobody to sign up",
+				Message: "None of the team's players can join this event",
+			})
+		case errors.Is(err, services.ErrEventNotUpcoming):
+			c.JSON(http.StatusConflict, types.ErrorResponse{
+				Error:   "Event unavailable",
+				Message: "Cannot join this event",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to join event",
+			})
+		}
+		return
+	}
+
+	response := types.TeamEventRegistrationResponse{
+		Message: "Team joined the event",
+		EventID: event.ID,
+		TeamID:  team.ID,
+		Joined:  make([]uint, 0, len(joined)),
+		Skipped: make([]types.TeamSkippedMemberResponse, 0, len(skipped)),
+	}
+	for _, p := range joined {
+		response.Joined = append(response.Joined, p.UserID)
+	}
+	for _, s := range skipped {
+		response.Skipped = append(response.Skipped, types.TeamSkippedMemberResponse{UserID: s.UserID, Reason: s.Reason})
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// leaveEventAsTeam withdraws every player the captain's team signed up for the event
+func leaveEventAsTeam(c *gin.Context, event *models.Event, userID uint) {
+	team, ok := loadTeamFromQuery(c, userID)
+	if !ok {
+		return
+	}
+
+	if _, err := services.WithdrawTeamFromEvent(config.DB, event.ID, team.ID); err != nil {
+		if errors.Is(err, services.ErrTeamNotRegistered) {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Not participating",
+				Message: "The team is not signed up for this event",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to leave event",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Team successfully left event",
+		"event_id": event.ID,
+		"team_id":  team.ID,
+	})
+}
+
+// loadTeamFromQuery loads the team_id query parameter's team and checks the user captains it
+func loadTeamFromQuery(c *gin.Context, userID uint) (models.Team, bool) {
+	var team models.Team
+
+	teamID, err := strconv.ParseUint(c.Query("team_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid team ID",
+			Message: "Team ID must be a valid number",
+		})
+		return team, false
+	}
+
+	if err := config.DB.First(&team, teamID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Team not found",
+			Message: "The requested team does not exist",
+		})
+		return team, false
+	}
+
+	if !services.IsTeamCaptain(config.DB, team.ID, userID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only captains can sign their team up for events",
+		})
+		return team, false
+	}
+
+	return team, true
+}
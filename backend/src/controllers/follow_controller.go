@@ -5,6 +5,7 @@ import (
 	"backend/src/models"
 	"backend/src/types"
 	"backend/src/services"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -20,17 +21,19 @@ func NewFollowController() *FollowController {
 
 // FollowUser godoc
 // @Summary      Follow a user
-// @Description  Follow another user by their ID
+// @Description  Follow another user by their ID. Following a private account sends a follow request that it has to approve.
 // @Tags         Follow
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "User ID to follow" example(12345)
 // @Success      200 {object} types.FollowResponse "Successfully followed user"
+// @Success      202 {object} types.FollowResponse "Follow request sent to a private account"
 // @Failure      400 {object} types.ErrorResponse "Invalid user ID or trying to follow yourself"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Blocked"
 // @Failure      404 {object} types.ErrorResponse "User not found"
-// @Failure      409 {object} types.ErrorResponse "Already following user"
+// @Failure      409 {object} types.ErrorResponse "Already following user or request already pending"
 // @Router       /users/{id}/follow [post]
 func (fc *FollowController) FollowUser(c *gin.Context) {
 	currentUserID, exists := c.Get("userID")
@@ -69,6 +72,14 @@ func (fc *FollowController) FollowUser(c *gin.Context) {
 		return
 	}
 
+	if services.IsBlockedBetween(config.DB, currentUserID.(uint), uint(targetUserID)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You cannot follow this user",
+		})
+		return
+	}
+
 	var existingFollow models.Follow
 	if err := config.DB.Where("follower_id = ? AND followed_id = ?", currentUserID, targetUserID).First(&existingFollow).Error; err == nil {
 		c.JSON(http.StatusConflict, types.ErrorResponse{
@@ -78,6 +89,31 @@ func (fc *FollowController) FollowUser(c *gin.Context) {
 		return
 	}
 
+	// Private accounts approve their followers first
+	if targetUser.IsPrivate {
+		if err := services.RequestToFollow(config.DB, currentUserID.(uint), targetUser.ID); err != nil {
+			if errors.Is(err, services.ErrFollowRequestPending) {
+				c.JSON(http.StatusConflict, types.ErrorResponse{
+					Error:   "Already requested",
+					Message: "Your follow request is waiting for approval",
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to send follow request",
+			})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, types.FollowResponse{
+			Success:     true,
+			Message:     fmt.Sprintf("Follow request sent to %s", targetUser.Username),
+			IsRequested: true,
+		})
+		return
+	}
+
 	follow := models.Follow{
 		FollowerID: currentUserID.(uint),
 		FollowedID: uint(targetUserID),
@@ -232,15 +268,20 @@ func (fc *FollowController) GetFollowStatus(c *gin.Context) {
 	config.DB.Model(&models.Follow{}).Where("follower_id = ? AND followed_id = ?", currentUserID, targetUserID).Count(&followCount)
 	isFollowing := followCount > 0
 
+	isRequested := !isFollowing && services.HasPendingFollowRequest(config.DB, currentUserID.(uint), uint(targetUserID))
+
 	message := "Not following user"
 	if isFollowing {
 		message = "Following user"
+	} else if isRequested {
+		message = "Follow request pending"
 	}
 
 	c.JSON(http.StatusOK, types.FollowResponse{
 		Success:     true,
 		Message:     message,
 		IsFollowing: isFollowing,
+		IsRequested: isRequested,
 	})
 }
 
@@ -257,6 +298,7 @@ func (fc *FollowController) GetFollowStatus(c *gin.Context) {
 // @Success      200 {object} types.FollowListResponse "List of followers"
 // @Failure      400 {object} types.ErrorResponse "Invalid parameters"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Private account not followed"
 // @Router       /users/{id}/followers [get]
 func (fc *FollowController) GetFollowers(c *gin.Context) {
 	currentUserID, exists := c.Get("userID")
@@ -278,6 +320,14 @@ func (fc *FollowController) GetFollowers(c *gin.Context) {
 		return
 	}
 
+	if !services.CanSeePrivateContent(config.DB, currentUserID.(uint), uint(targetUserID)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Private account",
+			Message: "Follow this user to see who they follow and who follows them",
+		})
+		return
+	}
+
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 	if page < 1 {
@@ -362,9 +412,10 @@ func (fc *FollowController) GetFollowers(c *gin.Context) {
 // @Success      200 {object} types.FollowListResponse "List of following"
 // @Failure      400 {object} types.ErrorResponse "Invalid parameters"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Private account not followed"
 // @Router       /users/{id}/following [get]
 func (fc *FollowController) GetFollowing(c *gin.Context) {
-	_, exists := c.Get("userID")
+	currentUserID, exists := c.Get("userID")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
 			Error:   "Unauthorized",
@@ -383,6 +434,14 @@ func (fc *FollowController) GetFollowing(c *gin.Context) {
 		return
 	}
 
+	if !services.CanSeePrivateContent(config.DB, currentUserID.(uint), uint(targetUserID)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Private account",
+			Message: "Follow this user to see who they follow and who follows them",
+		})
+		return
+	}
+
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 	if page < 1 {
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetFollowRequests godoc
+// @Summary      List follow requests
+// @Description  List the pending requests to follow the current user, oldest first
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.FollowRequestResponse "Pending follow requests"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/follow-requests [get]
+func (fc *FollowController) GetFollowRequests(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var requests []models.FollowRequest
+	if err := config.DB.Preload("Requester").
+		Scopes(services.BlockedUsersScope(userID.(uint), "follow_requests.requester_id")).
+		Where("target_id = ?", userID).
+		Order("created_at ASC").
+		Find(&requests).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch follow requests",
+		})
+		return
+	}
+
+	response := make([]types.FollowRequestResponse, 0, len(requests))
+	for _, r := range requests {
+		response = append(response, types.FollowRequestResponse{
+			ID:          r.Requester.ID,
+			Username:    r.Requester.Username,
+			DisplayName: r.Requester.DisplayName,
+			AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", r.Requester.ID),
+			HasAvatar:   len(r.Requester.AvatarData) > 0,
+			RequestedAt: r.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// ApproveFollowRequest godoc
+// @Summary      Approve a follow request
+// @Description  Let the user follow you. They are notified.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Requesting user ID"
+// @Success      200 {object} types.FollowResponse "Follow request approved"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "No pending follow request"
+// @Router       /users/follow-requests/{id}/approve [post]
+func (fc *FollowController) ApproveFollowRequest(c *gin.Context) {
+	userID, requesterID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.ApproveFollowRequest(config.DB, userID, requesterID); err != nil {
+		respondFollowRequestError(c, err, "Failed to approve follow request")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.FollowResponse{
+		Success: true,
+		Message: "Follow request approved",
+	})
+}
+
+// DenyFollowRequest godoc
+// @Summary      Deny a follow request
+// @Description  Turn down a follow request. The user is not notified and may ask again.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Requesting user ID"
+// @Success      200 {object} types.FollowResponse "Follow request denied"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "No pending follow request"
+// @Router       /users/follow-requests/{id}/deny [post]
+func (fc *FollowController) DenyFollowRequest(c *gin.Context) {
+	userID, requesterID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.DenyFollowRequest(config.DB, userID, requesterID); err != nil {
+		respondFollowRequestError(c, err, "Failed to deny follow request")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.FollowResponse{
+		Success: true,
+		Message: "Follow request denied",
+	})
+}
+
+// CancelFollowRequest godoc
+// @Summary      Cancel a follow request
+// @Description  Withdraw your pending request to follow a private account
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID the request was sent to"
+// @Success      200 {object} types.FollowResponse "Follow request cancelled"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "No pending follow request"
+// @Router       /users/{id}/follow-request [delete]
+func (fc *FollowController) CancelFollowRequest(c *gin.Context) {
+	userID, targetID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.CancelFollowRequest(config.DB, userID, targetID); err != nil {
+		respondFollowRequestError(c, err, "Failed to cancel follow request")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.FollowResponse{
+		Success: true,
+		Message: "Follow request cancelled",
+	})
+}
+
+// respondFollowRequestError maps follow request service errors to responses
+func respondFollowRequestError(c *gin.Context, err error, fallback string) {
+	if errors.Is(err, services.ErrNoFollowRequest) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Request not found",
+			Message: "There is no pending follow request",
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+		Error:   "Database error",
+		Message: fallback,
+	})
+}
@@ -0,0 +1,728 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GroupController struct{}
+
+func NewGroupController() *GroupController {
+	return &GroupController{}
+}
+
+// CreateGroup godoc
+// @Summary      Create a group
+// @Description  Create a club/group with you as its admin. Public groups can be joined directly; private groups take join requests and hide their posts and events from non-members.
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        group body types.CreateGroupRequest true "Group data"
+// @Success      201 {object} types.GroupResponse "Group created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /groups [post]
+func (gc *GroupController) CreateGroup(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if req.Visibility != "" && !req.Visibility.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Visibility must be public or private",
+		})
+		return
+	}
+
+	group, err := services.CreateGroup(config.DB, userID.(uint), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create group",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildGroupResponse(*group, userID.(uint)))
+}
+
+// GetGroups godoc
+// @Summary      List groups
+// @Description  List groups, newest first, optionally by sport, city or name. Private groups are listed too; only their content is hidden.
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        sport query string false "Only groups of this sport"
+// @Param        city query string false "Only groups in this city"
+// @Param        q query string false "Search in group names"
+// @Param        limit query int false "Maximum number of groups" default(20)
+// @Param        offset query int false "Number of groups to skip" default(0)
+// @Success      200 {array} types.GroupResponse "Groups"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /groups [get]
+func (gc *GroupController) GetGroups(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	query := config.DB.Model(&models.Group{})
+	if sport := c.Query("sport"); sport != "" {
+		query = query.Where("LOWER(sport) = LOWER(?)", sport)
+	}
+	if city := c.Query("city"); city != "" {
+		query = query.Where("LOWER(city) = LOWER(?)", city)
+	}
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(q)+"%")
+	}
+
+	var groups []models.Group
+	if err := query.Order("created_at DESC").Limit(limit).Offset(max(offset, 0)).Find(&groups).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch groups",
+		})
+		return
+	}
+
+	userID := c.GetUint("userID")
+	responses := make([]types.GroupResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, buildGroupResponse(group, userID))
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetMyGroups godoc
+// @Summary      Get my groups
+// @Description  List the groups the current user is a member of
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.GroupResponse "Groups of the current user"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /groups/my [get]
+func (gc *GroupController) GetMyGroups(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var groups []models.Group
+	if err := config.DB.Where("id IN (?)", config.DB.Model(&models.GroupMember{}).Select("group_id").Where("user_id = ?", userID)).
+		Order("name ASC").Find(&groups).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch groups",
+		})
+		return
+	}
+
+	responses := make([]types.GroupResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, buildGroupResponse(group, userID.(uint)))
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetGroup godoc
+// @Summary      Get a group
+// @Description  Get a group's details. Its feed is available through /posts and /events with scope=group:<id>.
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      200 {object} types.GroupResponse "Group"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Router       /groups/{id} [get]
+func (gc *GroupController) GetGroup(c *gin.Context) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, buildGroupResponse(group, c.GetUint("userID")))
+}
+
+// UpdateGroup godoc
+// @Summary      Update a group
+// @Description  Change a group's details or visibility (by its admins)
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Param        group body types.UpdateGroupRequest true "Fields to change"
+// @Success      200 {object} types.GroupResponse "Group updated successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin of the group"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Router       /groups/{id} [put]
+func (gc *GroupController) UpdateGroup(c *gin.Context) {
+	group, ok := loadAdministeredGroup(c)
+	if !ok {
+		return
+	}
+
+	var req types.UpdateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if req.Visibility != nil && !req.Visibility.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Visibility must be public or private",
+		})
+		return
+	}
+
+	updates := map[string]any{}
+	if req.Name != nil {
+		updates["name"] = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Sport != nil {
+		updates["sport"] = strings.TrimSpace(*req.Sport)
+	}
+	if req.City != nil {
+		updates["city"] = strings.TrimSpace(*req.City)
+	}
+	if req.Visibility != nil {
+		updates["visibility"] = string(*req.Visibility)
+	}
+	if len(updates) > 0 {
+		if err := config.DB.Model(&group).Updates(updates).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to update group",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, buildGroupResponse(group, c.GetUint("userID")))
+}
+
+// DeleteGroup godoc
+// @Summary      Delete a group
+// @Description  Delete a group (by its admins). Its posts and events are kept but no longer belong to a group.
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      204 "Group deleted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin of the group"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Router       /groups/{id} [delete]
+func (gc *GroupController) DeleteGroup(c *gin.Context) {
+	group, ok := loadAdministeredGroup(c)
+	if !ok {
+		return
+	}
+
+	if err := services.DeleteGroup(config.DB, &group); err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to delete group",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetGroupMembers godoc
+// @Summary      List group members
+// @Description  List a group's members, admins and moderators first. Members of private groups are only visible to other members.
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      200 {array} types.GroupMemberResponse "Members"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Private group"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Router       /groups/{id}/members [get]
+func (gc *GroupController) GetGroupMembers(c *gin.Context) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return
+	}
+
+	if !services.CanViewGroupContent(config.DB, &group, c.GetUint("userID")) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only members can see who is in a private group",
+		})
+		return
+	}
+
+	var members []models.GroupMember
+	if err := config.DB.Preload("User").Where("group_id = ?", group.ID).
+		Order("CASE role WHEN 'admin' THEN 0 WHEN 'moderator' THEN 1 ELSE 2 END, joined_at ASC").
+		Find(&members).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch members",
+		})
+		return
+	}
+
+	response := make([]types.GroupMemberResponse, 0, len(members))
+	for _, m := range members {
+		response = append(response, types.GroupMemberResponse{
+			UserID:      m.UserID,
+			Username:    m.User.Username,
+			DisplayName: m.User.DisplayName,
+			AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", m.UserID),
+			Role:        types.GroupRole(m.Role),
+			JoinedAt:    m.JoinedAt,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// JoinGroup godoc
+// @Summary      Join a group
+// @Description  Join a public group right away, or ask to join a private one. Admins and moderators are notified of requests.
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      200 {object} types.GroupResponse "Joined the group"
+// @Success      202 {object} types.GroupJoinRequestResponse "Join request sent"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Earlier request was rejected"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Failure      409 {object} types.ErrorResponse "Already a member or request pending"
+// @Router       /groups/{id}/join [post]
+func (gc *GroupController) JoinGroup(c *gin.Context) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint("userID")
+	request, err := services.JoinGroup(config.DB, &group, userID)
+	if err != nil {
+		respondGroupError(c, err, "Failed to join group")
+		return
+	}
+	if request == nil {
+		c.JSON(http.StatusOK, buildGroupResponse(group, userID))
+		return
+	}
+
+	config.DB.First(&request.User, userID)
+	c.JSON(http.StatusAccepted, buildGroupJoinRequestResponse(*request))
+}
+
+// CancelGroupJoinRequest godoc
+// @Summary      Withdraw a join request
+// @Description  Withdraw your pending request to join a private group
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      204 "Request withdrawn"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Group or pending request not found"
+// @Router       /groups/{id}/join-requests [delete]
+func (gc *GroupController) CancelGroupJoinRequest(c *gin.Context) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return
+	}
+
+	if err := services.CancelGroupJoinRequest(config.DB, group.ID, c.GetUint("userID")); err != nil {
+		respondGroupError(c, err, "Failed to withdraw request")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetGroupJoinRequests godoc
+// @Summary      List join requests
+// @Description  List pending requests to join a group (for its admins and moderators)
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Success      200 {array} types.GroupJoinRequestResponse "Pending join requests"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin or moderator of the group"
+// @Failure      404 {object} types.ErrorResponse "Group not found"
+// @Router       /groups/{id}/join-requests [get]
+func (gc *GroupController) GetGroupJoinRequests(c *gin.Context) {
+	group, ok := loadModeratedGroup(c)
+	if !ok {
+		return
+	}
+
+	var requests []models.GroupJoinRequest
+	if err := config.DB.Preload("User").Where("group_id = ? AND status = ?", group.ID, types.ParticipationStatusPending).
+		Order("created_at ASC").Find(&requests).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch join requests",
+		})
+		return
+	}
+
+	response := make([]types.GroupJoinRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		response = append(response, buildGroupJoinRequestResponse(request))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptGroupJoinRequest godoc
+// @Summary      Accept a join request
+// @Description  Accept a user's request to join the group (by its admins and moderators)
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} types.GroupResponse "Request accepted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin or moderator of the group"
+// @Failure      404 {object} types.ErrorResponse "Group or pending request not found"
+// @Router       /groups/{id}/join-requests/{userId}/accept [post]
+func (gc *GroupController) AcceptGroupJoinRequest(c *gin.Context) {
+	respondToGroupJoinRequest(c, true)
+}
+
+// RejectGroupJoinRequest godoc
+// @Summary      Reject a join request
+// @Description  Reject a user's request to join the group (by its admins and moderators). The user can't ask again.
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} types.GroupResponse "Request rejected"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin or moderator of the group"
+// @Failure      404 {object} types.ErrorResponse "Group or pending request not found"
+// @Router       /groups/{id}/join-requests/{userId}/reject [post]
+func (gc *GroupController) RejectGroupJoinRequest(c *gin.Context) {
+	respondToGroupJoinRequest(c, false)
+}
+
+// SetGroupMemberRole godoc
+// @Summary      Change a member's role
+// @Description  Make a member admin, moderator or regular member (by the group's admins). The last admin can't step down.
+// @Tags         Groups
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Param        userId path int true "Member's user ID"
+// @Param        role body types.SetGroupRoleRequest true "New role"
+// @Success      200 {object} types.GroupResponse "Role changed"
+// @Failure      400 {object} types.ErrorResponse "Invalid role"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not an admin of the group"
+// @Failure      404 {object} types.ErrorResponse "Group or member not found"
+// @Failure      409 {object} types.ErrorResponse "Last admin"
+// @Router       /groups/{id}/members/{userId}/role [put]
+func (gc *GroupController) SetGroupMemberRole(c *gin.Context) {
+	group, ok := loadAdministeredGroup(c)
+	if !ok {
+		return
+	}
+	memberID, ok := parseGroupUserID(c)
+	if !ok {
+		return
+	}
+
+	var req types.SetGroupRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !req.Role.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Role must be admin, moderator or member",
+		})
+		return
+	}
+
+	if err := services.SetGroupMemberRole(config.DB, &group, memberID, req.Role); err != nil {
+		respondGroupError(c, err, "Failed to change role")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildGroupResponse(group, c.GetUint("userID")))
+}
+
+// RemoveGroupMember godoc
+// @Summary      Remove a member or leave
+// @Description  Leave a group, or remove a member from it. Moderators can remove regular members; admins anyone. The last admin can't leave.
+// @Tags         Groups
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Group ID"
+// @Param        userId path int true "Member's user ID"
+// @Success      204 "Member removed"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to remove this member"
+// @Failure      404 {object} types.ErrorResponse "Group or member not found"
+// @Failure      409 {object} types.ErrorResponse "Last admin"
+// @Router       /groups/{id}/members/{userId} [delete]
+func (gc *GroupController) RemoveGroupMember(c *gin.Context) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return
+	}
+	memberID, ok := parseGroupUserID(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint("userID")
+	actorRole := types.GroupRoleAdmin
+	if memberID != userID {
+		actorRole = services.GroupRoleFor(config.DB, group.ID, userID)
+		if actorRole != types.GroupRoleAdmin && actorRole != types.GroupRoleModerator {
+			c.JSON(http.StatusForbidden, types.ErrorResponse{
+				Error:   "Forbidden",
+				Message: "Only admins and moderators can remove other members",
+			})
+			return
+		}
+	}
+
+	if err := services.RemoveGroupMember(config.DB, &group, actorRole, memberID); err != nil {
+		respondGroupError(c, err, "Failed to remove member")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondToGroupJoinRequest accepts or rejects the join request of the user in the path
+func respondToGroupJoinRequest(c *gin.Context, accept bool) {
+	group, ok := loadModeratedGroup(c)
+	if !ok {
+		return
+	}
+	requesterID, ok := parseGroupUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := services.RespondToGroupJoinRequest(config.DB, &group, c.GetUint("userID"), requesterID, accept); err != nil {
+		respondGroupError(c, err, "Failed to answer join request")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildGroupResponse(group, c.GetUint("userID")))
+}
+
+// loadGroup loads the group in the path, responding on failure
+func loadGroup(c *gin.Context) (models.Group, bool) {
+	var group models.Group
+
+	if _, exists := c.Get("userID"); !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return group, false
+	}
+
+	groupID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid group ID",
+			Message: "Group ID must be a valid number",
+		})
+		return group, false
+	}
+
+	if err := config.DB.First(&group, groupID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Group not found",
+			Message: "The requested group does not exist",
+		})
+		return group, false
+	}
+
+	return group, true
+}
+
+// loadAdministeredGroup loads the group in the path and checks the current user is an admin
+func loadAdministeredGroup(c *gin.Context) (models.Group, bool) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return group, false
+	}
+
+	if services.GroupRoleFor(config.DB, group.ID, c.GetUint("userID")) != types.GroupRoleAdmin {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only admins can manage the group",
+		})
+		return group, false
+	}
+
+	return group, true
+}
+
+// loadModeratedGroup loads the group in the path and checks the current user is an admin or moderator
+func loadModeratedGroup(c *gin.Context) (models.Group, bool) {
+	group, ok := loadGroup(c)
+	if !ok {
+		return group, false
+	}
+
+	if !services.CanModerateGroup(config.DB, group.ID, c.GetUint("userID")) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only admins and moderators can do this",
+		})
+		return group, false
+	}
+
+	return group, true
+}
+
+// parseGroupUserID reads the userId path parameter
+func parseGroupUserID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGroupError maps group service errors to responses
+func respondGroupError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrNotGroupMember), errors.Is(err, services.ErrNoGroupJoinRequest):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrAlreadyGroupMember):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Already a member",
+			Message: "You are already a member of this group",
+		})
+	case errors.Is(err, services.ErrGroupJoinRequestPending):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Request pending",
+			Message: "Your request to join is waiting for an admin or moderator",
+		})
+	case errors.Is(err, services.ErrGroupJoinRequestRejected):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Request rejected",
+			Message: "Your request to join was declined",
+		})
+	case errors.Is(err, services.ErrGroupRoleTooHigh):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Moderators can only remove regular members",
+		})
+	case errors.Is(err, services.ErrLastGroupAdmin):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Last admin",
+			Message: "Make another member admin first, or delete the group",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildGroupResponse converts a group with the current user's role or pending request
+func buildGroupResponse(group models.Group, userID uint) types.GroupResponse {
+	var memberCount int64
+	config.DB.Model(&models.GroupMember{}).Where("group_id = ?", group.ID).Count(&memberCount)
+
+	response := types.GroupResponse{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		Sport:       group.Sport,
+		City:        group.City,
+		Visibility:  types.GroupVisibility(group.Visibility),
+		CreatedByID: group.CreatedByID,
+		MemberCount: int(memberCount),
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+	if role := services.GroupRoleFor(config.DB, group.ID, userID); role != "" {
+		response.MyRole = &role
+	} else {
+		var request models.GroupJoinRequest
+		if config.DB.Select("status").Where("group_id = ? AND user_id = ?", group.ID, userID).Limit(1).Find(&request).RowsAffected > 0 {
+			response.MyRequest = &request.Status
+		}
+	}
+	return response
+}
+
+// buildGroupJoinRequestResponse converts a join request with its User loaded
+func buildGroupJoinRequestResponse(request models.GroupJoinRequest) types.GroupJoinRequestResponse {
+	return types.GroupJoinRequestResponse{
+		ID:          request.ID,
+		GroupID:     request.GroupID,
+		UserID:      request.UserID,
+		Username:    request.User.Username,
+		DisplayName: request.User.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", request.UserID),
+		Status:      types.ParticipationStatus(request.Status),
+		CreatedAt:   request.CreatedAt,
+	}
+}
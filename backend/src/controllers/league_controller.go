@@ -0,0 +1,735 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LeagueController struct{}
+
+func NewLeagueController() *LeagueController {
+	return &LeagueController{}
+}
+
+// CreateLeague godoc
+// @Summary      Create a league
+// @Description  Create a league that runs over seasons. Its fixtures are played at the league's home ground or venue.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        league body types.CreateLeagueRequest true "League data"
+// @Success      201 {object} types.LeagueResponse "League created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /leagues [post]
+func (lc *LeagueController) CreateLeague(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateLeagueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	league := models.League{
+		OrganizerID:  userID.(uint),
+		Name:         strings.TrimSpace(req.Name),
+		Description:  req.Description,
+		Sport:        strings.TrimSpace(req.Sport),
+		LocationName: req.LocationName,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		Timezone:     "UTC",
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			respondUnknownTimezone(c)
+			return
+		}
+		league.Timezone = req.Timezone
+	}
+	if req.VenueID != nil {
+		venue, err := services.GetVenue(config.DB, *req.VenueID)
+		if err != nil {
+			respondEventVenueError(c, err)
+			return
+		}
+		league.VenueID = &venue.ID
+		league.LocationName = venue.Name
+		league.Latitude = venue.Latitude
+		league.Longitude = venue.Longitude
+	}
+	if league.Latitude == nil || league.Longitude == nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Either venue_id or latitude and longitude are required",
+		})
+		return
+	}
+
+	if err := config.DB.Create(&league).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create league",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildLeagueResponse(league))
+}
+
+// GetLeagues godoc
+// @Summary      List leagues
+// @Description  List leagues, newest first, optionally by sport or name
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        sport query string false "Only leagues of this sport"
+// @Param        q query string false "Search in league names"
+// @Param        limit query int false "Maximum number of leagues" default(20)
+// @Param        offset query int false "Number of leagues to skip" default(0)
+// @Success      200 {array} types.LeagueResponse "Leagues"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /leagues [get]
+func (lc *LeagueController) GetLeagues(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	query := config.DB.Model(&models.League{})
+	if sport := c.Query("sport"); sport != "" {
+		query = query.Where("LOWER(sport) = LOWER(?)", sport)
+	}
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(q)+"%")
+	}
+
+	var leagues []models.League
+	if err := query.Order("created_at DESC").Limit(limit).Offset(max(offset, 0)).Find(&leagues).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch leagues",
+		})
+		return
+	}
+
+	responses := make([]types.LeagueResponse, 0, len(leagues))
+	for _, league := range leagues {
+		responses = append(responses, buildLeagueResponse(league))
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetLeague godoc
+// @Summary      Get a league
+// @Description  Get a league with its teams and seasons
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Success      200 {object} types.LeagueResponse "League"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "League not found"
+// @Router       /leagues/{id} [get]
+func (lc *LeagueController) GetLeague(c *gin.Context) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, buildLeagueResponse(league))
+}
+
+// CreateLeagueTeam godoc
+// @Summary      Enter a team into a league
+// @Description  Create a team in the league with you as its captain. A player can only play for one team per league.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        team body types.CreateLeagueTeamRequest true "Team name and players"
+// @Success      201 {object} types.LeagueTeamResponse "Team created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "League not found"
+// @Failure      409 {object} types.ErrorResponse "Team name taken or player already in a team"
+// @Router       /leagues/{id}/teams [post]
+func (lc *LeagueController) CreateLeagueTeam(c *gin.Context) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return
+	}
+
+	var req types.CreateLeagueTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	team, err := services.CreateLeagueTeam(config.DB, &league, c.GetUint("userID"), req.Name, req.UserIDs)
+	if err != nil {
+		respondLeagueError(c, err, "Failed to create team")
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildLeagueTeamResponse(team.ID))
+}
+
+// AddLeagueTeamMember godoc
+// @Summary      Add a player to a league team
+// @Description  Add a player to a team (by its captain and the league organizer). The player joins the team's upcoming fixtures.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        teamId path int true "Team ID"
+// @Param        member body types.AddLeagueTeamMemberRequest true "Player to add"
+// @Success      200 {object} types.LeagueTeamResponse "Updated team"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the captain or league organizer"
+// @Failure      404 {object} types.ErrorResponse "League or team not found"
+// @Failure      409 {object} types.ErrorResponse "Player already in a team of the league"
+// @Router       /leagues/{id}/teams/{teamId}/members [post]
+func (lc *LeagueController) AddLeagueTeamMember(c *gin.Context) {
+	league, team, ok := loadLeagueTeam(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint("userID")
+	if userID != team.CaptainID && userID != league.OrganizerID {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the captain and the league organizer can add players",
+		})
+		return
+	}
+
+	var req types.AddLeagueTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := services.AddLeagueTeamMember(config.DB, &team, req.UserID); err != nil {
+		respondLeagueError(c, err, "Failed to add player")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildLeagueTeamResponse(team.ID))
+}
+
+// RemoveLeagueTeamMember godoc
+// @Summary      Remove a player from a league team
+// @Description  Remove a player from a team (by the player, the captain and the league organizer). The player leaves the
+// @Description  team's upcoming fixtures. The captain cannot be removed.
+// @Tags         Leagues
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        teamId path int true "Team ID"
+// @Param        userId path int true "Player ID"
+// @Success      204 "Player removed"
+// @Failure      400 {object} types.ErrorResponse "The captain cannot be removed"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to remove this player"
+// @Failure      404 {object} types.ErrorResponse "League or team not found"
+// @Router       /leagues/{id}/teams/{teamId}/members/{userId} [delete]
+func (lc *LeagueController) RemoveLeagueTeamMember(c *gin.Context) {
+	league, team, ok := loadLeagueTeam(c)
+	if !ok {
+		return
+	}
+
+	memberID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return
+	}
+
+	userID := c.GetUint("userID")
+	if userID != uint(memberID) && userID != team.CaptainID && userID != league.OrganizerID {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the player, the captain and the league organizer can remove a player",
+		})
+		return
+	}
+
+	if err := services.RemoveLeagueTeamMember(config.DB, &team, uint(memberID)); err != nil {
+		respondLeagueError(c, err, "Failed to remove player")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateLeagueSeason godoc
+// @Summary      Create a league season
+// @Description  Create a season for teams of the league (by the league organizer). Generate its fixtures once the teams are final.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        season body types.CreateLeagueSeasonRequest true "Season settings"
+// @Success      201 {object} types.LeagueSeasonResponse "Season created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the league organizer"
+// @Failure      404 {object} types.ErrorResponse "League not found"
+// @Router       /leagues/{id}/seasons [post]
+func (lc *LeagueController) CreateLeagueSeason(c *gin.Context) {
+	league, ok := loadOrganizedLeague(c)
+	if !ok {
+		return
+	}
+
+	var req types.CreateLeagueSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	season, err := services.CreateLeagueSeason(config.DB, &league, req)
+	if err != nil {
+		respondLeagueError(c, err, "Failed to create season")
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildLeagueSeasonResponse(*season))
+}
+
+// GetLeagueSeason godoc
+// @Summary      Get a league season
+// @Description  Get a season with its fixtures by matchday and the standings table
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        seasonId path int true "Season ID"
+// @Success      200 {object} types.LeagueSeasonResponse "Season"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "League or season not found"
+// @Router       /leagues/{id}/seasons/{seasonId} [get]
+func (lc *LeagueController) GetLeagueSeason(c *gin.Context) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return
+	}
+	season, ok := loadLeagueSeason(c, league.ID)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, buildLeagueSeasonResponse(season))
+}
+
+// GetLeagueStandings godoc
+// @Summary      Get league standings
+// @Description  Get the standings table of a season. Teams level on points are ranked by the results between them
+// @Description  (points, goal difference, goals scored), then by overall goal difference and goals scored.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        seasonId path int true "Season ID"
+// @Success      200 {array} types.LeagueStandingResponse "Standings"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "League or season not found"
+// @Router       /leagues/{id}/seasons/{seasonId}/standings [get]
+func (lc *LeagueController) GetLeagueStandings(c *gin.Context) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return
+	}
+	season, ok := loadLeagueSeason(c, league.ID)
+	if !ok {
+		return
+	}
+
+	teams, fixtures := loadSeasonTeamsAndFixtures(season.ID)
+	c.JSON(http.StatusOK, services.LeagueStandings(&season, teams, fixtures))
+}
+
+// GenerateFixtures godoc
+// @Summary      Generate season fixtures
+// @Description  Draw a round robin of the season's teams (by the league organizer): every team meets every other team once
+// @Description  per leg, home and away swapped in the second leg, one matchday every match_interval_days. Every fixture is
+// @Description  a regular game event with the teams' players as participants.
+// @Tags         Leagues
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        seasonId path int true "Season ID"
+// @Success      200 {object} types.LeagueSeasonResponse "Season with its fixtures"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the league organizer"
+// @Failure      404 {object} types.ErrorResponse "League or season not found"
+// @Failure      409 {object} types.ErrorResponse "Fixtures already generated"
+// @Router       /leagues/{id}/seasons/{seasonId}/fixtures [post]
+func (lc *LeagueController) GenerateFixtures(c *gin.Context) {
+	league, ok := loadOrganizedLeague(c)
+	if !ok {
+		return
+	}
+	season, ok := loadLeagueSeason(c, league.ID)
+	if !ok {
+		return
+	}
+
+	if _, err := services.GenerateFixtures(config.DB, &league, &season); err != nil {
+		respondLeagueError(c, err, "Failed to generate fixtures")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildLeagueSeasonResponse(season))
+}
+
+// RecordFixtureResult godoc
+// @Summary      Enter a fixture result
+// @Description  Enter the final score of a fixture (by the league organizer and the fixture's co-organizers and referees).
+// @Description  The result is final right away and counts towards the standings.
+// @Tags         Leagues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "League ID"
+// @Param        seasonId path int true "Season ID"
+// @Param        fixtureId path int true "Fixture ID"
+// @Param        result body types.FixtureResultRequest true "Home and away scores"
+// @Success      200 {array} types.LeagueStandingResponse "Updated standings"
+// @Failure      400 {object} types.ErrorResponse "Invalid scores"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to enter results"
+// @Failure      404 {object} types.ErrorResponse "League, season or fixture not found"
+// @Failure      409 {object} types.ErrorResponse "Result already entered"
+// @Router       /leagues/{id}/seasons/{seasonId}/fixtures/{fixtureId}/result [post]
+func (lc *LeagueController) RecordFixtureResult(c *gin.Context) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return
+	}
+	season, ok := loadLeagueSeason(c, league.ID)
+	if !ok {
+		return
+	}
+
+	var fixture models.LeagueFixture
+	if err := config.DB.Preload("Event").Where("id = ? AND season_id = ?", c.Param("fixtureId"), season.ID).First(&fixture).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Fixture not found",
+			Message: "The requested fixture does not exist",
+		})
+		return
+	}
+
+	userID := c.GetUint("userID")
+	staff := []types.ParticipantRole{types.ParticipantRoleOrganizer, types.ParticipantRoleCoOrganizer, types.ParticipantRoleReferee}
+	if userID != league.OrganizerID && !services.HasEventRole(config.DB, &fixture.Event, userID, staff...) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the league organizer and the fixture's referees can enter results",
+		})
+		return
+	}
+
+	var req types.FixtureResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if _, err := services.RecordFixtureResult(config.DB, &fixture, userID, req); err != nil {
+		respondLeagueError(c, err, "Failed to record result")
+		return
+	}
+
+	teams, fixtures := loadSeasonTeamsAndFixtures(season.ID)
+	c.JSON(http.StatusOK, services.LeagueStandings(&season, teams, fixtures))
+}
+
+// loadLeague loads the league in the path, responding on failure
+func loadLeague(c *gin.Context) (models.League, bool) {
+	var league models.League
+
+	if _, exists := c.Get("userID"); !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return league, false
+	}
+
+	leagueID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid league ID",
+			Message: "League ID must be a valid number",
+		})
+		return league, false
+	}
+
+	if err := config.DB.First(&league, leagueID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "League not found",
+			Message: "The requested league does not exist",
+		})
+		return league, false
+	}
+
+	return league, true
+}
+
+// loadOrganizedLeague loads the league in the path and checks the current user organizes it
+func loadOrganizedLeague(c *gin.Context) (models.League, bool) {
+	league, ok := loadLeague(c)
+	if !ok {
+		return league, false
+	}
+
+	if league.OrganizerID != c.GetUint("userID") {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the league organizer can manage seasons",
+		})
+		return league, false
+	}
+
+	return league, true
+}
+
+// loadLeagueTeam loads the league and the team in the path
+func loadLeagueTeam(c *gin.Context) (models.League, models.LeagueTeam, bool) {
+	var team models.LeagueTeam
+
+	league, ok := loadLeague(c)
+	if !ok {
+		return league, team, false
+	}
+
+	if err := config.DB.Where("id = ? AND league_id = ?", c.Param("teamId"), league.ID).First(&team).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Team not found",
+			Message: "The requested team does not play in this league",
+		})
+		return league, team, false
+	}
+
+	return league, team, true
+}
+
+// loadLeagueSeason loads the season in the path, responding on failure
+func loadLeagueSeason(c *gin.Context, leagueID uint) (models.LeagueSeason, bool) {
+	var season models.LeagueSeason
+	if err := config.DB.Where("id = ? AND league_id = ?", c.Param("seasonId"), leagueID).First(&season).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Season not found",
+			Message: "The requested season does not exist",
+		})
+		return season, false
+	}
+	return season, true
+}
+
+// loadSeasonTeamsAndFixtures loads the teams of a season and its fixtures with their events
+func loadSeasonTeamsAndFixtures(seasonID uint) ([]models.LeagueTeam, []models.LeagueFixture) {
+	var teams []models.LeagueTeam
+	config.DB.Joins("JOIN league_season_teams st ON st.league_team_id = league_teams.id").
+		Where("st.league_season_id = ?", seasonID).
+		Order("league_teams.name ASC").Find(&teams)
+
+	var fixtures []models.LeagueFixture
+	config.DB.Preload("Event").Where("season_id = ?", seasonID).Order("matchday ASC, id ASC").Find(&fixtures)
+
+	return teams, fixtures
+}
+
+// respondLeagueError maps league service errors to responses
+func respondLeagueError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrInvalidLeagueTeam), errors.Is(err, services.ErrInvalidSeason), errors.Is(err, services.ErrInvalidScores):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrLeagueTeamExists):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Team exists",
+			Message: "A team with this name already plays in the league",
+		})
+	case errors.Is(err, services.ErrAlreadyInLeagueTeam):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Already in a team",
+			Message: "A player already plays for a team in this league",
+		})
+	case errors.Is(err, services.ErrFixturesGenerated):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Fixtures exist",
+			Message: "The fixtures of this season have already been generated",
+		})
+	case errors.Is(err, services.ErrResultConfirmed):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Fixture played",
+			Message: "The result of this fixture has already been entered",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildLeagueResponse builds a league with its teams and seasons
+func buildLeagueResponse(league models.League) types.LeagueResponse {
+	var teams []models.LeagueTeam
+	config.DB.Preload("Members.User").Where("league_id = ?", league.ID).Order("name ASC").Find(&teams)
+
+	var seasons []models.LeagueSeason
+	config.DB.Where("league_id = ?", league.ID).Order("start_at DESC").Find(&seasons)
+
+	response := types.LeagueResponse{
+		ID:           league.ID,
+		OrganizerID:  league.OrganizerID,
+		Name:         league.Name,
+		Description:  league.Description,
+		Sport:        league.Sport,
+		LocationName: league.LocationName,
+		Latitude:     *league.Latitude,
+		Longitude:    *league.Longitude,
+		VenueID:      league.VenueID,
+		Timezone:     league.Timezone,
+		Teams:        make([]types.LeagueTeamResponse, 0, len(teams)),
+		Seasons:      make([]types.LeagueSeasonSummaryResponse, 0, len(seasons)),
+		CreatedAt:    league.CreatedAt,
+		UpdatedAt:    league.UpdatedAt,
+	}
+	for _, team := range teams {
+		response.Teams = append(response.Teams, toLeagueTeamResponse(team))
+	}
+	for _, season := range seasons {
+		response.Seasons = append(response.Seasons, types.LeagueSeasonSummaryResponse{
+			ID:      season.ID,
+			Name:    season.Name,
+			StartAt: season.StartAt,
+			Status:  types.LeagueSeasonStatus(season.Status),
+		})
+	}
+	return response
+}
+
+// buildLeagueSeasonResponse builds a season with its fixtures by matchday and its standings
+func buildLeagueSeasonResponse(season models.LeagueSeason) types.LeagueSeasonResponse {
+	teams, fixtures := loadSeasonTeamsAndFixtures(season.ID)
+	names := make(map[uint]string, len(teams))
+	for _, team := range teams {
+		names[team.ID] = team.Name
+	}
+
+	response := types.LeagueSeasonResponse{
+		ID:                   season.ID,
+		LeagueID:             season.LeagueID,
+		Name:                 season.Name,
+		StartAt:              season.StartAt,
+		Legs:                 season.Legs,
+		MatchIntervalDays:    season.MatchIntervalDays,
+		MatchDurationMinutes: season.MatchDurationMinutes,
+		PointsWin:            season.PointsWin,
+		PointsDraw:           season.PointsDraw,
+		Status:               types.LeagueSeasonStatus(season.Status),
+		Matchdays:            make([]types.LeagueMatchdayResponse, 0),
+		Standings:            services.LeagueStandings(&season, teams, fixtures),
+		CreatedAt:            season.CreatedAt,
+	}
+	for _, f := range fixtures {
+		if n := len(response.Matchdays); n == 0 || response.Matchdays[n-1].Matchday != f.Matchday {
+			response.Matchdays = append(response.Matchdays, types.LeagueMatchdayResponse{Matchday: f.Matchday})
+		}
+		matchday := &response.Matchdays[len(response.Matchdays)-1]
+		matchday.Fixtures = append(matchday.Fixtures, types.LeagueFixtureResponse{
+			ID:         f.ID,
+			Matchday:   f.Matchday,
+			HomeTeamID: f.HomeTeamID,
+			HomeTeam:   names[f.HomeTeamID],
+			AwayTeamID: f.AwayTeamID,
+			AwayTeam:   names[f.AwayTeamID],
+			EventID:    f.EventID,
+			StartAt:    f.Event.StartAt,
+			Status:     types.LeagueFixtureStatus(f.Status),
+			HomeScore:  f.HomeScore,
+			AwayScore:  f.AwayScore,
+		})
+	}
+	return response
+}
+
+// buildLeagueTeamResponse loads a team with its players
+func buildLeagueTeamResponse(teamID uint) types.LeagueTeamResponse {
+	var team models.LeagueTeam
+	config.DB.Preload("Members.User").First(&team, teamID)
+	return toLeagueTeamResponse(team)
+}
+
+// toLeagueTeamResponse converts a team whose members have their User preloaded
+func toLeagueTeamResponse(team models.LeagueTeam) types.LeagueTeamResponse {
+	response := types.LeagueTeamResponse{
+		ID:        team.ID,
+		Name:      team.Name,
+		CaptainID: team.CaptainID,
+		Players:   make([]types.SidePlayerResponse, 0, len(team.Members)),
+	}
+	for _, member := range team.Members {
+		response.Players = append(response.Players, types.SidePlayerResponse{
+			UserID:      member.UserID,
+			Username:    member.User.Username,
+			DisplayName: member.User.DisplayName,
+		})
+	}
+	return response
+}
@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MessageController struct{}
+
+func NewMessageController() *MessageController {
+	return &MessageController{}
+}
+
+// GetConversations godoc
+// @Summary      List conversations
+// @Description  List the current user's direct message conversations, most recent activity first
+// @Tags         Messages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Maximum number of conversations" default(20)
+// @Param        offset query int false "Number of conversations to skip" default(0)
+// @Success      200 {array} types.ConversationResponse "Conversations"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /conversations [get]
+func (mc *MessageController) GetConversations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	// Conversations without messages yet are left out of the inbox
+	var conversations []models.Conversation
+	if err := config.DB.Preload("UserA").Preload("UserB").
+		Where("(user_a_id = ? OR user_b_id = ?) AND last_message_at IS NOT NULL", userID, userID).
+		Order("last_message_at DESC").Limit(limit).Offset(max(offset, 0)).
+		Find(&conversations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch conversations",
+		})
+		return
+	}
+
+	response := make([]types.ConversationResponse, 0, len(conversations))
+	for _, conversation := range conversations {
+		response = append(response, buildConversationResponse(conversation, userID.(uint)))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// StartConversation godoc
+// @Summary      Start a conversation
+// @Description  Open the direct message conversation with another user, or return the existing one
+// @Tags         Messages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        conversation body types.StartConversationRequest true "User to talk to"
+// @Success      200 {object} types.ConversationResponse "Conversation"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Messaging this user is not allowed"
+// @Failure      404 {object} types.ErrorResponse "User not found"
+// @Router       /conversations [post]
+func (mc *MessageController) StartConversation(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.StartConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	conversation, err := services.GetOrCreateConversation(config.DB, userID.(uint), req.UserID)
+	if err != nil {
+		respondMessageError(c, err, "Failed to start conversation")
+		return
+	}
+
+	config.DB.Preload("UserA").Preload("UserB").First(conversation, conversation.ID)
+	c.JSON(http.StatusOK, buildConversationResponse(*conversation, userID.(uint)))
+}
+
+// GetMessages godoc
+// @Summary      Get messages
+// @Description  Page through a conversation's messages, newest first. Pass next_cursor as before to load older messages.
+// @Tags         Messages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Conversation ID"
+// @Param        before query int false "Only messages older than this message ID"
+// @Param        limit query int false "Maximum number of messages" default(30)
+// @Success      200 {object} types.DirectMessagePageResponse "Messages"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Conversation not found"
+// @Router       /conversations/{id}/messages [get]
+func (mc *MessageController) GetMessages(c *gin.Context) {
+	conversation, ok := loadConversation(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "30"))
+	if err != nil || limit <= 0 {
+		limit = 30
+	}
+	limit = min(limit, 100)
+
+	query := config.DB.Where("conversation_id = ?", conversation.ID)
+	if before, err := strconv.ParseUint(c.Query("before"), 10, 32); err == nil {
+		query = query.Where("id < ?", before)
+	}
+
+	// Fetch one extra row to know whether there is an older page
+	var messages []models.DirectMessage
+	if err := query.Order("id DESC").Limit(limit + 1).Find(&messages).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch messages",
+		})
+		return
+	}
+
+	page := types.DirectMessagePageResponse{Messages: make([]types.DirectMessageResponse, 0, min(len(messages), limit))}
+	if len(messages) > limit {
+		messages = messages[:limit]
+		page.NextCursor = &messages[limit-1].ID
+	}
+	for _, message := range messages {
+		page.Messages = append(page.Messages, buildDirectMessageResponse(message))
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// SendMessage godoc
+// @Summary      Send a message
+// @Description  Send a direct message in a conversation. It is delivered live to both participants over /notifications/stream as a direct_message event.
+// @Tags         Messages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Conversation ID"
+// @Param        message body types.SendMessageRequest true "Message"
+// @Success      201 {object} types.DirectMessageResponse "Message sent"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Messaging this user is not allowed"
+// @Failure      404 {object} types.ErrorResponse "Conversation not found"
+// @Router       /conversations/{id}/messages [post]
+func (mc *MessageController) SendMessage(c *gin.Context) {
+	conversation, ok := loadConversation(c)
+	if !ok {
+		return
+	}
+
+	var req types.SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Message cannot be empty",
+		})
+		return
+	}
+
+	message, err := services.SendDirectMessage(config.DB, &conversation, c.GetUint("userID"), body)
+	if err != nil {
+		respondMessageError(c, err, "Failed to send message")
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildDirectMessageResponse(*message))
+}
+
+// MarkConversationRead godoc
+// @Summary      Mark a conversation as read
+// @Description  Mark the messages received in a conversation as read. The sender gets a message_read event over /notifications/stream.
+// @Tags         Messages
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Conversation ID"
+// @Success      200 {object} object{conversation_id=int,marked_read=int} "Messages marked as read"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Conversation not found"
+// @Router       /conversations/{id}/read [post]
+func (mc *MessageController) MarkConversationRead(c *gin.Context) {
+	conversation, ok := loadConversation(c)
+	if !ok {
+		return
+	}
+
+	marked, err := services.MarkConversationRead(config.DB, &conversation, c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to mark messages as read",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"conversation_id": conversation.ID,
+		"marked_read":     marked,
+	})
+}
+
+// loadConversation loads the conversation in the path if the current user takes part in it
+func loadConversation(c *gin.Context) (models.Conversation, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return models.Conversation{}, false
+	}
+
+	conversationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid conversation ID",
+			Message: "Conversation ID must be a valid number",
+		})
+		return models.Conversation{}, false
+	}
+
+	conversation, err := services.LoadConversation(config.DB, uint(conversationID), userID.(uint))
+	if err != nil {
+		respondMessageError(c, err, "Failed to load conversation")
+		return models.Conversation{}, false
+	}
+	return *conversation, true
+}
+
+// respondMessageError maps messaging service errors to responses
+func respondMessageError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrConversationNotFound), errors.Is(err, services.ErrNotConversationMember):
+		// Don't reveal conversations of other users
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Conversation not found",
+			Message: "The requested conversation does not exist",
+		})
+	case errors.Is(err, services.ErrRecipientNotFound):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "User not found",
+			Message: "The user you want to message does not exist",
+		})
+	case errors.Is(err, services.ErrUserBlocked):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You cannot message this user",
+		})
+	case errors.Is(err, services.ErrCannotMessageSelf):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "You cannot message yourself",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildConversationResponse converts a conversation with UserA and UserB loaded, adding its
+// latest message and the user's unread count
+func buildConversationResponse(conversation models.Conversation, userID uint) types.ConversationResponse {
+	other := conversation.UserA
+	if conversation.UserAID == userID {
+		other = conversation.UserB
+	}
+
+	response := types.ConversationResponse{
+		ID: conversation.ID,
+		OtherUser: types.ConversationUserResponse{
+			UserID:      other.ID,
+			Username:    other.Username,
+			DisplayName: other.DisplayName,
+			AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", other.ID),
+		},
+		LastMessageAt: conversation.LastMessageAt,
+	}
+
+	var last models.DirectMessage
+	if config.DB.Where("conversation_id = ?", conversation.ID).Order("id DESC").Limit(1).Find(&last).RowsAffected > 0 {
+		lastResponse := buildDirectMessageResponse(last)
+		response.LastMessage = &lastResponse
+	}
+
+	var unread int64
+	config.DB.Model(&models.DirectMessage{}).
+		Where("conversation_id = ? AND sender_id <> ? AND read_at IS NULL", conversation.ID, userID).
+		Count(&unread)
+	response.UnreadCount = int(unread)
+	return response
+}
+
+// buildDirectMessageResponse converts a direct message
+func buildDirectMessageResponse(message models.DirectMessage) types.DirectMessageResponse {
+	return types.DirectMessageResponse{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		SenderID:       message.SenderID,
+		Body:           message.Body,
+		ReadAt:         message.ReadAt,
+		CreatedAt:      message.CreatedAt,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strconv"
 	"regexp"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -79,9 +80,9 @@ func (ec *PostController) ToggleLike(c *gin.Context) {
         return
     }
 
-    // Ensure post exists
+    // Ensure post exists and is visible to the user
     var post models.Post
-    if err := config.DB.Select("id").First(&post, postIDInt).Error; err != nil {
+    if err := config.DB.Select("id, user_id, group_id").First(&post, postIDInt).Error; err != nil || !services.CanViewPost(config.DB, &post, userID.(uint)) {
         c.JSON(http.StatusNotFound, types.ErrorResponse{
             Error:   "Post not found",
             Message: "The requested post does not exist",
@@ -200,7 +201,8 @@ func (ec *PostController) UpdatePost(c *gin.Context) {
         _ = config.DB.Where("post_id = ?", post.ID).Delete(&models.PostMention{}).Error
         if len(*req.Mentions) > 0 {
             var users []models.User
-            if err := config.DB.Where("username IN ?", *req.Mentions).Find(&users).Error; err == nil {
+            // Users blocked either way can't be mentioned
+            if err := config.DB.Where("username IN ?", *req.Mentions).Scopes(services.BlockedUsersScope(userID.(uint), "users.id")).Find(&users).Error; err == nil {
                 for _, u := range users {
                     mention := models.PostMention{PostID: post.ID, UserID: u.ID}
                     _ = config.DB.Create(&mention).Error
@@ -245,7 +247,7 @@ func (ec *PostController) UpdatePost(c *gin.Context) {
         likedByMe = cnt > 0
     }
 
-    response := types.PostResponse{ID: post.ID, UserID: post.UserID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt}
+    response := types.PostResponse{ID: post.ID, UserID: post.UserID, GroupID: post.GroupID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt}
     c.JSON(http.StatusOK, response)
 }
 
@@ -273,6 +275,11 @@ func (ec *PostController) GetPost(c *gin.Context) {
         c.JSON(http.StatusNotFound, types.ErrorResponse{Error: "Post not found", Message: "The requested post does not exist"})
         return
     }
+    // Posts of private groups are hidden from non-members
+    if !services.CanViewPost(config.DB, &post, userID.(uint)) {
+        c.JSON(http.StatusNotFound, types.ErrorResponse{Error: "Post not found", Message: "The requested post does not exist"})
+        return
+    }
 
     var postMentions []models.PostMention
     _ = config.DB.Preload("User").Where("post_id = ?", post.ID).Find(&postMentions).Error
@@ -291,20 +298,20 @@ func (ec *PostController) GetPost(c *gin.Context) {
         likedByMe = cnt > 0
     }
 
-    response := types.PostResponse{ID: post.ID, UserID: post.UserID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt}
+    response := types.PostResponse{ID: post.ID, UserID: post.UserID, GroupID: post.GroupID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt}
     c.JSON(http.StatusOK, response)
 }
 
 // GetUserPostsByID returns published posts for a specified user ID
 func (ec *PostController) GetUserPostsByID(c *gin.Context) {
-    _, exists := c.Get("userID")
+    viewerID, exists := c.Get("userID")
     if !exists { c.JSON(http.StatusUnauthorized, types.ErrorResponse{Error: "Unauthorized", Message: "User not authenticated"}); return }
     userParam := c.Param("id")
     uid, err := strconv.ParseUint(userParam, 10, 32)
     if err != nil { c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Invalid user ID", Message: "User ID must be a valid number"}); return }
 
     var posts []models.Post
-    if err := config.DB.Where("user_id = ? AND deleted_at IS NULL AND status = ?", uint(uid), types.PostStatusPublished).Order("created_at DESC").Find(&posts).Error; err != nil {
+    if err := config.DB.Scopes(services.VisiblePostsScope(viewerID.(uint)), services.BlockedUsersScope(viewerID.(uint), "posts.user_id")).Where("user_id = ? AND deleted_at IS NULL AND status = ?", uint(uid), types.PostStatusPublished).Order("created_at DESC").Find(&posts).Error; err != nil {
         c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: "Database error", Message: "Failed to fetch user posts"})
         return
     }
@@ -322,7 +329,7 @@ func (ec *PostController) GetUserPostsByID(c *gin.Context) {
         var likesCount int64
         _ = config.DB.Model(&models.PostLike{}).Where("post_id = ?", post.ID).Count(&likesCount).Error
 
-        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: false, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
+        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, GroupID: post.GroupID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: false, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
     }
     c.JSON(http.StatusOK, response)
 }
@@ -360,11 +367,20 @@ func (ec *PostController) CreatePost(c *gin.Context) {
         return
     }
 
+    if req.GroupID != nil && !services.IsGroupMember(config.DB, *req.GroupID, userID.(uint)) {
+        c.JSON(http.StatusForbidden, types.ErrorResponse{
+            Error:   "Forbidden",
+            Message: "Only group members can post in this group",
+        })
+        return
+    }
+
     post := models.Post{
-        UserID: uint(userID.(uint)),
-        Title:  req.Title,
-        Body:   req.Body,
-        Status: types.PostStatusPublished,
+        UserID:  uint(userID.(uint)),
+        GroupID: req.GroupID,
+        Title:   req.Title,
+        Body:    req.Body,
+        Status:  types.PostStatusPublished,
     }
 
     if err := config.DB.Create(&post).Error; err != nil {
@@ -386,6 +402,8 @@ func (ec *PostController) CreatePost(c *gin.Context) {
                 })
                 return
             }
+            // Users blocked either way can't be mentioned
+            if services.IsBlockedBetween(config.DB, userID.(uint), user.ID) { continue }
 
             postMention := models.PostMention{
                 PostID: post.ID,
@@ -428,6 +446,7 @@ func (ec *PostController) CreatePost(c *gin.Context) {
     response := types.PostResponse{
         ID:        post.ID,
         UserID:    post.UserID,
+        GroupID:   post.GroupID,
         Title:     post.Title,
         Body:      post.Body,
         Status:    post.Status,
@@ -449,6 +468,7 @@ func (ec *PostController) CreatePost(c *gin.Context) {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        scope query string false "Filter by scope (all, following, group:<id>)"
 // @Success      200 {array} types.PostResponse "List of posts"
 // @Failure      401 {object} types.ErrorResponse "User not authenticated"
 // @Router       /posts [get]
@@ -463,12 +483,16 @@ func (ec *PostController) GetPosts(c *gin.Context) {
     offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
     scope := c.DefaultQuery("scope", "all")
 
-    query := config.DB.Preload("Author").Where("deleted_at IS NULL")
+    query := config.DB.Preload("Author").Where("deleted_at IS NULL").Scopes(services.VisiblePostsScope(userID.(uint)), services.HiddenUsersScope(userID.(uint), "posts.user_id"))
     if scope == "following" {
         // Only posts from users current user follows
         uid := userID.(uint)
         sub := config.DB.Model(&models.Follow{}).Select("followed_id").Where("follower_id = ?", uid)
         query = query.Where("user_id IN (?)", sub)
+    } else if groupIDStr, ok := strings.CutPrefix(scope, "group:"); ok {
+        // Only posts of the given group; an invalid ID matches nothing
+        groupID, _ := strconv.ParseUint(groupIDStr, 10, 32)
+        query = query.Where("posts.group_id = ?", groupID)
     }
     var posts []models.Post
     if err := query.Limit(limit).Offset(offset).Order("created_at DESC").Find(&posts).Error; err != nil {
@@ -495,7 +519,7 @@ func (ec *PostController) GetPosts(c *gin.Context) {
             likedByMe = cnt > 0
         }
 
-        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
+        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, GroupID: post.GroupID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
     }
     c.JSON(http.StatusOK, response)
 }
@@ -543,7 +567,7 @@ func (ec *PostController) GetUserPosts(c *gin.Context) {
             likedByMe = cnt > 0
         }
 
-        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
+        response = append(response, types.PostResponse{ID: post.ID, UserID: post.UserID, GroupID: post.GroupID, Title: post.Title, Body: post.Body, Status: post.Status, ImageURL: imageURL, Mentions: mentionUsernames, LikesCount: int(likesCount), LikedByMe: likedByMe, CreatedAt: post.CreatedAt, UpdatedAt: post.UpdatedAt})
     }
     c.JSON(http.StatusOK, response)
 }
@@ -590,7 +614,8 @@ func (ec *PostController) DeletePost(c *gin.Context) {
         return
     }
 
-    if post.UserID != userID.(uint) {
+    // Group admins and moderators can remove posts from their group
+    if post.UserID != userID.(uint) && (post.GroupID == nil || !services.CanModerateGroup(config.DB, *post.GroupID, userID.(uint))) {
         c.JSON(http.StatusForbidden, types.ErrorResponse{
             Error:   "Forbidden",
             Message: "You can only delete your own posts",
@@ -688,7 +713,7 @@ func (ec *PostController) UploadPostImage(c *gin.Context) {
 
 // GetPostImage godoc
 // @Summary      Get post image
-// @Description  Retrieve the image for a post
+// @Description  Retrieve the image for a post the current user can see
 // @Tags         Posts
 // @Accept       json
 // @Produce      octet-stream
@@ -699,6 +724,14 @@ func (ec *PostController) UploadPostImage(c *gin.Context) {
 // @Failure      404 {object} types.ErrorResponse "Post not found"
 // @Router       /posts/{id}/image [get]
 func (ec *PostController) GetPostImage(c *gin.Context) {
+    userID, exists := c.Get("userID")
+    if !exists {
+        c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+            Error:   "Unauthorized",
+            Message: "User not authenticated",
+        })
+        return
+    }
 
     postID := c.Param("id")
     postIDInt, err := strconv.ParseUint(postID, 10, 32)
@@ -711,7 +744,7 @@ func (ec *PostController) GetPostImage(c *gin.Context) {
     }
 
     var post models.Post
-    if err := config.DB.Select("image_data, image_type").First(&post, postIDInt).Error; err != nil {
+    if err := config.DB.Select("id, user_id, group_id, image_data, image_type").First(&post, postIDInt).Error; err != nil || !services.CanViewPost(config.DB, &post, userID.(uint)) {
         c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
         return
     }
@@ -727,13 +760,13 @@ func (ec *PostController) GetPostImage(c *gin.Context) {
     }
 
     c.Header("Content-Type", contentType)
-    c.Header("Cache-Control", "public, max-age=86400")
+    c.Header("Cache-Control", "private, max-age=86400")
     c.Data(http.StatusOK, contentType, post.ImageData)
 }
 
 // GetPostComments returns nested comments for a post
 func (ec *PostController) GetPostComments(c *gin.Context) {
-    _, exists := c.Get("userID")
+    uid, exists := c.Get("userID")
     if !exists { c.JSON(http.StatusUnauthorized, types.ErrorResponse{Error: "Unauthorized", Message: "User not authenticated"}); return }
 
     postID := c.Param("id")
@@ -741,13 +774,14 @@ func (ec *PostController) GetPostComments(c *gin.Context) {
     if err != nil { c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Invalid post ID", Message: "Post ID must be a valid number"}); return }
 
     var post models.Post
-    if err := config.DB.Select("id").First(&post, postIDInt).Error; err != nil {
+    if err := config.DB.Select("id, user_id, group_id").First(&post, postIDInt).Error; err != nil || !services.CanViewPost(config.DB, &post, uid.(uint)) {
         c.JSON(http.StatusNotFound, types.ErrorResponse{Error: "Post not found", Message: "The requested post does not exist"})
         return
     }
 
     var comments []models.Comment
-    if err := config.DB.Preload("Author").Where("post_id = ?", post.ID).Order("created_at ASC").Find(&comments).Error; err != nil {
+    // Comments of blocked and muted users are left out; their replies then show as roots
+    if err := config.DB.Preload("Author").Where("post_id = ?", post.ID).Scopes(services.HiddenUsersScope(uid.(uint), "comments.user_id")).Order("created_at ASC").Find(&comments).Error; err != nil {
         c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: "Database error", Message: "Failed to fetch comments"})
         return
     }
@@ -800,10 +834,14 @@ func (ec *PostController) CreateComment(c *gin.Context) {
     if err != nil { c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Invalid post ID", Message: "Post ID must be a valid number"}); return }
 
     var post models.Post
-    if err := config.DB.Select("id").First(&post, postIDInt).Error; err != nil {
+    if err := config.DB.Select("id, user_id, group_id").First(&post, postIDInt).Error; err != nil || !services.CanViewPost(config.DB, &post, uid.(uint)) {
         c.JSON(http.StatusNotFound, types.ErrorResponse{Error: "Post not found", Message: "The requested post does not exist"})
         return
     }
+    if services.IsBlockedBetween(config.DB, uid.(uint), post.UserID) {
+        c.JSON(http.StatusForbidden, types.ErrorResponse{Error: "Forbidden", Message: "You cannot comment on this post"})
+        return
+    }
 
     var req types.CreateCommentRequest
     if err := c.ShouldBindJSON(&req); err != nil {
@@ -3,8 +3,10 @@ package controllers
 import (
 	"backend/src/config"
 	"backend/src/models"
+	"backend/src/services"
 	"backend/src/types"
 	"fmt"
+	"math"
 	"net/http"
 	"strconv"
 
@@ -97,6 +99,8 @@ func (pc *ProfileController) GetProfile(c *gin.Context) {
 	var activitiesCount int64
 	config.DB.Model(&models.Event{}).Where("organizer_id = ? AND deleted_at IS NULL AND status != ?", user.ID, "cancelled").Count(&activitiesCount)
 
+	reliability, trackedActivities := services.ReliabilityFor(config.DB, user.ID)
+
 	profile := types.ProfileResponse{
 		ID:             user.ID,
 		Username:       user.Username,
@@ -105,12 +109,19 @@ func (pc *ProfileController) GetProfile(c *gin.Context) {
 		Bio:            user.Bio,
 		City:           user.City,
 		Country:        user.Country,
+		HomeLatitude:   user.HomeLatitude,
+		HomeLongitude:  user.HomeLongitude,
 		Sports:         sports,
 		AvatarURL:      avatarURL,
 		HasAvatar:      hasAvatar,
 		FollowersCount: int(followersCount),
 		FollowingCount: int(followingCount),
 		ActivitiesCount: int(activitiesCount),
+		GameRecord:     services.GameRecordFor(config.DB, user.ID),
+		Reliability:    reliability,
+		TrackedActivities: trackedActivities,
+		EventReminders: user.EventReminders,
+		IsPrivate:      user.IsPrivate,
 		CreatedAt:      user.CreatedAt,
 		UpdatedAt:      user.UpdatedAt,
 	}
@@ -120,7 +131,7 @@ func (pc *ProfileController) GetProfile(c *gin.Context) {
 
 // GetPublicProfile godoc
 // @Summary      Get public user profile
-// @Description  Retrieve another user's public profile information by user ID
+// @Description  Retrieve another user's public profile information by user ID. Private accounts the current user doesn't follow only show their basic profile.
 // @Tags         Profile
 // @Accept       json
 // @Produce      json
@@ -190,10 +201,33 @@ func (pc *ProfileController) GetPublicProfile(c *gin.Context) {
 	config.DB.Model(&models.Follow{}).Where("followed_id = ?", targetUserID).Count(&followersCount)
 	config.DB.Model(&models.Follow{}).Where("follower_id = ?", targetUserID).Count(&followingCount)
 
+	// Private accounts only show the basics until the follow request is approved
+	if user.IsPrivate && !isFollowing {
+		c.JSON(http.StatusOK, types.PublicProfileResponse{
+			ID:              user.ID,
+			Username:        user.Username,
+			DisplayName:     user.DisplayName,
+			AvatarURL:       avatarURL,
+			HasAvatar:       hasAvatar,
+			FollowersCount:  int(followersCount),
+			FollowingCount:  int(followingCount),
+			Sports:          []string{},
+			Ratings:         []types.SportRatingResponse{},
+			CreatedAt:       user.CreatedAt,
+			UpdatedAt:       user.UpdatedAt,
+			IsPrivate:       true,
+			FollowRequested: services.HasPendingFollowRequest(config.DB, currentUserID.(uint), user.ID),
+			IsLimited:       true,
+		})
+		return
+	}
+
 	// Get activities (events) count for target user (exclude cancelled)
 	var activitiesCount int64
 	config.DB.Model(&models.Event{}).Where("organizer_id = ? AND deleted_at IS NULL AND status != ?", targetUserID, "cancelled").Count(&activitiesCount)
 
+	reliability, trackedActivities := services.ReliabilityFor(config.DB, user.ID)
+
 	profile := types.PublicProfileResponse{
 		ID:             user.ID,
 		Username:       user.Username,
@@ -207,9 +241,14 @@ func (pc *ProfileController) GetPublicProfile(c *gin.Context) {
 		FollowersCount: int(followersCount),
 		FollowingCount: int(followingCount),
 		ActivitiesCount: int(activitiesCount),
+		GameRecord:     services.GameRecordFor(config.DB, user.ID),
+		Reliability:    reliability,
+		TrackedActivities: trackedActivities,
 		CreatedAt:      user.CreatedAt,
 		UpdatedAt:      user.UpdatedAt,
 		IsFollowing:    isFollowing,
+		IsPrivate:      user.IsPrivate,
+		Ratings:        buildSportRatings(user.ID),
 	}
 
 	c.JSON(http.StatusOK, profile)
@@ -242,6 +281,11 @@ func (pc *ProfileController) UpdateProfile(c *gin.Context) {
 		return
 	}
 
+	if (req.HomeLatitude == nil) != (req.HomeLongitude == nil) {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Bad Request", Message: "home_latitude and home_longitude must be set together"})
+		return
+	}
+
 	// Load user
 	var user models.User
 	if err := config.DB.Preload("Sports").First(&user, uid).Error; err != nil {
@@ -254,6 +298,18 @@ func (pc *ProfileController) UpdateProfile(c *gin.Context) {
 	user.Bio = req.Bio
 	user.City = req.City
 	user.Country = req.Country
+	if req.HomeLatitude != nil {
+		user.HomeLatitude = req.HomeLatitude
+		user.HomeLongitude = req.HomeLongitude
+	}
+	if req.EventReminders != nil {
+		user.EventReminders = *req.EventReminders
+	}
+	wentPublic := false
+	if req.IsPrivate != nil {
+		wentPublic = user.IsPrivate && !*req.IsPrivate
+		user.IsPrivate = *req.IsPrivate
+	}
 
 	// Update sports if provided
 	if req.Sports != nil {
@@ -275,6 +331,14 @@ func (pc *ProfileController) UpdateProfile(c *gin.Context) {
 		return
 	}
 
+	// Nobody needs approval anymore once the account is public
+	if wentPublic {
+		if err := services.ApproveAllFollowRequests(config.DB, user.ID); err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: "Database error", Message: "Failed to approve pending follow requests"})
+			return
+		}
+	}
+
 	// Build response reusing GetProfile shape
 	var followersCount, followingCount int64
 	config.DB.Model(&models.Follow{}).Where("followed_id = ?", user.ID).Count(&followersCount)
@@ -288,6 +352,8 @@ func (pc *ProfileController) UpdateProfile(c *gin.Context) {
 	hasAvatar := len(user.AvatarData) > 0
 	avatarURL := fmt.Sprintf("/api/user/%d/avatar", user.ID)
 
+	reliability, trackedActivities := services.ReliabilityFor(config.DB, user.ID)
+
 	resp := types.ProfileResponse{
 		ID: user.ID,
 		Username: user.Username,
@@ -296,15 +362,188 @@ func (pc *ProfileController) UpdateProfile(c *gin.Context) {
 		Bio: user.Bio,
 		City: user.City,
 		Country: user.Country,
+		HomeLatitude: user.HomeLatitude,
+		HomeLongitude: user.HomeLongitude,
 		Sports: sportNames,
 		AvatarURL: avatarURL,
 		HasAvatar: hasAvatar,
 		FollowersCount: int(followersCount),
 		FollowingCount: int(followingCount),
 		ActivitiesCount: int(activitiesCount),
+		GameRecord: services.GameRecordFor(config.DB, user.ID),
+		Reliability: reliability,
+		TrackedActivities: trackedActivities,
+		EventReminders: user.EventReminders,
+		IsPrivate: user.IsPrivate,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// GetUserResults godoc
+// @Summary      Get a user's game history
+// @Description  List a user's games with a confirmed result, newest first, with their outcome and the final score
+// @Tags         Profile
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID"
+// @Param        limit query int false "Limit number of results" default(20)
+// @Param        offset query int false "Offset for pagination" default(0)
+// @Success      200 {array} types.GameHistoryEntryResponse "Game history"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Private account not followed"
+// @Router       /profile/{id}/results [get]
+func (pc *ProfileController) GetUserResults(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{Error: "Unauthorized", Message: "User not authenticated"})
+		return
+	}
+
+	targetUserID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Invalid user ID", Message: "User ID must be a valid number"})
+		return
+	}
+
+	if !services.CanSeePrivateContent(config.DB, userID.(uint), uint(targetUserID)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{Error: "Private account", Message: "Follow this user to see their history"})
+		return
+	}
+
+	limit := 20
+	if v, err := strconv.Atoi(c.DefaultQuery("limit", "20")); err == nil && v > 0 && v <= 100 {
+		limit = v
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.DefaultQuery("offset", "0")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	var participations []models.EventParticipant
+	if err := config.DB.Preload("Event").
+		Joins("JOIN game_results ON game_results.event_id = event_participants.event_id AND game_results.status = ?", types.ResultStatusConfirmed).
+		Joins("JOIN events ON events.id = event_participants.event_id").
+		Where("event_participants.user_id = ? AND event_participants.side_id IS NOT NULL", targetUserID).
+		Order("events.start_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&participations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: "Database error", Message: "Failed to fetch game history"})
+		return
+	}
+
+	history := make([]types.GameHistoryEntryResponse, 0, len(participations))
+	for _, p := range participations {
+		var result models.GameResult
+		if err := config.DB.Preload("Scores").Where("event_id = ?", p.EventID).First(&result).Error; err != nil {
+			continue
+		}
+
+		var sides []models.EventSide
+		config.DB.Where("event_id = ?", p.EventID).Find(&sides)
+		names := make(map[uint]string, len(sides))
+		for _, s := range sides {
+			names[s.ID] = s.Name
+		}
+
+		history = append(history, types.GameHistoryEntryResponse{
+			EventID:  p.EventID,
+			Title:    p.Event.Title,
+			Sport:    p.Event.Sport,
+			StartAt:  p.Event.StartAt,
+			SideID:   *p.SideID,
+			Outcome:  services.OutcomeFor(&result, *p.SideID),
+			Format:   types.ScoreFormat(result.Format),
+			Scores:   buildSideScores(result.Scores, names),
+			Recorded: result.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// GetUserRatingHistory godoc
+// @Summary      Get a user's rating history
+// @Description  List a user's rating changes, newest first, optionally for one sport
+// @Tags         Profile
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "User ID"
+// @Param        sport query string false "Only this sport"
+// @Param        limit query int false "Limit number of results" default(50)
+// @Success      200 {array} types.RatingHistoryEntryResponse "Rating history"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Private account not followed"
+// @Router       /profile/{id}/ratings/history [get]
+func (pc *ProfileController) GetUserRatingHistory(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{Error: "Unauthorized", Message: "User not authenticated"})
+		return
+	}
+
+	targetUserID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{Error: "Invalid user ID", Message: "User ID must be a valid number"})
+		return
+	}
+
+	if !services.CanSeePrivateContent(config.DB, userID.(uint), uint(targetUserID)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{Error: "Private account", Message: "Follow this user to see their history"})
+		return
+	}
+
+	limit := 50
+	if v, err := strconv.Atoi(c.DefaultQuery("limit", "50")); err == nil && v > 0 && v <= 200 {
+		limit = v
+	}
+
+	query := config.DB.Where("user_id = ?", targetUserID)
+	if sport := c.Query("sport"); sport != "" {
+		query = query.Where("sport = ?", sport)
+	}
+
+	var entries []models.SportRatingHistory
+	if err := query.Order("created_at DESC").Limit(limit).Find(&entries).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{Error: "Database error", Message: "Failed to fetch rating history"})
+		return
+	}
+
+	history := make([]types.RatingHistoryEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		before, after := int(math.Round(e.RatingBefore)), int(math.Round(e.RatingAfter))
+		history = append(history, types.RatingHistoryEntryResponse{
+			Sport:        e.Sport,
+			EventID:      e.EventID,
+			RatingBefore: before,
+			RatingAfter:  after,
+			Change:       after - before,
+			CreatedAt:    e.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// buildSportRatings lists a user's ratings, highest first
+func buildSportRatings(userID uint) []types.SportRatingResponse {
+	var ratings []models.SportRating
+	config.DB.Where("user_id = ? AND games_played > 0", userID).Order("rating DESC").Find(&ratings)
+
+	response := make([]types.SportRatingResponse, 0, len(ratings))
+	for _, r := range ratings {
+		response = append(response, types.SportRatingResponse{
+			Sport:       r.Sport,
+			Rating:      int(math.Round(r.Rating)),
+			GamesPlayed: r.GamesPlayed,
+			Provisional: r.GamesPlayed < services.ProvisionalGames,
+		})
+	}
+	return response
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"backend/src/config"
 	"backend/src/models"
+	"backend/src/services"
 	"backend/src/types"
 	"fmt"
 	"net/http"
@@ -112,7 +113,7 @@ func (sc *SearchController) executeUserSearch(c *gin.Context, req types.SearchUs
 	query := config.DB.Model(&models.User{}).Where(
 		"LOWER(username) LIKE ? OR LOWER(display_name) LIKE ?",
 		searchTerm, searchTerm,
-	)
+	).Scopes(services.BlockedUsersScope(userID.(uint), "users.id"))
 
 	if err := query.Count(&total).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
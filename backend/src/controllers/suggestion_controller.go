@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SuggestionController struct{}
+
+func NewSuggestionController() *SuggestionController {
+	return &SuggestionController{}
+}
+
+// GetSuggestions godoc
+// @Summary      People you may know
+// @Description  Suggest users to follow, ranked by mutual follows, past activities played together, shared sports and living in the same city or country. Each suggestion explains why it was made. People you follow or asked to follow, dismissed suggestions and blocked users are left out.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Number of suggestions (1-50)" default(10)
+// @Success      200 {array} types.UserSuggestionResponse "Suggested users, best match first"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /users/suggestions [get]
+func (sc *SuggestionController) GetSuggestions(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	suggestions, err := services.SuggestUsers(config.DB, userID.(uint), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch suggestions",
+		})
+		return
+	}
+
+	response := make([]types.UserSuggestionResponse, 0, len(suggestions))
+	for _, s := range suggestions {
+		sharedSports := s.SharedSports
+		if sharedSports == nil {
+			sharedSports = []string{}
+		}
+		response = append(response, types.UserSuggestionResponse{
+			ID:            s.User.ID,
+			Username:      s.User.Username,
+			DisplayName:   s.User.DisplayName,
+			AvatarURL:     fmt.Sprintf("/api/user/%d/avatar", s.User.ID),
+			HasAvatar:     len(s.User.AvatarData) > 0,
+			Reason:        s.Reason,
+			Score:         s.Score,
+			MutualFollows: s.MutualFollows,
+			SharedSports:  sharedSports,
+			SharedEvents:  s.SharedEvents,
+			SameCity:      s.SameCity,
+			SameCountry:   s.SameCountry,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DismissSuggestion godoc
+// @Summary      Dismiss a suggestion
+// @Description  Stop suggesting a user. Dismissed users don't come back in suggestions.
+// @Tags         Follow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Suggested user ID"
+// @Success      204 "No Content"
+// @Failure      400 {object} types.ErrorResponse "Invalid user ID or trying to dismiss yourself"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "User not found"
+// @Router       /users/suggestions/{id}/dismiss [post]
+func (sc *SuggestionController) DismissSuggestion(c *gin.Context) {
+	userID, dismissedID, ok := parseRelationTarget(c)
+	if !ok {
+		return
+	}
+
+	if err := services.DismissSuggestion(config.DB, userID, dismissedID); err != nil {
+		switch {
+		case errors.Is(err, services.ErrCannotDismissSelf):
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid Request",
+				Message: "You cannot dismiss yourself",
+			})
+		case errors.Is(err, services.ErrUserNotFound):
+			c.JSON(http.StatusNotFound, types.ErrorResponse{
+				Error:   "User not found",
+				Message: "The requested user does not exist",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to dismiss suggestion",
+			})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,978 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"backend/src/utils"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TeamController struct{}
+
+func NewTeamController() *TeamController {
+	return &TeamController{}
+}
+
+// CreateTeam godoc
+// @Summary      Create a team
+// @Description  Create a persistent team with you as its captain
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        team body types.CreateTeamRequest true "Team data"
+// @Success      201 {object} types.TeamResponse "Team created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /teams [post]
+func (tc *TeamController) CreateTeam(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	team, err := services.CreateTeam(config.DB, userID.(uint), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create team",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildTeamResponse(*team, userID.(uint)))
+}
+
+// GetTeams godoc
+// @Summary      List teams
+// @Description  List teams, newest first, optionally by sport or name
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        sport query string false "Only teams of this sport"
+// @Param        q query string false "Search in team names"
+// @Param        limit query int false "Maximum number of teams" default(20)
+// @Param        offset query int false "Number of teams to skip" default(0)
+// @Success      200 {array} types.TeamResponse "Teams"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /teams [get]
+func (tc *TeamController) GetTeams(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	query := config.DB.Model(&models.Team{})
+	if sport := c.Query("sport"); sport != "" {
+		query = query.Where("LOWER(sport) = LOWER(?)", sport)
+	}
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+strings.ToLower(q)+"%")
+	}
+
+	var teams []models.Team
+	if err := query.Order("created_at DESC").Limit(limit).Offset(max(offset, 0)).Find(&teams).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch teams",
+		})
+		return
+	}
+
+	userID := c.GetUint("userID")
+	responses := make([]types.TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		responses = append(responses, buildTeamResponse(team, userID))
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetMyTeams godoc
+// @Summary      Get my teams
+// @Description  List the teams the current user plays for
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.TeamResponse "Teams of the current user"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /teams/my [get]
+func (tc *TeamController) GetMyTeams(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var teams []models.Team
+	if err := config.DB.Where("id IN (?)", config.DB.Model(&models.TeamMember{}).Select("team_id").Where("user_id = ?", userID)).
+		Order("name ASC").Find(&teams).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch teams",
+		})
+		return
+	}
+
+	responses := make([]types.TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		responses = append(responses, buildTeamResponse(team, userID.(uint)))
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// GetTeam godoc
+// @Summary      Get a team
+// @Description  Get a team with its roster
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {object} types.TeamResponse "Team"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id} [get]
+func (tc *TeamController) GetTeam(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTeamResponse(team, c.GetUint("userID")))
+}
+
+// UpdateTeam godoc
+// @Summary      Update a team
+// @Description  Change a team's name, sport or description (by its captains)
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        team body types.UpdateTeamRequest true "Fields to change"
+// @Success      200 {object} types.TeamResponse "Team updated successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id} [put]
+func (tc *TeamController) UpdateTeam(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	var req types.UpdateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	updates := map[string]any{}
+	if req.Name != nil {
+		updates["name"] = strings.TrimSpace(*req.Name)
+	}
+	if req.Sport != nil {
+		updates["sport"] = strings.TrimSpace(*req.Sport)
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if len(updates) > 0 {
+		if err := config.DB.Model(&team).Updates(updates).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to update team",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, buildTeamResponse(team, c.GetUint("userID")))
+}
+
+// DeleteTeam godoc
+// @Summary      Delete a team
+// @Description  Disband a team (by its captains). Players already signed up for events through the team keep their spots.
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      204 "Team deleted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id} [delete]
+func (tc *TeamController) DeleteTeam(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	if err := services.DeleteTeam(config.DB, &team); err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to delete team",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UploadTeamAvatar godoc
+// @Summary      Upload team avatar
+// @Description  Upload a new avatar image for a team (by its captains). Accepts JPEG, PNG and WebP formats. Maximum file size is 5MB.
+// @Tags         Teams
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        avatar formData file true "Avatar image file (JPEG, PNG, WebP, max 5MB)"
+// @Success      200 {object} types.UploadResponse "Avatar uploaded successfully"
+// @Failure      400 {object} types.FileUploadError "No file uploaded or invalid file format"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Failure      500 {object} types.FileUploadError "File processing or database error"
+// @Router       /teams/{id}/avatar [post]
+func (tc *TeamController) UploadTeamAvatar(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	fileHeader, err := c.FormFile("avatar")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{Error: "No file uploaded", Message: "Please select an image file to upload", ErrorCode: "NO_FILE"})
+		return
+	}
+	if err := utils.ValidateImageFile(fileHeader); err != nil {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{Error: "Invalid file", Message: err.Error(), ErrorCode: "INVALID_FILE"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "File processing failed", Message: "Unable to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	fileContent, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "File processing failed", Message: "Unable to process uploaded file"})
+		return
+	}
+
+	contentType := fileHeader.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = utils.GetContentTypeFromExtension(fileHeader.Filename)
+	}
+
+	updates := map[string]any{
+		"avatar_data": fileContent,
+		"avatar_type": contentType,
+	}
+	if err := config.DB.Model(&team).Updates(updates).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "Database error", Message: "Failed to update team"})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.UploadResponse{
+		Message:   "Avatar uploaded successfully",
+		Success:   true,
+		AvatarURL: fmt.Sprintf("/api/teams/%d/avatar", team.ID),
+	})
+}
+
+// DeleteTeamAvatar godoc
+// @Summary      Delete team avatar
+// @Description  Remove a team's custom avatar image (by its captains)
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {object} types.UploadResponse "Avatar deleted successfully"
+// @Failure      400 {object} types.FileUploadError "No avatar to delete"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id}/avatar [delete]
+func (tc *TeamController) DeleteTeamAvatar(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	if len(team.AvatarData) == 0 {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{
+			Error:   "No avatar to delete",
+			Message: "Team doesn't have a custom avatar",
+		})
+		return
+	}
+
+	updates := map[string]any{
+		"avatar_data": nil,
+		"avatar_type": "",
+	}
+	if err := config.DB.Model(&team).Updates(updates).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{
+			Error:   "Database error",
+			Message: "Failed to delete avatar",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, types.UploadResponse{
+		Message: "Avatar deleted successfully",
+		Success: true,
+	})
+}
+
+// GetTeamAvatar godoc
+// @Summary      Get team avatar
+// @Description  Retrieve a team's avatar image
+// @Tags         Teams
+// @Produce      image/jpeg,image/png,image/webp
+// @Param        id path int true "Team ID"
+// @Success      200 {string} binary "Team's avatar image"
+// @Failure      404 {object} object{error=string} "No avatar found"
+// @Router       /teams/{id}/avatar [get]
+func (tc *TeamController) GetTeamAvatar(c *gin.Context) {
+	var team models.Team
+	if err := config.DB.Select("avatar_data, avatar_type").First(&team, c.Param("id")).Error; err != nil || len(team.AvatarData) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "No avatar found",
+		})
+		return
+	}
+
+	contentType := team.AvatarType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Data(http.StatusOK, contentType, team.AvatarData)
+}
+
+// InviteToTeam godoc
+// @Summary      Invite users to a team
+// @Description  Invite users to join a team (by its captains). Re-inviting a user who declined or left resets their invitation to pending.
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        invitation body types.InviteToTeamRequest true "Users to invite"
+// @Success      201 {array} types.TeamInvitationResponse "Invitations sent"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id}/invitations [post]
+func (tc *TeamController) InviteToTeam(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	var req types.InviteToTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	invitations, err := services.InviteToTeam(config.DB, &team, c.GetUint("userID"), req.UserIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to send invitations",
+		})
+		return
+	}
+	if len(invitations) == 0 {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "None of the given users can be invited",
+		})
+		return
+	}
+
+	response := make([]types.TeamInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		invitation.Team = team
+		response = append(response, buildTeamInvitationResponse(invitation))
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetTeamInvitations godoc
+// @Summary      Get team invitations
+// @Description  List the pending invitations of a team (by its captains)
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {array} types.TeamInvitationResponse "Pending invitations"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id}/invitations [get]
+func (tc *TeamController) GetTeamInvitations(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	var invitations []models.TeamInvitation
+	if err := config.DB.Preload("Invitee").Where("team_id = ? AND status = ?", team.ID, types.InvitationStatusPending).
+		Order("created_at ASC").Find(&invitations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch invitations",
+		})
+		return
+	}
+
+	response := make([]types.TeamInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		invitation.Team = team
+		response = append(response, buildTeamInvitationResponse(invitation))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMyTeamInvitations godoc
+// @Summary      Get my team invitations
+// @Description  List the current user's pending invitations to join teams
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {array} types.TeamInvitationResponse "Pending invitations"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /teams/invitations [get]
+func (tc *TeamController) GetMyTeamInvitations(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var invitations []models.TeamInvitation
+	if err := config.DB.Preload("Team").Preload("Invitee").
+		Joins("JOIN teams ON teams.id = team_invitations.team_id AND teams.deleted_at IS NULL").
+		Where("team_invitations.invitee_id = ? AND team_invitations.status = ?", userID, types.InvitationStatusPending).
+		Order("team_invitations.created_at DESC").
+		Find(&invitations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch invitations",
+		})
+		return
+	}
+
+	response := make([]types.TeamInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		response = append(response, buildTeamInvitationResponse(invitation))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptTeamInvitation godoc
+// @Summary      Accept a team invitation
+// @Description  Accept an invitation and join the team
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {object} types.TeamResponse "Team joined"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Team or invitation not found"
+// @Router       /teams/{id}/invitations/accept [post]
+func (tc *TeamController) AcceptTeamInvitation(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+
+	if err := services.RespondToTeamInvitation(config.DB, &team, c.GetUint("userID"), true); err != nil {
+		respondTeamError(c, err, "Failed to accept invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTeamResponse(team, c.GetUint("userID")))
+}
+
+// DeclineTeamInvitation godoc
+// @Summary      Decline a team invitation
+// @Description  Decline an invitation to join a team
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {object} gin.H "Invitation declined"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Team or invitation not found"
+// @Router       /teams/{id}/invitations/decline [post]
+func (tc *TeamController) DeclineTeamInvitation(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+
+	if err := services.RespondToTeamInvitation(config.DB, &team, c.GetUint("userID"), false); err != nil {
+		respondTeamError(c, err, "Failed to decline invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Invitation declined",
+		"team_id": team.ID,
+	})
+}
+
+// RequestToJoinTeam godoc
+// @Summary      Ask to join a team
+// @Description  Send a request to join a team to its captains. Users with a pending invitation join right away.
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {object} types.TeamResponse "Invitation accepted, team joined"
+// @Success      202 {object} types.TeamJoinRequestResponse "Request sent"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Failure      409 {object} types.ErrorResponse "Already on the team or request already made"
+// @Router       /teams/{id}/join-requests [post]
+func (tc *TeamController) RequestToJoinTeam(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint("userID")
+	request, joined, err := services.RequestToJoinTeam(config.DB, &team, userID)
+	if err != nil {
+		respondTeamError(c, err, "Failed to request to join")
+		return
+	}
+	if joined {
+		c.JSON(http.StatusOK, buildTeamResponse(team, userID))
+		return
+	}
+
+	config.DB.First(&request.User, userID)
+	c.JSON(http.StatusAccepted, buildTeamJoinRequestResponse(*request))
+}
+
+// CancelTeamJoinRequest godoc
+// @Summary      Withdraw a team join request
+// @Description  Withdraw the current user's pending request to join a team
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      204 "Request withdrawn"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Team or request not found"
+// @Router       /teams/{id}/join-requests [delete]
+func (tc *TeamController) CancelTeamJoinRequest(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+
+	if err := services.CancelTeamJoinRequest(config.DB, team.ID, c.GetUint("userID")); err != nil {
+		respondTeamError(c, err, "Failed to withdraw request")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetTeamJoinRequests godoc
+// @Summary      Get team join requests
+// @Description  List the pending requests to join a team (by its captains)
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Success      200 {array} types.TeamJoinRequestResponse "Pending join requests"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team not found"
+// @Router       /teams/{id}/join-requests [get]
+func (tc *TeamController) GetTeamJoinRequests(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+
+	var requests []models.TeamJoinRequest
+	if err := config.DB.Preload("User").Where("team_id = ? AND status = ?", team.ID, types.ParticipationStatusPending).
+		Order("created_at ASC").Find(&requests).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch join requests",
+		})
+		return
+	}
+
+	response := make([]types.TeamJoinRequestResponse, 0, len(requests))
+	for _, request := range requests {
+		response = append(response, buildTeamJoinRequestResponse(request))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptTeamJoinRequest godoc
+// @Summary      Accept a team join request
+// @Description  Accept a user's request and add them to the team (by its captains)
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} types.TeamResponse "Updated team"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team or request not found"
+// @Router       /teams/{id}/join-requests/{userId}/accept [post]
+func (tc *TeamController) AcceptTeamJoinRequest(c *gin.Context) {
+	respondToTeamJoinRequest(c, true)
+}
+
+// RejectTeamJoinRequest godoc
+// @Summary      Reject a team join request
+// @Description  Reject a user's request to join the team (by its captains). The user can't ask again but can still be invited.
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        userId path int true "Requesting user ID"
+// @Success      200 {object} types.TeamResponse "Team"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team or request not found"
+// @Router       /teams/{id}/join-requests/{userId}/reject [post]
+func (tc *TeamController) RejectTeamJoinRequest(c *gin.Context) {
+	respondToTeamJoinRequest(c, false)
+}
+
+// SetTeamMemberRole godoc
+// @Summary      Change a player's team role
+// @Description  Make a player captain or member (by the team's captains). The last captain can't step down.
+// @Tags         Teams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        userId path int true "Player ID"
+// @Param        role body types.SetTeamRoleRequest true "New role"
+// @Success      200 {object} types.TeamResponse "Updated team"
+// @Failure      400 {object} types.ErrorResponse "Invalid role"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not a captain of the team"
+// @Failure      404 {object} types.ErrorResponse "Team or player not found"
+// @Failure      409 {object} types.ErrorResponse "The team would have no captain"
+// @Router       /teams/{id}/members/{userId}/role [put]
+func (tc *TeamController) SetTeamMemberRole(c *gin.Context) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+	memberID, ok := parseTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	var req types.SetTeamRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil || !req.Role.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Role must be captain or member",
+		})
+		return
+	}
+
+	if err := services.SetTeamMemberRole(config.DB, &team, memberID, req.Role); err != nil {
+		respondTeamError(c, err, "Failed to change role")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTeamResponse(team, c.GetUint("userID")))
+}
+
+// RemoveTeamMember godoc
+// @Summary      Remove a player from a team
+// @Description  Take a player off the roster (by the team's captains), or leave the team yourself. The last captain must
+// @Description  hand over the captaincy or delete the team instead.
+// @Tags         Teams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Team ID"
+// @Param        userId path int true "Player ID"
+// @Success      204 "Player removed"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to remove this player"
+// @Failure      404 {object} types.ErrorResponse "Team or player not found"
+// @Failure      409 {object} types.ErrorResponse "The team would have no captain"
+// @Router       /teams/{id}/members/{userId} [delete]
+func (tc *TeamController) RemoveTeamMember(c *gin.Context) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return
+	}
+	memberID, ok := parseTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint("userID")
+	if memberID != userID && !services.IsTeamCaptain(config.DB, team.ID, userID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only captains can remove other players",
+		})
+		return
+	}
+
+	if err := services.RemoveTeamMember(config.DB, &team, memberID); err != nil {
+		respondTeamError(c, err, "Failed to remove player")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondToTeamJoinRequest accepts or rejects the join request of the user in the path
+func respondToTeamJoinRequest(c *gin.Context, accept bool) {
+	team, ok := loadCaptainedTeam(c)
+	if !ok {
+		return
+	}
+	requesterID, ok := parseTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := services.RespondToTeamJoinRequest(config.DB, &team, c.GetUint("userID"), requesterID, accept); err != nil {
+		respondTeamError(c, err, "Failed to answer join request")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTeamResponse(team, c.GetUint("userID")))
+}
+
+// loadTeam loads the team in the path, responding on failure
+func loadTeam(c *gin.Context) (models.Team, bool) {
+	var team models.Team
+
+	if _, exists := c.Get("userID"); !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return team, false
+	}
+
+	teamID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid team ID",
+			Message: "Team ID must be a valid number",
+		})
+		return team, false
+	}
+
+	if err := config.DB.First(&team, teamID).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Team not found",
+			Message: "The requested team does not exist",
+		})
+		return team, false
+	}
+
+	return team, true
+}
+
+// loadCaptainedTeam loads the team in the path and checks the current user captains it
+func loadCaptainedTeam(c *gin.Context) (models.Team, bool) {
+	team, ok := loadTeam(c)
+	if !ok {
+		return team, false
+	}
+
+	if !services.IsTeamCaptain(config.DB, team.ID, c.GetUint("userID")) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only captains can manage the team",
+		})
+		return team, false
+	}
+
+	return team, true
+}
+
+// parseTeamUserID reads the userId path parameter
+func parseTeamUserID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid user ID",
+			Message: "User ID must be a valid number",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondTeamError maps team service errors to responses
+func respondTeamError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrNotTeamMember), errors.Is(err, services.ErrNoTeamInvitation), errors.Is(err, services.ErrNoTeamJoinRequest):
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Not found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrAlreadyTeamMember):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Already on the team",
+			Message: "You are already on this team",
+		})
+	case errors.Is(err, services.ErrTeamJoinRequestPending):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Request pending",
+			Message: "Your request to join is waiting for a captain",
+		})
+	case errors.Is(err, services.ErrTeamJoinRequestRejected):
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Request rejected",
+			Message: "Your request to join was declined",
+		})
+	case errors.Is(err, services.ErrLastCaptain):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Last captain",
+			Message: "Make another player captain first, or delete the team",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildTeamResponse converts a team with its roster, captains first
+func buildTeamResponse(team models.Team, userID uint) types.TeamResponse {
+	var members []models.TeamMember
+	config.DB.Preload("User").Where("team_id = ?", team.ID).Order("joined_at ASC").Find(&members)
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].Role == string(types.TeamRoleCaptain) && members[j].Role != string(types.TeamRoleCaptain)
+	})
+
+	response := types.TeamResponse{
+		ID:          team.ID,
+		Name:        team.Name,
+		Sport:       team.Sport,
+		Description: team.Description,
+		AvatarURL:   fmt.Sprintf("/api/teams/%d/avatar", team.ID),
+		HasAvatar:   len(team.AvatarData) > 0,
+		CreatedByID: team.CreatedByID,
+		MemberCount: len(members),
+		Members:     make([]types.TeamMemberResponse, 0, len(members)),
+		CreatedAt:   team.CreatedAt,
+		UpdatedAt:   team.UpdatedAt,
+	}
+	for _, m := range members {
+		role := types.TeamRole(m.Role)
+		if m.UserID == userID {
+			response.MyRole = &role
+		}
+		response.Members = append(response.Members, types.TeamMemberResponse{
+			UserID:      m.UserID,
+			Username:    m.User.Username,
+			DisplayName: m.User.DisplayName,
+			AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", m.UserID),
+			Role:        role,
+			JoinedAt:    m.JoinedAt,
+		})
+	}
+	return response
+}
+
+// buildTeamInvitationResponse converts an invitation with its Team and Invitee loaded
+func buildTeamInvitationResponse(invitation models.TeamInvitation) types.TeamInvitationResponse {
+	return types.TeamInvitationResponse{
+		ID:          invitation.ID,
+		TeamID:      invitation.TeamID,
+		TeamName:    invitation.Team.Name,
+		InviterID:   invitation.InviterID,
+		InviteeID:   invitation.InviteeID,
+		Username:    invitation.Invitee.Username,
+		DisplayName: invitation.Invitee.DisplayName,
+		Status:      types.InvitationStatus(invitation.Status),
+		CreatedAt:   invitation.CreatedAt,
+		RespondedAt: invitation.RespondedAt,
+	}
+}
+
+// buildTeamJoinRequestResponse converts a join request with its User loaded
+func buildTeamJoinRequestResponse(request models.TeamJoinRequest) types.TeamJoinRequestResponse {
+	return types.TeamJoinRequestResponse{
+		ID:          request.ID,
+		TeamID:      request.TeamID,
+		UserID:      request.UserID,
+		Username:    request.User.Username,
+		DisplayName: request.User.DisplayName,
+		AvatarURL:   fmt.Sprintf("/api/user/%d/avatar", request.UserID),
+		Status:      types.ParticipationStatus(request.Status),
+		CreatedAt:   request.CreatedAt,
+		RespondedAt: request.RespondedAt,
+	}
+}
@@ -0,0 +1,632 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TournamentController struct{}
+
+func NewTournamentController() *TournamentController {
+	return &TournamentController{}
+}
+
+// CreateTournament godoc
+// @Summary      Create a tournament
+// @Description  Turn an upcoming event into a single elimination, double elimination or round robin tournament (by organizers
+// @Description  and co-organizers). Players or teams can register until the tournament is started.
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        tournament body types.CreateTournamentRequest true "Tournament settings"
+// @Success      201 {object} types.TournamentResponse "Tournament created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to manage the event"
+// @Failure      404 {object} types.ErrorResponse "Event not found"
+// @Failure      409 {object} types.ErrorResponse "Event already has a tournament or is not upcoming"
+// @Router       /tournaments [post]
+func (tc *TournamentController) CreateTournament(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateTournamentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if !req.Format.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Format must be one of single_elimination, double_elimination or round_robin",
+		})
+		return
+	}
+	if req.EntryType != "" && !req.EntryType.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Entry type must be player or team",
+		})
+		return
+	}
+	if req.SeedBy != "" && !req.SeedBy.IsValid() {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Seed by must be one of registration, rating or manual",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, req.EventID).Error; err != nil || !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+	if !services.CanManageEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You are not allowed to manage this event",
+		})
+		return
+	}
+	if event.ParentEventID != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "A tournament match cannot host a tournament",
+		})
+		return
+	}
+	if event.Status != string(types.EventStatusUpcoming) {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Event not upcoming",
+			Message: "Tournaments can only be created for upcoming events",
+		})
+		return
+	}
+
+	tournament, err := services.CreateTournament(config.DB, &event, req)
+	if err != nil {
+		respondTournamentError(c, err, "Failed to create tournament")
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildTournamentResponse(*tournament))
+}
+
+// GetTournament godoc
+// @Summary      Get a tournament
+// @Description  Get a tournament with its entries and bracket: rounds of matches with their entries, scores, game events
+// @Description  and the matches winners and losers move on to. Round robins include the standings.
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Success      200 {object} types.TournamentResponse "Tournament and bracket"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Tournament not found"
+// @Router       /tournaments/{id} [get]
+func (tc *TournamentController) GetTournament(c *gin.Context) {
+	tournament, _, ok := loadTournament(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTournamentResponse(tournament))
+}
+
+// GetEventTournament godoc
+// @Summary      Get an event's tournament
+// @Description  Get the tournament held at an event, with its entries and bracket
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Event ID"
+// @Success      200 {object} types.TournamentResponse "Tournament and bracket"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Event or tournament not found"
+// @Router       /events/{id}/tournament [get]
+func (tc *TournamentController) GetEventTournament(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var event models.Event
+	if err := config.DB.First(&event, c.Param("id")).Error; err != nil || !services.CanViewEvent(config.DB, &event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Event not found",
+			Message: "The requested event does not exist",
+		})
+		return
+	}
+
+	var tournament models.Tournament
+	if err := config.DB.Where("event_id = ?", event.ID).First(&tournament).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Tournament not found",
+			Message: "No tournament is held at this event",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTournamentResponse(tournament))
+}
+
+// RegisterEntry godoc
+// @Summary      Register for a tournament
+// @Description  Register yourself as a player, or a team you play in, while registration is open. Organizers can register
+// @Description  other players and teams.
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Param        entry body types.RegisterTournamentEntryRequest false "Team name and players (team tournaments)"
+// @Success      201 {object} types.TournamentEntryResponse "Entry registered"
+// @Failure      400 {object} types.ErrorResponse "Invalid entry"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to register these players"
+// @Failure      404 {object} types.ErrorResponse "Tournament not found"
+// @Failure      409 {object} types.ErrorResponse "Registration closed, tournament full or already registered"
+// @Router       /tournaments/{id}/entries [post]
+func (tc *TournamentController) RegisterEntry(c *gin.Context) {
+	tournament, event, ok := loadTournament(c)
+	if !ok {
+		return
+	}
+	userID := c.GetUint("userID")
+
+	// The body is optional for player entries
+	var req types.RegisterTournamentEntryRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+	if len(req.UserIDs) == 0 {
+		req.UserIDs = []uint{userID}
+	}
+
+	if services.IsBannedFromEvent(config.DB, event.ID, userID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You have been removed from this event",
+		})
+		return
+	}
+
+	includesSelf := false
+	for _, uid := range req.UserIDs {
+		includesSelf = includesSelf || uid == userID
+	}
+	if !includesSelf && !services.CanManageEvent(config.DB, &event, userID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only register entries you play in",
+		})
+		return
+	}
+
+	entry, err := services.RegisterEntry(config.DB, &tournament, userID, req.Name, req.UserIDs)
+	if err != nil {
+		respondTournamentError(c, err, "Failed to register")
+		return
+	}
+
+	config.DB.Preload("Members.User").First(entry, entry.ID)
+	c.JSON(http.StatusCreated, buildTournamentEntryResponse(*entry))
+}
+
+// WithdrawEntry godoc
+// @Summary      Withdraw from a tournament
+// @Description  Withdraw an entry while registration is open (by its players, the user who registered it and organizers)
+// @Tags         Tournaments
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Param        entryId path int true "Entry ID"
+// @Success      204 "Entry withdrawn"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to withdraw this entry"
+// @Failure      404 {object} types.ErrorResponse "Tournament or entry not found"
+// @Failure      409 {object} types.ErrorResponse "Registration closed"
+// @Router       /tournaments/{id}/entries/{entryId} [delete]
+func (tc *TournamentController) WithdrawEntry(c *gin.Context) {
+	tournament, event, ok := loadTournament(c)
+	if !ok {
+		return
+	}
+	userID := c.GetUint("userID")
+
+	var entry models.TournamentEntry
+	if err := config.DB.Preload("Members").Where("id = ? AND tournament_id = ?", c.Param("entryId"), tournament.ID).First(&entry).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Entry not found",
+			Message: "The requested entry does not exist",
+		})
+		return
+	}
+
+	allowed := entry.RegisteredByID == userID
+	for _, member := range entry.Members {
+		allowed = allowed || member.UserID == userID
+	}
+	if !allowed && !services.CanManageEvent(config.DB, &event, userID) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only withdraw entries you play in or registered",
+		})
+		return
+	}
+
+	if err := services.WithdrawEntry(config.DB, &tournament, entry.ID); err != nil {
+		respondTournamentError(c, err, "Failed to withdraw entry")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetSeeds godoc
+// @Summary      Seed a tournament
+// @Description  Seed every entry manually before the tournament starts (by organizers and co-organizers)
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Param        seeds body types.SetTournamentSeedsRequest true "Entries from first seed to last"
+// @Success      200 {object} types.TournamentResponse "Updated tournament"
+// @Failure      400 {object} types.ErrorResponse "Invalid seeds"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to manage the tournament"
+// @Failure      404 {object} types.ErrorResponse "Tournament not found"
+// @Failure      409 {object} types.ErrorResponse "Tournament already started"
+// @Router       /tournaments/{id}/seeds [put]
+func (tc *TournamentController) SetSeeds(c *gin.Context) {
+	tournament, ok := loadManagedTournament(c)
+	if !ok {
+		return
+	}
+
+	var req types.SetTournamentSeedsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := services.SetTournamentSeeds(config.DB, &tournament, req.EntryIDs); err != nil {
+		respondTournamentError(c, err, "Failed to seed tournament")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTournamentResponse(tournament))
+}
+
+// StartTournament godoc
+// @Summary      Start a tournament
+// @Description  Close registration, seed the entries and draw the bracket (by organizers and co-organizers). Every match is
+// @Description  played as a game event, created once both of its entries are known.
+// @Tags         Tournaments
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Success      200 {object} types.TournamentResponse "Tournament with its bracket"
+// @Failure      400 {object} types.ErrorResponse "Not enough entries"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to manage the tournament"
+// @Failure      404 {object} types.ErrorResponse "Tournament not found"
+// @Failure      409 {object} types.ErrorResponse "Tournament already started"
+// @Router       /tournaments/{id}/start [post]
+func (tc *TournamentController) StartTournament(c *gin.Context) {
+	tournament, ok := loadManagedTournament(c)
+	if !ok {
+		return
+	}
+
+	if err := services.StartTournament(config.DB, &tournament); err != nil {
+		respondTournamentError(c, err, "Failed to start tournament")
+		return
+	}
+
+	c.JSON(http.StatusOK, buildTournamentResponse(tournament))
+}
+
+// RecordMatchScore godoc
+// @Summary      Enter a match score
+// @Description  Enter the final score of a scheduled match (by organizers, co-organizers and referees of the tournament event).
+// @Description  The result is final right away and the winner, and in double elimination the loser, move on through the bracket.
+// @Description  Elimination matches cannot end in a draw.
+// @Tags         Tournaments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Tournament ID"
+// @Param        matchId path int true "Match ID"
+// @Param        score body types.TournamentScoreRequest true "Score per entry"
+// @Success      200 {object} types.TournamentResponse "Updated tournament"
+// @Failure      400 {object} types.ErrorResponse "Invalid scores"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to manage the tournament"
+// @Failure      404 {object} types.ErrorResponse "Tournament or match not found"
+// @Failure      409 {object} types.ErrorResponse "Match not scheduled or already played"
+// @Router       /tournaments/{id}/matches/{matchId}/score [post]
+func (tc *TournamentController) RecordMatchScore(c *gin.Context) {
+	tournament, ok := loadManagedTournament(c, types.ParticipantRoleReferee)
+	if !ok {
+		return
+	}
+
+	var match models.TournamentMatch
+	if err := config.DB.Where("id = ? AND tournament_id = ?", c.Param("matchId"), tournament.ID).First(&match).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Match not found",
+			Message: "The requested match does not exist",
+		})
+		return
+	}
+
+	var req types.TournamentScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if _, err := services.RecordTournamentScore(config.DB, &tournament, &match, c.GetUint("userID"), req); err != nil {
+		respondTournamentError(c, err, "Failed to record score")
+		return
+	}
+
+	config.DB.First(&tournament, tournament.ID)
+	c.JSON(http.StatusOK, buildTournamentResponse(tournament))
+}
+
+// loadTournament loads the tournament in the path and its event, which the current user must be allowed to see
+func loadTournament(c *gin.Context) (models.Tournament, models.Event, bool) {
+	var tournament models.Tournament
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return tournament, models.Event{}, false
+	}
+
+	tournamentID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid tournament ID",
+			Message: "Tournament ID must be a valid number",
+		})
+		return tournament, models.Event{}, false
+	}
+
+	// Tournaments of events the user may not see are reported as missing
+	if err := config.DB.Preload("Event").First(&tournament, tournamentID).Error; err != nil ||
+		!services.CanViewEvent(config.DB, &tournament.Event, userID.(uint)) {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Tournament not found",
+			Message: "The requested tournament does not exist",
+		})
+		return tournament, models.Event{}, false
+	}
+
+	return tournament, tournament.Event, true
+}
+
+// loadManagedTournament loads the tournament in the path and checks the current user may manage its event
+func loadManagedTournament(c *gin.Context, extraRoles ...types.ParticipantRole) (models.Tournament, bool) {
+	tournament, event, ok := loadTournament(c)
+	if !ok {
+		return tournament, false
+	}
+
+	roles := append([]types.ParticipantRole{types.ParticipantRoleOrganizer, types.ParticipantRoleCoOrganizer}, extraRoles...)
+	if !services.HasEventRole(config.DB, &event, c.GetUint("userID"), roles...) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You are not allowed to manage this tournament",
+		})
+		return tournament, false
+	}
+
+	return tournament, true
+}
+
+// respondTournamentError maps tournament service errors to responses
+func respondTournamentError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, services.ErrInvalidEntry), errors.Is(err, services.ErrInvalidSeeds),
+		errors.Is(err, services.ErrNotEnoughEntries), errors.Is(err, services.ErrInvalidScores):
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrTournamentExists):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Tournament exists",
+			Message: "This event already has a tournament",
+		})
+	case errors.Is(err, services.ErrRegistrationClosed):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Registration closed",
+			Message: "The tournament has already started",
+		})
+	case errors.Is(err, services.ErrTournamentFull):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Tournament full",
+			Message: "The tournament has reached its maximum number of entries",
+		})
+	case errors.Is(err, services.ErrAlreadyRegistered):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Already registered",
+			Message: "A player of this entry is already registered for the tournament",
+		})
+	case errors.Is(err, services.ErrMatchNotScheduled):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Match not scheduled",
+			Message: "Both entries of this match are not known yet",
+		})
+	case errors.Is(err, services.ErrResultConfirmed):
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Match played",
+			Message: "The score of this match has already been entered",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: fallback,
+		})
+	}
+}
+
+// buildTournamentResponse builds a tournament with its entries, bracket rounds and, for round robins, standings
+func buildTournamentResponse(tournament models.Tournament) types.TournamentResponse {
+	var event models.Event
+	config.DB.Unscoped().First(&event, tournament.EventID)
+
+	var entries []models.TournamentEntry
+	config.DB.Preload("Members.User").Where("tournament_id = ?", tournament.ID).Order("seed ASC NULLS LAST, id ASC").Find(&entries)
+
+	var matches []models.TournamentMatch
+	config.DB.Where("tournament_id = ?", tournament.ID).Order("round ASC, position ASC").Find(&matches)
+
+	response := types.TournamentResponse{
+		ID:                   tournament.ID,
+		EventID:              tournament.EventID,
+		Title:                event.Title,
+		Sport:                event.Sport,
+		StartAt:              event.StartAt,
+		Format:               types.TournamentFormat(tournament.Format),
+		EntryType:            types.TournamentEntryType(tournament.EntryType),
+		TeamSize:             tournament.TeamSize,
+		MaxEntries:           tournament.MaxEntries,
+		SeedBy:               types.TournamentSeeding(tournament.SeedBy),
+		MatchDurationMinutes: tournament.MatchDurationMinutes,
+		Status:               types.TournamentStatus(tournament.Status),
+		WinnerEntryID:        tournament.WinnerEntryID,
+		Entries:              make([]types.TournamentEntryResponse, 0, len(entries)),
+		Rounds:               buildTournamentRounds(tournament, matches),
+		CreatedAt:            tournament.CreatedAt,
+		UpdatedAt:            tournament.UpdatedAt,
+	}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, buildTournamentEntryResponse(entry))
+	}
+	if tournament.Format == string(types.TournamentRoundRobin) && len(matches) > 0 {
+		response.Standings = services.TournamentStandings(entries, matches)
+	}
+
+	return response
+}
+
+// buildTournamentRounds groups matches into rounds: the winners bracket, then the losers bracket, then the final
+func buildTournamentRounds(tournament models.Tournament, matches []models.TournamentMatch) []types.TournamentRoundResponse {
+	bracketOrder := map[string]int{
+		string(types.TournamentBracketRoundRobin): 0,
+		string(types.TournamentBracketWinners):    0,
+		string(types.TournamentBracketLosers):     1,
+		string(types.TournamentBracketFinal):      2,
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return bracketOrder[matches[i].Bracket] < bracketOrder[matches[j].Bracket]
+	})
+
+	lastRound := make(map[string]int)
+	for _, m := range matches {
+		if m.Round > lastRound[m.Bracket] {
+			lastRound[m.Bracket] = m.Round
+		}
+	}
+
+	rounds := make([]types.TournamentRoundResponse, 0)
+	for _, m := range matches {
+		if n := len(rounds); n == 0 || rounds[n-1].Bracket != types.TournamentBracket(m.Bracket) || rounds[n-1].Round != m.Round {
+			rounds = append(rounds, types.TournamentRoundResponse{
+				Bracket: types.TournamentBracket(m.Bracket),
+				Round:   m.Round,
+				Name:    services.TournamentRoundName(tournament.Format, m.Bracket, m.Round, lastRound[m.Bracket]),
+				Matches: make([]types.TournamentMatchResponse, 0),
+			})
+		}
+		round := &rounds[len(rounds)-1]
+		round.Matches = append(round.Matches, types.TournamentMatchResponse{
+			ID:               m.ID,
+			Bracket:          types.TournamentBracket(m.Bracket),
+			Round:            m.Round,
+			Position:         m.Position,
+			Status:           types.TournamentMatchStatus(m.Status),
+			ScheduledAt:      m.ScheduledAt,
+			EventID:          m.EventID,
+			Entry1ID:         m.Entry1ID,
+			Entry2ID:         m.Entry2ID,
+			Score1:           m.Score1,
+			Score2:           m.Score2,
+			WinnerEntryID:    m.WinnerEntryID,
+			NextMatchID:      m.NextMatchID,
+			NextSlot:         m.NextSlot,
+			LoserNextMatchID: m.LoserNextMatchID,
+			LoserNextSlot:    m.LoserNextSlot,
+		})
+	}
+	return rounds
+}
+
+// buildTournamentEntryResponse builds an entry; its members must have their User preloaded
+func buildTournamentEntryResponse(entry models.TournamentEntry) types.TournamentEntryResponse {
+	response := types.TournamentEntryResponse{
+		ID:             entry.ID,
+		Name:           entry.Name,
+		Seed:           entry.Seed,
+		RegisteredByID: entry.RegisteredByID,
+		Players:        make([]types.SidePlayerResponse, 0, len(entry.Members)),
+	}
+	for _, member := range entry.Members {
+		response.Players = append(response.Players, types.SidePlayerResponse{
+			UserID:      member.UserID,
+			Username:    member.User.Username,
+			DisplayName: member.User.DisplayName,
+		})
+	}
+	return response
+}
@@ -0,0 +1,417 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// CreateVenueResource godoc
+// @Summary      Add a bookable resource to a venue
+// @Description  Add a court, field or lane that events can book. Only the user who added the venue can manage its resources.
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        resource body types.CreateVenueResourceRequest true "Resource data"
+// @Success      201 {object} types.VenueResourceResponse "Resource created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the user who added the venue"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Router       /venues/{id}/resources [post]
+func (vc *VenueController) CreateVenueResource(c *gin.Context) {
+	venue, ok := loadOwnedVenue(c)
+	if !ok {
+		return
+	}
+
+	var req types.CreateVenueResourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	resource := models.VenueResource{
+		VenueID: venue.ID,
+		Name:    strings.TrimSpace(req.Name),
+		Sport:   strings.TrimSpace(req.Sport),
+	}
+	if err := config.DB.Create(&resource).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create resource",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildVenueResourceResponse(resource))
+}
+
+// UpdateVenueResource godoc
+// @Summary      Update a bookable resource
+// @Description  Rename a resource or change its sport
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        resourceId path int true "Resource ID"
+// @Param        resource body types.UpdateVenueResourceRequest true "Resource update data"
+// @Success      200 {object} types.VenueResourceResponse "Resource updated successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the user who added the venue"
+// @Failure      404 {object} types.ErrorResponse "Venue or resource not found"
+// @Router       /venues/{id}/resources/{resourceId} [put]
+func (vc *VenueController) UpdateVenueResource(c *gin.Context) {
+	venue, ok := loadOwnedVenue(c)
+	if !ok {
+		return
+	}
+	resource, ok := loadVenueResource(c, venue.ID)
+	if !ok {
+		return
+	}
+
+	var req types.UpdateVenueResourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if req.Name != nil {
+		resource.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Sport != nil {
+		resource.Sport = strings.TrimSpace(*req.Sport)
+	}
+	if err := config.DB.Save(&resource).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to update resource",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, buildVenueResourceResponse(resource))
+}
+
+// DeleteVenueResource godoc
+// @Summary      Delete a bookable resource
+// @Description  Delete a resource. Upcoming events that booked it stay at the venue without a resource.
+// @Tags         Venues
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        resourceId path int true "Resource ID"
+// @Success      204 "Resource deleted"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the user who added the venue"
+// @Failure      404 {object} types.ErrorResponse "Venue or resource not found"
+// @Router       /venues/{id}/resources/{resourceId} [delete]
+func (vc *VenueController) DeleteVenueResource(c *gin.Context) {
+	venue, ok := loadOwnedVenue(c)
+	if !ok {
+		return
+	}
+	resource, ok := loadVenueResource(c, venue.ID)
+	if !ok {
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Event{}).
+			Where("resource_id = ? AND status = ?", resource.ID, types.EventStatusUpcoming).
+			Update("resource_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&resource).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to delete resource",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetVenueOpeningHours godoc
+// @Summary      Set venue opening hours
+// @Description  Replace the weekly opening hours of a venue. Times are in the venue's time zone; a day can have several
+// @Description  intervals. Without opening hours the venue counts as always open.
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        hours body types.SetOpeningHoursRequest true "Opening hours"
+// @Success      200 {object} types.VenueResponse "Opening hours updated"
+// @Failure      400 {object} types.ErrorResponse "Invalid opening hours"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the user who added the venue"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Router       /venues/{id}/hours [put]
+func (vc *VenueController) SetVenueOpeningHours(c *gin.Context) {
+	venue, ok := loadOwnedVenue(c)
+	if !ok {
+		return
+	}
+
+	var req types.SetOpeningHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if req.Timezone != nil {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "" {
+			respondUnknownTimezone(c)
+			return
+		}
+		venue.Timezone = *req.Timezone
+	}
+
+	hours := make([]models.VenueOpeningHours, 0, len(req.Hours))
+	for _, entry := range req.Hours {
+		opens, ok1 := parseDayMinute(entry.Opens)
+		closes, ok2 := parseDayMinute(entry.Closes)
+		if !ok1 || !ok2 || opens >= closes || opens == 24*60 {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: fmt.Sprintf("Invalid opening hours %s-%s: use HH:MM with opening before closing (24:00 for midnight)", entry.Opens, entry.Closes),
+			})
+			return
+		}
+		hours = append(hours, models.VenueOpeningHours{VenueID: venue.ID, Weekday: entry.Weekday, OpensAt: opens, ClosesAt: closes})
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&venue).Update("timezone", venue.Timezone).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("venue_id = ?", venue.ID).Delete(&models.VenueOpeningHours{}).Error; err != nil {
+			return err
+		}
+		if len(hours) > 0 {
+			return tx.Create(&hours).Error
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to update opening hours",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, buildVenueResponse(venue, nil))
+}
+
+// GetVenueAvailability godoc
+// @Summary      Get venue availability
+// @Description  Return, per resource, when the venue is open on a day, when the resource is booked and the free slots in between.
+// @Description  Events without an end time hold their resource for one hour.
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        date query string true "Day in the venue's time zone (YYYY-MM-DD)"
+// @Param        resource_id query int false "Only this resource"
+// @Param        min_minutes query int false "Only free slots at least this long" default(30)
+// @Success      200 {object} types.VenueAvailabilityResponse "Availability per resource"
+// @Failure      400 {object} types.ErrorResponse "Invalid date"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Router       /venues/{id}/availability [get]
+func (vc *VenueController) GetVenueAvailability(c *gin.Context) {
+	venue, ok := loadVenue(c)
+	if !ok {
+		return
+	}
+
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	day, err := time.ParseInLocation("2006-01-02", c.Query("date"), loc)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "date must be a day formatted as YYYY-MM-DD",
+		})
+		return
+	}
+	dayEnd := day.AddDate(0, 0, 1)
+
+	minMinutes, err := strconv.Atoi(c.DefaultQuery("min_minutes", "30"))
+	if err != nil || minMinutes <= 0 {
+		minMinutes = 30
+	}
+
+	query := config.DB.Where("venue_id = ?", venue.ID)
+	if resourceID := c.Query("resource_id"); resourceID != "" {
+		query = query.Where("id = ?", resourceID)
+	}
+	var resources []models.VenueResource
+	if err := query.Order("name ASC, id ASC").Find(&resources).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch resources",
+		})
+		return
+	}
+
+	open, err := services.VenueOpenIntervals(config.DB, &venue, day, dayEnd)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch opening hours",
+		})
+		return
+	}
+
+	resourceIDs := make([]uint, 0, len(resources))
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.ID)
+	}
+	bookings, err := services.ResourceBookings(config.DB, resourceIDs, day, dayEnd)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch bookings",
+		})
+		return
+	}
+
+	response := types.VenueAvailabilityResponse{
+		VenueID:   venue.ID,
+		Date:      day.Format("2006-01-02"),
+		Timezone:  loc.String(),
+		Resources: make([]types.ResourceAvailabilityResponse, 0, len(resources)),
+	}
+	for _, resource := range resources {
+		booked := bookings[resource.ID]
+		response.Resources = append(response.Resources, types.ResourceAvailabilityResponse{
+			ResourceID: resource.ID,
+			Name:       resource.Name,
+			Open:       buildTimeSlots(open),
+			Booked:     buildTimeSlots(booked),
+			Free:       buildTimeSlots(services.SubtractIntervals(open, booked, time.Duration(minMinutes)*time.Minute)),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// loadOwnedVenue loads the venue from the :id path parameter and checks that the current
+// user added it, responding on failure
+func loadOwnedVenue(c *gin.Context) (models.Venue, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return models.Venue{}, false
+	}
+
+	venue, ok := loadVenue(c)
+	if !ok {
+		return venue, false
+	}
+
+	if venue.CreatedByID == nil || *venue.CreatedByID != userID.(uint) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage venues you added",
+		})
+		return venue, false
+	}
+
+	return venue, true
+}
+
+// loadVenueResource loads the resource from the :resourceId path parameter, responding on failure
+func loadVenueResource(c *gin.Context, venueID uint) (models.VenueResource, bool) {
+	var resource models.VenueResource
+
+	resourceID, err := strconv.ParseUint(c.Param("resourceId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid resource ID",
+			Message: "Resource ID must be a valid number",
+		})
+		return resource, false
+	}
+
+	if err := config.DB.Where("id = ? AND venue_id = ?", resourceID, venueID).First(&resource).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Resource not found",
+			Message: "The requested resource does not belong to this venue",
+		})
+		return resource, false
+	}
+
+	return resource, true
+}
+
+// parseDayMinute parses "HH:MM" (up to "24:00") into minutes after midnight
+func parseDayMinute(value string) (int, bool) {
+	hours, minutes, found := strings.Cut(value, ":")
+	if !found {
+		return 0, false
+	}
+	h, err1 := strconv.Atoi(hours)
+	m, err2 := strconv.Atoi(minutes)
+	if err1 != nil || err2 != nil || h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, false
+	}
+	return h*60 + m, true
+}
+
+// formatDayMinute renders minutes after midnight as "HH:MM"
+func formatDayMinute(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func buildVenueResourceResponse(resource models.VenueResource) types.VenueResourceResponse {
+	return types.VenueResourceResponse{
+		ID:      resource.ID,
+		VenueID: resource.VenueID,
+		Name:    resource.Name,
+		Sport:   resource.Sport,
+	}
+}
+
+func buildTimeSlots(intervals []services.Interval) []types.TimeSlot {
+	slots := make([]types.TimeSlot, 0, len(intervals))
+	for _, interval := range intervals {
+		slots = append(slots, types.TimeSlot{StartAt: interval.Start, EndAt: interval.End})
+	}
+	return slots
+}
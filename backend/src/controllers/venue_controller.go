@@ -0,0 +1,707 @@
+package controllers
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/services"
+	"backend/src/types"
+	"backend/src/utils"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxVenuePhotos bounds the number of photos a venue can have
+const maxVenuePhotos = 20
+
+type VenueController struct{}
+
+func NewVenueController() *VenueController {
+	return &VenueController{}
+}
+
+// CreateVenue godoc
+// @Summary      Create a venue
+// @Description  Add a reusable venue that events can reference. Fails when a venue with a similar name already exists within 100 meters.
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        venue body types.CreateVenueRequest true "Venue data"
+// @Success      201 {object} types.VenueResponse "Venue created successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      409 {object} types.ErrorResponse "A similar venue already exists nearby"
+// @Router       /venues [post]
+func (vc *VenueController) CreateVenue(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	var req types.CreateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if req.Surface != "" && !req.Surface.IsValid() {
+		respondInvalidVenueSurface(c)
+		return
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		respondUnknownTimezone(c)
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	longitude := utils.NormalizeLongitude(req.Longitude)
+	if !respondIfSimilarVenueExists(c, name, req.Latitude, longitude, 0) {
+		return
+	}
+
+	sports, err := services.FindOrCreateSports(config.DB, req.Sports)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to process sports",
+		})
+		return
+	}
+
+	createdBy := userID.(uint)
+	venue := models.Venue{
+		CreatedByID: &createdBy,
+		Name:        name,
+		Address:     strings.TrimSpace(req.Address),
+		Latitude:    &req.Latitude,
+		Longitude:   &longitude,
+		Surface:     string(req.Surface),
+		Indoor:      req.Indoor,
+		Lit:         req.Lit,
+		Timezone:    timezone,
+		Sports:      sports,
+	}
+	if err := config.DB.Create(&venue).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to create venue",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildVenueResponse(venue, nil))
+}
+
+// SearchVenues godoc
+// @Summary      Search venues
+// @Description  Search venues by name or address, sport, surface and flags, optionally around a point
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        q query string false "Text to look for in the name or address"
+// @Param        sport query string false "Only venues supporting this sport"
+// @Param        surface query string false "Only venues with this surface"
+// @Param        indoor query bool false "Only indoor (true) or outdoor (false) venues"
+// @Param        lit query bool false "Only venues with (true) or without (false) lighting"
+// @Param        lat query float false "Latitude of the reference point; results then include distance_km"
+// @Param        lng query float false "Longitude of the reference point"
+// @Param        radius_km query float false "Only venues within this great-circle distance (km) of lat/lng"
+// @Param        sort query string false "Sort order: name (default) or distance (requires lat/lng)"
+// @Param        limit query int false "Limit number of results (max 100)" default(20)
+// @Param        offset query int false "Offset for pagination" default(0)
+// @Success      200 {array} types.VenueResponse "List of venues"
+// @Failure      400 {object} types.ErrorResponse "Invalid request"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Router       /venues [get]
+func (vc *VenueController) SearchVenues(c *gin.Context) {
+	origin, ok := parseEventOrigin(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	limit = min(limit, 100)
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	query := config.DB.Model(&models.Venue{}).Scopes(eventRadiusScope(origin, c.Query("radius_km")))
+
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		pattern := "%" + strings.ToLower(q) + "%"
+		query = query.Where("LOWER(name) LIKE ? OR LOWER(address) LIKE ?", pattern, pattern)
+	}
+	if sport := c.Query("sport"); sport != "" {
+		query = query.Where(`EXISTS (SELECT 1 FROM venue_sports vs JOIN sports s ON s.id = vs.sport_id
+			WHERE vs.venue_id = venues.id AND LOWER(s.name) = LOWER(?))`, sport)
+	}
+	if surface := c.Query("surface"); surface != "" {
+		query = query.Where("surface = ?", surface)
+	}
+	if indoor, err := strconv.ParseBool(c.Query("indoor")); err == nil {
+		query = query.Where("indoor = ?", indoor)
+	}
+	if lit, err := strconv.ParseBool(c.Query("lit")); err == nil {
+		query = query.Where("lit = ?", lit)
+	}
+
+	switch c.DefaultQuery("sort", "name") {
+	case "name":
+		query = query.Order("name ASC").Order("id ASC")
+	case "distance":
+		if origin == nil {
+			c.JSON(http.StatusBadRequest, types.ErrorResponse{
+				Error:   "Invalid request",
+				Message: "Sorting by distance requires lat and lng",
+			})
+			return
+		}
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  utils.HaversineSQL("latitude", "longitude") + " ASC, id ASC",
+			Vars: []any{origin[0], origin[0], origin[1]},
+		}})
+	default:
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: "Sort must be one of name or distance",
+		})
+		return
+	}
+
+	var venues []models.Venue
+	if err := query.Preload("Sports").Limit(limit).Offset(offset).Find(&venues).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch venues",
+		})
+		return
+	}
+
+	response := make([]types.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		response = append(response, buildVenueResponse(venue, origin))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetVenue godoc
+// @Summary      Get a venue page
+// @Description  Get a venue with its photos and the upcoming events at the venue that the current user can see
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        events_limit query int false "Maximum number of upcoming events (max 100)" default(20)
+// @Success      200 {object} types.VenueDetailResponse "Venue page"
+// @Failure      400 {object} types.ErrorResponse "Invalid venue ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Router       /venues/{id} [get]
+func (vc *VenueController) GetVenue(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	venue, ok := loadVenue(c)
+	if !ok {
+		return
+	}
+
+	eventsLimit, err := strconv.Atoi(c.DefaultQuery("events_limit", "20"))
+	if err != nil || eventsLimit <= 0 {
+		eventsLimit = 20
+	}
+	eventsLimit = min(eventsLimit, 100)
+
+	var events []models.Event
+	if err := config.DB.Preload("Organizer").
+		Where("venue_id = ? AND status = ? AND deleted_at IS NULL", venue.ID, types.EventStatusUpcoming).
+		Scopes(services.VisibleEventsScope(userID.(uint))).
+		Order("start_at ASC").Limit(eventsLimit).Find(&events).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to fetch venue events",
+		})
+		return
+	}
+
+	response := types.VenueDetailResponse{
+		VenueResponse: buildVenueResponse(venue, nil),
+		Events:        make([]types.EventWithOrganizerResponse, 0, len(events)),
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, buildEventWithOrganizerResponse(event, userID.(uint)))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateVenue godoc
+// @Summary      Update a venue
+// @Description  Update a venue you added. Upcoming events at the venue take over its new name and coordinates.
+// @Tags         Venues
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        venue body types.UpdateVenueRequest true "Venue update data"
+// @Success      200 {object} types.VenueResponse "Venue updated successfully"
+// @Failure      400 {object} types.ErrorResponse "Invalid request data"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not the user who added the venue"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Failure      409 {object} types.ErrorResponse "A similar venue already exists nearby"
+// @Router       /venues/{id} [put]
+func (vc *VenueController) UpdateVenue(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	venue, ok := loadVenue(c)
+	if !ok {
+		return
+	}
+
+	if venue.CreatedByID == nil || *venue.CreatedByID != userID.(uint) {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only update venues you added",
+		})
+		return
+	}
+
+	var req types.UpdateVenueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if req.Surface != nil && *req.Surface != "" && !req.Surface.IsValid() {
+		respondInvalidVenueSurface(c)
+		return
+	}
+
+	locationChanged := req.Name != nil || req.Latitude != nil || req.Longitude != nil
+	if req.Name != nil {
+		venue.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Address != nil {
+		venue.Address = strings.TrimSpace(*req.Address)
+	}
+	if req.Latitude != nil {
+		venue.Latitude = req.Latitude
+	}
+	if req.Longitude != nil {
+		longitude := utils.NormalizeLongitude(*req.Longitude)
+		venue.Longitude = &longitude
+	}
+	if req.Surface != nil {
+		venue.Surface = string(*req.Surface)
+	}
+	if req.Indoor != nil {
+		venue.Indoor = *req.Indoor
+	}
+	if req.Lit != nil {
+		venue.Lit = *req.Lit
+	}
+	if req.Timezone != nil {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "" {
+			respondUnknownTimezone(c)
+			return
+		}
+		venue.Timezone = *req.Timezone
+	}
+
+	if locationChanged && !respondIfSimilarVenueExists(c, venue.Name, *venue.Latitude, *venue.Longitude, venue.ID) {
+		return
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Sports").Save(&venue).Error; err != nil {
+			return err
+		}
+		if req.Sports != nil {
+			sports, err := services.FindOrCreateSports(tx, *req.Sports)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&venue).Association("Sports").Replace(&sports); err != nil {
+				return err
+			}
+		}
+		if locationChanged {
+			return services.SyncVenueEvents(tx, &venue)
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to update venue",
+		})
+		return
+	}
+
+	config.DB.Preload("Sports").First(&venue, venue.ID)
+	c.JSON(http.StatusOK, buildVenueResponse(venue, nil))
+}
+
+// UploadVenuePhoto godoc
+// @Summary      Upload venue photo
+// @Description  Add a photo to a venue. A venue can have up to 20 photos.
+// @Tags         Venues
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        photo formData file true "Image file (JPEG, PNG, GIF, WebP, max 5MB)"
+// @Success      201 {object} types.VenuePhotoResponse "Photo uploaded successfully"
+// @Failure      400 {object} types.FileUploadError "No file uploaded, invalid file or too many photos"
+// @Failure      401 {object} types.FileUploadError "User not authenticated"
+// @Failure      404 {object} types.ErrorResponse "Venue not found"
+// @Router       /venues/{id}/photos [post]
+func (vc *VenueController) UploadVenuePhoto(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.FileUploadError{Error: "Unauthorized", Message: "User not authenticated"})
+		return
+	}
+
+	venue, ok := loadVenue(c)
+	if !ok {
+		return
+	}
+
+	var photoCount int64
+	config.DB.Model(&models.VenuePhoto{}).Where("venue_id = ?", venue.ID).Count(&photoCount)
+	if photoCount >= maxVenuePhotos {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{
+			Error:     "Too many photos",
+			Message:   fmt.Sprintf("A venue can have at most %d photos", maxVenuePhotos),
+			ErrorCode: "TOO_MANY_PHOTOS",
+		})
+		return
+	}
+
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{Error: "No file uploaded", Message: "Please select an image file to upload", ErrorCode: "NO_FILE"})
+		return
+	}
+	if err := utils.ValidateImageFile(fileHeader); err != nil {
+		c.JSON(http.StatusBadRequest, types.FileUploadError{Error: "Invalid file", Message: err.Error(), ErrorCode: "INVALID_FILE"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "File processing failed", Message: "Unable to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	fileContent, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "File processing failed", Message: "Unable to process uploaded file"})
+		return
+	}
+
+	contentType := fileHeader.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = utils.GetContentTypeFromExtension(fileHeader.Filename)
+	}
+
+	photo := models.VenuePhoto{
+		VenueID:      venue.ID,
+		UploadedByID: userID.(uint),
+		ImageData:    fileContent,
+		ImageType:    contentType,
+	}
+	if err := config.DB.Create(&photo).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.FileUploadError{Error: "Database error", Message: "Failed to save photo"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, buildVenuePhotoResponse(photo))
+}
+
+// GetVenuePhoto godoc
+// @Summary      Get venue photo
+// @Description  Retrieve a photo of a venue
+// @Tags         Venues
+// @Produce      octet-stream
+// @Param        id path int true "Venue ID"
+// @Param        photoId path int true "Photo ID"
+// @Success      200 {file} string "Venue photo"
+// @Failure      400 {object} types.ErrorResponse "Invalid ID"
+// @Failure      404 {object} types.ErrorResponse "Photo not found"
+// @Router       /venues/{id}/photos/{photoId} [get]
+func (vc *VenueController) GetVenuePhoto(c *gin.Context) {
+	venueID, err1 := strconv.ParseUint(c.Param("id"), 10, 32)
+	photoID, err2 := strconv.ParseUint(c.Param("photoId"), 10, 32)
+	if err1 != nil || err2 != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid ID",
+			Message: "Venue and photo IDs must be valid numbers",
+		})
+		return
+	}
+
+	var photo models.VenuePhoto
+	if err := config.DB.Where("id = ? AND venue_id = ?", photoID, venueID).First(&photo).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Photo not found",
+			Message: "The requested photo does not exist",
+		})
+		return
+	}
+
+	contentType := photo.ImageType
+	if contentType == "" {
+		contentType = "image/jpeg"
+	}
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Data(http.StatusOK, contentType, photo.ImageData)
+}
+
+// DeleteVenuePhoto godoc
+// @Summary      Delete venue photo
+// @Description  Delete a photo of a venue. Allowed for the uploader and the user who added the venue.
+// @Tags         Venues
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Venue ID"
+// @Param        photoId path int true "Photo ID"
+// @Success      204 "Photo deleted"
+// @Failure      400 {object} types.ErrorResponse "Invalid ID"
+// @Failure      401 {object} types.ErrorResponse "User not authenticated"
+// @Failure      403 {object} types.ErrorResponse "Not allowed to delete this photo"
+// @Failure      404 {object} types.ErrorResponse "Venue or photo not found"
+// @Router       /venues/{id}/photos/{photoId} [delete]
+func (vc *VenueController) DeleteVenuePhoto(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, types.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	venue, ok := loadVenue(c)
+	if !ok {
+		return
+	}
+
+	photoID, err := strconv.ParseUint(c.Param("photoId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid photo ID",
+			Message: "Photo ID must be a valid number",
+		})
+		return
+	}
+
+	var photo models.VenuePhoto
+	if err := config.DB.Select("id, venue_id, uploaded_by_id").
+		Where("id = ? AND venue_id = ?", photoID, venue.ID).First(&photo).Error; err != nil {
+		c.JSON(http.StatusNotFound, types.ErrorResponse{
+			Error:   "Photo not found",
+			Message: "The requested photo does not exist",
+		})
+		return
+	}
+
+	isVenueCreator := venue.CreatedByID != nil && *venue.CreatedByID == userID.(uint)
+	if photo.UploadedByID != userID.(uint) && !isVenueCreator {
+		c.JSON(http.StatusForbidden, types.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only delete photos you uploaded or of venues you added",
+		})
+		return
+	}
+
+	if err := config.DB.Delete(&photo).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to delete photo",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// loadVenue loads the venue from the :id path parameter with its sports, responding on failure
+func loadVenue(c *gin.Context) (models.Venue, bool) {
+	var venue models.Venue
+
+	venueID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, types.ErrorResponse{
+			Error:   "Invalid venue ID",
+			Message: "Venue ID must be a valid number",
+		})
+		return venue, false
+	}
+
+	if err := config.DB.Preload("Sports").First(&venue, venueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, types.ErrorResponse{
+				Error:   "Venue not found",
+				Message: "The requested venue does not exist",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+				Error:   "Database error",
+				Message: "Failed to fetch venue",
+			})
+		}
+		return venue, false
+	}
+
+	return venue, true
+}
+
+// respondIfSimilarVenueExists responds with 409 and returns false when another venue with a
+// similar name lies within services.VenueMatchRadiusKm of the point
+func respondIfSimilarVenueExists(c *gin.Context, name string, lat, lng float64, excludeID uint) bool {
+	existing, err := services.FindSimilarVenue(config.DB, name, lat, lng, services.VenueMatchRadiusKm, excludeID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, types.ErrorResponse{
+			Error:   "Database error",
+			Message: "Failed to check for existing venues",
+		})
+		return false
+	}
+	if existing != nil {
+		c.JSON(http.StatusConflict, types.ErrorResponse{
+			Error:   "Venue already exists",
+			Message: fmt.Sprintf("%q (venue %d) is already registered at this location", existing.Name, existing.ID),
+		})
+		return false
+	}
+	return true
+}
+
+func respondUnknownTimezone(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, types.ErrorResponse{
+		Error:   "Invalid request",
+		Message: "Unknown time zone",
+	})
+}
+
+func respondInvalidVenueSurface(c *gin.Context) {
+	c.JSON(http.StatusBadRequest, types.ErrorResponse{
+		Error:   "Invalid request",
+		Message: "Surface must be one of grass, artificial, hard, clay, wood, sand, water, ice or other",
+	})
+}
+
+// buildVenueResponse converts a venue with its sports preloaded into its API representation.
+// When origin is given the distance to it is included.
+func buildVenueResponse(venue models.Venue, origin *[2]float64) types.VenueResponse {
+	var photos []models.VenuePhoto
+	config.DB.Select("id, venue_id, uploaded_by_id, created_at").
+		Where("venue_id = ?", venue.ID).Order("created_at ASC").Find(&photos)
+
+	var hours []models.VenueOpeningHours
+	config.DB.Where("venue_id = ?", venue.ID).Order("weekday ASC, opens_at ASC").Find(&hours)
+
+	var resources []models.VenueResource
+	config.DB.Where("venue_id = ?", venue.ID).Order("name ASC, id ASC").Find(&resources)
+
+	var upcomingEvents int64
+	config.DB.Model(&models.Event{}).
+		Where("venue_id = ? AND status = ? AND visibility = ?", venue.ID, types.EventStatusUpcoming, types.EventVisibilityPublic).
+		Count(&upcomingEvents)
+
+	response := types.VenueResponse{
+		ID:             venue.ID,
+		CreatedByID:    venue.CreatedByID,
+		Name:           venue.Name,
+		Address:        venue.Address,
+		Latitude:       *venue.Latitude,
+		Longitude:      *venue.Longitude,
+		Sports:         make([]string, 0, len(venue.Sports)),
+		Surface:        types.VenueSurface(venue.Surface),
+		Indoor:         venue.Indoor,
+		Lit:            venue.Lit,
+		Timezone:       venue.Timezone,
+		OpeningHours:   make([]types.OpeningHoursEntry, 0, len(hours)),
+		Resources:      make([]types.VenueResourceResponse, 0, len(resources)),
+		Photos:         make([]types.VenuePhotoResponse, 0, len(photos)),
+		UpcomingEvents: int(upcomingEvents),
+		CreatedAt:      venue.CreatedAt,
+		UpdatedAt:      venue.UpdatedAt,
+	}
+	for _, sport := range venue.Sports {
+		response.Sports = append(response.Sports, sport.Name)
+	}
+	for _, h := range hours {
+		response.OpeningHours = append(response.OpeningHours, types.OpeningHoursEntry{
+			Weekday: h.Weekday,
+			Opens:   formatDayMinute(h.OpensAt),
+			Closes:  formatDayMinute(h.ClosesAt),
+		})
+	}
+	for _, resource := range resources {
+		response.Resources = append(response.Resources, buildVenueResourceResponse(resource))
+	}
+	for _, photo := range photos {
+		response.Photos = append(response.Photos, buildVenuePhotoResponse(photo))
+	}
+	if origin != nil {
+		distance := math.Round(utils.HaversineKm(origin[0], origin[1], *venue.Latitude, *venue.Longitude)*100) / 100
+		response.DistanceKm = &distance
+	}
+
+	return response
+}
+
+func buildVenuePhotoResponse(photo models.VenuePhoto) types.VenuePhotoResponse {
+	return types.VenuePhotoResponse{
+		ID:           photo.ID,
+		URL:          fmt.Sprintf("/api/venues/%d/photos/%d", photo.VenueID, photo.ID),
+		UploadedByID: photo.UploadedByID,
+		CreatedAt:    photo.CreatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+)
+
+// CalendarFeedToken authorizes a user's subscribable calendar feed.
+// Only the SHA-256 hash of the secret is stored; deleting the row revokes the feed.
+type CalendarFeedToken struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	UserID     uint       `json:"user_id" gorm:"not null;uniqueIndex"`
+	User       User       `json:"-" gorm:"foreignKey:UserID"`
+	TokenHash  string     `json:"-" gorm:"not null;size:64;uniqueIndex"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// Conversation is a 1:1 message thread. UserAID always holds the lower user ID so each pair
+// of users has a single conversation.
+type Conversation struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	UserAID       uint       `json:"user_a_id" gorm:"not null;uniqueIndex:idx_conversation_pair"`
+	UserBID       uint       `json:"user_b_id" gorm:"not null;uniqueIndex:idx_conversation_pair;index"`
+	LastMessageAt *time.Time `json:"last_message_at" gorm:"type:timestamptz;index"`
+	UserA         User       `json:"user_a" gorm:"foreignKey:UserAID"`
+	UserB         User       `json:"user_b" gorm:"foreignKey:UserBID"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// DirectMessage is a message in a conversation. ReadAt is set once the recipient has read it.
+type DirectMessage struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	ConversationID uint       `json:"conversation_id" gorm:"not null;index"`
+	SenderID       uint       `json:"sender_id" gorm:"not null"`
+	Body           string     `json:"body" gorm:"type:text;not null"`
+	ReadAt         *time.Time `json:"read_at" gorm:"type:timestamptz"`
+	Sender         User       `json:"sender" gorm:"foreignKey:SenderID"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
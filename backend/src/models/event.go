@@ -7,21 +7,33 @@ import (
 )
 
 type Event struct {
-	ID           uint           `json:"id" gorm:"primaryKey"`
-	OrganizerID  uint           `json:"organizer_id" gorm:"not null"`
-	Organizer    User           `json:"organizer" gorm:"foreignKey:OrganizerID"`
-	Type         string         `json:"type" gorm:"not null;size:20;default:'event';check:type IN ('game','event','training')"` // ADD THIS LINE
-	Title        string         `json:"title" gorm:"not null;size:255"`
-	Description  string         `json:"description" gorm:"type:text"`
-	Sport        string         `json:"sport" gorm:"size:100"`
-	StartAt      time.Time      `json:"start_at" gorm:"type:timestamptz;not null"`
-	EndAt        *time.Time     `json:"end_at" gorm:"type:timestamptz"`
-	Capacity     *int           `json:"capacity"`
-	LocationName string         `json:"location_name" gorm:"size:255"`
-	Latitude     *float64       `json:"latitude" gorm:"not null"`
-	Longitude    *float64       `json:"longitude" gorm:"not null"`
-	Status       string         `json:"status" gorm:"not null;size:20;default:'upcoming';check:status IN ('upcoming','active','complete','cancelled')"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+	ID                   uint           `json:"id" gorm:"primaryKey"`
+	OrganizerID          uint           `json:"organizer_id" gorm:"not null"`
+	Organizer            User           `json:"organizer" gorm:"foreignKey:OrganizerID"`
+	Type                 string         `json:"type" gorm:"not null;size:20;default:'event';check:type IN ('game','event','training')"` // ADD THIS LINE
+	Title                string         `json:"title" gorm:"not null;size:255"`
+	Description          string         `json:"description" gorm:"type:text"`
+	Sport                string         `json:"sport" gorm:"size:100"`
+	StartAt              time.Time      `json:"start_at" gorm:"type:timestamptz;not null"`
+	EndAt                *time.Time     `json:"end_at" gorm:"type:timestamptz"`
+	Capacity             *int           `json:"capacity"`
+	LocationName         string         `json:"location_name" gorm:"size:255"`
+	Latitude             *float64       `json:"latitude" gorm:"not null"`
+	Longitude            *float64       `json:"longitude" gorm:"not null"`
+	VenueID              *uint          `json:"venue_id" gorm:"index"`
+	ResourceID           *uint          `json:"resource_id" gorm:"index"`
+	Status               string         `json:"status" gorm:"not null;size:20;default:'upcoming';check:status IN ('upcoming','active','complete','cancelled')"`
+	Visibility           string         `json:"visibility" gorm:"not null;size:20;default:'public';index;check:visibility IN ('public','followers','invite')"`
+	MinReliability       *int           `json:"min_reliability"`
+	JoinMode             string         `json:"join_mode" gorm:"not null;size:20;default:'open';check:join_mode IN ('open','approval')"`
+	CheckInCode          string         `json:"-" gorm:"size:12"`
+	CheckInCodeExpiresAt *time.Time     `json:"-" gorm:"type:timestamptz"`
+	CheckInOpenedAt      *time.Time     `json:"check_in_opened_at" gorm:"type:timestamptz"`
+	SeriesID             *uint          `json:"series_id" gorm:"uniqueIndex:idx_series_occurrence"`
+	OccurrenceIndex      *int           `json:"occurrence_index" gorm:"uniqueIndex:idx_series_occurrence"`
+	ParentEventID        *uint          `json:"parent_event_id" gorm:"index"`
+	GroupID              *uint          `json:"group_id" gorm:"index"`
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+	DeletedAt            gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
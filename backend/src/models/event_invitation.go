@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+type EventInvitation struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	EventID     uint       `json:"event_id" gorm:"not null;uniqueIndex:idx_invitation_event_invitee"`
+	InviteeID   uint       `json:"invitee_id" gorm:"not null;uniqueIndex:idx_invitation_event_invitee;index"`
+	InviterID   uint       `json:"inviter_id" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','accepted','declined')"`
+	Event       Event      `json:"-" gorm:"foreignKey:EventID"`
+	Invitee     User       `json:"invitee" gorm:"foreignKey:InviteeID"`
+	Inviter     User       `json:"inviter" gorm:"foreignKey:InviterID"`
+	RespondedAt *time.Time `json:"responded_at" gorm:"type:timestamptz"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
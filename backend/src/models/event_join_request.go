@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// EventJoinRequest is a user's request to join an approval-required event
+type EventJoinRequest struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	EventID       uint       `json:"event_id" gorm:"not null;uniqueIndex:idx_event_join_request"`
+	UserID        uint       `json:"user_id" gorm:"not null;uniqueIndex:idx_event_join_request;index"`
+	Status        string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','accepted','rejected')"`
+	RespondedByID *uint      `json:"responded_by_id"`
+	RespondedAt   *time.Time `json:"responded_at" gorm:"type:timestamptz"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Event         Event      `json:"event" gorm:"foreignKey:EventID"`
+	User          User       `json:"user" gorm:"foreignKey:UserID"`
+}
+
+// EventBan keeps a removed user from joining an event again
+type EventBan struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	EventID    uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_event_ban"`
+	UserID     uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_event_ban"`
+	BannedByID uint      `json:"banned_by_id" gorm:"not null"`
+	Reason     string    `json:"reason" gorm:"size:500"`
+	CreatedAt  time.Time `json:"created_at"`
+	User       User      `json:"user" gorm:"foreignKey:UserID"`
+}
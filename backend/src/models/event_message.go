@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// EventMessage is a message in an event's chat, readable by its organizer and participants
+type EventMessage struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	EventID   uint      `json:"event_id" gorm:"not null;index"`
+	SenderID  uint      `json:"sender_id" gorm:"not null"`
+	Body      string    `json:"body" gorm:"type:text;not null"`
+	Sender    User      `json:"sender" gorm:"foreignKey:SenderID"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -5,11 +5,18 @@ import (
 )
 
 type EventParticipant struct {
-	ID       uint      `json:"id" gorm:"primaryKey"`
-	EventID  uint      `json:"event_id" gorm:"not null"`
-	UserID   uint      `json:"user_id" gorm:"not null"`
-	Role     string    `json:"role" gorm:"default:participant;size:50"`
-	JoinedAt time.Time `json:"joined_at"`
-	Event    Event     `json:"event" gorm:"foreignKey:EventID"`
-	User     User      `json:"user" gorm:"foreignKey:UserID"`
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	EventID     uint       `json:"event_id" gorm:"not null"`
+	UserID      uint       `json:"user_id" gorm:"not null"`
+	Role        string     `json:"role" gorm:"default:participant;size:50"`
+	SideID      *uint      `json:"side_id" gorm:"index"`
+	TeamID      *uint      `json:"team_id" gorm:"index"`
+	JoinedAt    time.Time  `json:"joined_at"`
+	CheckedInAt *time.Time `json:"checked_in_at" gorm:"type:timestamptz"`
+	NoShow      bool       `json:"no_show" gorm:"not null;default:false"`
+	// Wrong check-in codes entered in a row, and until when further attempts are refused
+	CheckInFailedAttempts int        `json:"-" gorm:"not null;default:0"`
+	CheckInLockedUntil    *time.Time `json:"-" gorm:"type:timestamptz"`
+	Event                 Event      `json:"event" gorm:"foreignKey:EventID"`
+	User                  User       `json:"user" gorm:"foreignKey:UserID"`
 }
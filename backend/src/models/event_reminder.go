@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// EventReminder records a reminder sent to a user for an event. The unique index makes
+// sending idempotent across restarts and backend instances.
+type EventReminder struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	EventID       uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_event_reminder"`
+	UserID        uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_event_reminder"`
+	OffsetMinutes int       `json:"offset_minutes" gorm:"not null;uniqueIndex:idx_event_reminder"`
+	SentAt        time.Time `json:"sent_at" gorm:"type:timestamptz;not null"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// EventSeries is the template and recurrence rule for a repeating activity.
+// Occurrences are materialized ahead of time as regular events linked back
+// through Event.SeriesID / Event.OccurrenceIndex.
+type EventSeries struct {
+	ID                uint           `json:"id" gorm:"primaryKey"`
+	OrganizerID       uint           `json:"organizer_id" gorm:"not null;index"`
+	Organizer         User           `json:"organizer" gorm:"foreignKey:OrganizerID"`
+	Type              string         `json:"type" gorm:"not null;size:20;default:'event';check:type IN ('game','event','training')"`
+	Title             string         `json:"title" gorm:"not null;size:255"`
+	Description       string         `json:"description" gorm:"type:text"`
+	Sport             string         `json:"sport" gorm:"size:100"`
+	LocationName      string         `json:"location_name" gorm:"size:255"`
+	Latitude          *float64       `json:"latitude" gorm:"not null"`
+	Longitude         *float64       `json:"longitude" gorm:"not null"`
+	VenueID           *uint          `json:"venue_id"`
+	Capacity          *int           `json:"capacity"`
+	Visibility        string         `json:"visibility" gorm:"not null;size:20;default:'public';check:visibility IN ('public','followers','invite')"`
+	MinReliability    *int           `json:"min_reliability"`
+	JoinMode          string         `json:"join_mode" gorm:"not null;size:20;default:'open';check:join_mode IN ('open','approval')"`
+	Frequency         string         `json:"frequency" gorm:"not null;size:20;check:frequency IN ('weekly','biweekly','monthly')"`
+	Timezone          string         `json:"timezone" gorm:"not null;size:64;default:'UTC'"`
+	StartAt           time.Time      `json:"start_at" gorm:"type:timestamptz;not null"`
+	DurationMinutes   *int           `json:"duration_minutes"`
+	Until             *time.Time     `json:"until" gorm:"type:timestamptz"`
+	Count             *int           `json:"count"`
+	MaterializedCount int            `json:"materialized_count" gorm:"not null;default:0"`
+	Status            string         `json:"status" gorm:"not null;size:20;default:'active';check:status IN ('active','cancelled')"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// EventWaitlistEntry holds a user's place in the queue for a full event.
+// Positions are kept contiguous (1..n) per event so they can be shown as-is.
+type EventWaitlistEntry struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	EventID   uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_waitlist_event_user;index:idx_waitlist_event_position"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_waitlist_event_user"`
+	Position  int       `json:"position" gorm:"not null;index:idx_waitlist_event_position"`
+	Event     Event     `json:"-" gorm:"foreignKey:EventID"`
+	User      User      `json:"user" gorm:"foreignKey:UserID"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -12,3 +12,13 @@ type Follow struct {
 	Followed   User      `json:"followed" gorm:"foreignKey:FollowedID"`
 	CreatedAt  time.Time `json:"created_at"`
 }
+
+// FollowRequest is a pending request to follow a private account. It is removed once the
+// account approves or denies it.
+type FollowRequest struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	RequesterID uint      `json:"requester_id" gorm:"not null;uniqueIndex:idx_follow_request"`
+	TargetID    uint      `json:"target_id" gorm:"not null;uniqueIndex:idx_follow_request;index"`
+	Requester   User      `json:"requester" gorm:"foreignKey:RequesterID"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// EventSide is one side/team of a game event; participants point to it through SideID
+type EventSide struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	EventID   uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_event_side_position"`
+	Name      string    `json:"name" gorm:"not null;size:100"`
+	Position  int       `json:"position" gorm:"not null;uniqueIndex:idx_event_side_position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GameResult struct {
+	ID            uint                     `json:"id" gorm:"primaryKey"`
+	EventID       uint                     `json:"event_id" gorm:"not null;uniqueIndex"`
+	Event         Event                    `json:"-" gorm:"foreignKey:EventID"`
+	RecordedByID  uint                     `json:"recorded_by_id" gorm:"not null"`
+	Format        string                   `json:"format" gorm:"not null;size:20;check:format IN ('sets','goals','points')"`
+	Status        string                   `json:"status" gorm:"not null;size:20;default:'pending';index;check:status IN ('pending','confirmed','disputed')"`
+	WinnerSideID  *uint                    `json:"winner_side_id"`
+	Scores        []GameResultScore        `json:"scores" gorm:"foreignKey:ResultID;constraint:OnDelete:CASCADE"`
+	Confirmations []GameResultConfirmation `json:"confirmations" gorm:"foreignKey:ResultID;constraint:OnDelete:CASCADE"`
+	ConfirmedAt   *time.Time               `json:"confirmed_at" gorm:"type:timestamptz"`
+	CreatedAt     time.Time                `json:"created_at"`
+	UpdatedAt     time.Time                `json:"updated_at"`
+}
+
+type GameResultScore struct {
+	ID       uint `json:"id" gorm:"primaryKey"`
+	ResultID uint `json:"result_id" gorm:"not null;uniqueIndex:idx_result_side"`
+	SideID   uint `json:"side_id" gorm:"not null;uniqueIndex:idx_result_side"`
+	Score    int  `json:"score" gorm:"not null"`
+	// Sets holds the games won per set as a comma-separated list ("6,3,7") for the sets format
+	Sets string `json:"sets" gorm:"size:100"`
+}
+
+type GameResultConfirmation struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ResultID  uint      `json:"result_id" gorm:"not null;uniqueIndex:idx_result_user"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_result_user"`
+	Response  string    `json:"response" gorm:"not null;size:20;check:response IN ('confirmed','disputed')"`
+	Comment   string    `json:"comment" gorm:"size:500"`
+	CreatedAt time.Time `json:"created_at"`
+}
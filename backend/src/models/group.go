@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Group is a club with its own members, post feed and events. Posts and events of a private
+// group are only visible to its members.
+type Group struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	Name        string         `json:"name" gorm:"not null;size:100"`
+	Description string         `json:"description" gorm:"type:text"`
+	Sport       string         `json:"sport" gorm:"size:100;index"`
+	City        string         `json:"city" gorm:"size:100"`
+	Visibility  string         `json:"visibility" gorm:"not null;size:20;default:'public';check:visibility IN ('public','private')"`
+	CreatedByID uint           `json:"created_by_id" gorm:"not null"`
+	Members     []GroupMember  `json:"members" gorm:"foreignKey:GroupID"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// GroupMember is a member of a group with their role in it
+type GroupMember struct {
+	ID       uint      `json:"id" gorm:"primaryKey"`
+	GroupID  uint      `json:"group_id" gorm:"not null;uniqueIndex:idx_group_member"`
+	UserID   uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_group_member;index"`
+	Role     string    `json:"role" gorm:"not null;size:20;default:'member';check:role IN ('admin','moderator','member')"`
+	JoinedAt time.Time `json:"joined_at"`
+	User     User      `json:"user" gorm:"foreignKey:UserID"`
+}
+
+// GroupJoinRequest is a user's request to join a private group, answered by its admins and
+// moderators
+type GroupJoinRequest struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	GroupID       uint       `json:"group_id" gorm:"not null;uniqueIndex:idx_group_join_request"`
+	UserID        uint       `json:"user_id" gorm:"not null;uniqueIndex:idx_group_join_request;index"`
+	Status        string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','accepted','rejected')"`
+	RespondedByID *uint      `json:"responded_by_id"`
+	RespondedAt   *time.Time `json:"responded_at" gorm:"type:timestamptz"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	User          User       `json:"user" gorm:"foreignKey:UserID"`
+}
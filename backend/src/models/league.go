@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// League is a competition that runs over seasons between its teams. Fixtures are played as
+// regular game events at the league's home ground.
+type League struct {
+	ID           uint           `json:"id" gorm:"primaryKey"`
+	OrganizerID  uint           `json:"organizer_id" gorm:"not null;index"`
+	Organizer    User           `json:"organizer" gorm:"foreignKey:OrganizerID"`
+	Name         string         `json:"name" gorm:"not null;size:255"`
+	Description  string         `json:"description" gorm:"type:text"`
+	Sport        string         `json:"sport" gorm:"not null;size:100;index"`
+	LocationName string         `json:"location_name" gorm:"size:255"`
+	Latitude     *float64       `json:"latitude" gorm:"not null"`
+	Longitude    *float64       `json:"longitude" gorm:"not null"`
+	VenueID      *uint          `json:"venue_id"`
+	Timezone     string         `json:"timezone" gorm:"not null;size:64;default:'UTC'"`
+	Teams        []LeagueTeam   `json:"teams" gorm:"foreignKey:LeagueID"`
+	Seasons      []LeagueSeason `json:"seasons" gorm:"foreignKey:LeagueID"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// LeagueTeam is a team of a league; it can play in several seasons
+type LeagueTeam struct {
+	ID        uint               `json:"id" gorm:"primaryKey"`
+	LeagueID  uint               `json:"league_id" gorm:"not null;uniqueIndex:idx_league_team_name"`
+	Name      string             `json:"name" gorm:"not null;size:100;uniqueIndex:idx_league_team_name"`
+	CaptainID uint               `json:"captain_id" gorm:"not null"`
+	Members   []LeagueTeamMember `json:"members" gorm:"foreignKey:TeamID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// LeagueTeamMember is a player of a league team
+type LeagueTeamMember struct {
+	ID       uint      `json:"id" gorm:"primaryKey"`
+	TeamID   uint      `json:"team_id" gorm:"not null;uniqueIndex:idx_league_team_member"`
+	UserID   uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_league_team_member;index"`
+	User     User      `json:"user" gorm:"foreignKey:UserID"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// LeagueSeason is one season of a league: the teams taking part, how often they meet and
+// how many points a result is worth
+type LeagueSeason struct {
+	ID                   uint            `json:"id" gorm:"primaryKey"`
+	LeagueID             uint            `json:"league_id" gorm:"not null;index"`
+	Name                 string          `json:"name" gorm:"not null;size:100"`
+	StartAt              time.Time       `json:"start_at" gorm:"type:timestamptz;not null"`
+	Legs                 int             `json:"legs" gorm:"not null;default:2"`
+	MatchIntervalDays    int             `json:"match_interval_days" gorm:"not null;default:7"`
+	MatchDurationMinutes int             `json:"match_duration_minutes" gorm:"not null;default:90"`
+	PointsWin            int             `json:"points_win" gorm:"not null;default:3"`
+	PointsDraw           int             `json:"points_draw" gorm:"not null;default:1"`
+	Status               string          `json:"status" gorm:"not null;size:20;default:'draft';check:status IN ('draft','active','complete')"`
+	Teams                []LeagueTeam    `json:"teams" gorm:"many2many:league_season_teams"`
+	Fixtures             []LeagueFixture `json:"fixtures" gorm:"foreignKey:SeasonID"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+}
+
+// LeagueFixture is a match between two teams of a season, played in a game event whose
+// first side is the home team and second side the away team
+type LeagueFixture struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	SeasonID    uint       `json:"season_id" gorm:"not null;index"`
+	Matchday    int        `json:"matchday" gorm:"not null"`
+	HomeTeamID  uint       `json:"home_team_id" gorm:"not null"`
+	AwayTeamID  uint       `json:"away_team_id" gorm:"not null"`
+	EventID     uint       `json:"event_id" gorm:"not null;uniqueIndex"`
+	Event       Event      `json:"-" gorm:"foreignKey:EventID"`
+	Status      string     `json:"status" gorm:"not null;size:20;default:'scheduled';check:status IN ('scheduled','complete')"`
+	HomeScore   *int       `json:"home_score"`
+	AwayScore   *int       `json:"away_score"`
+	CompletedAt *time.Time `json:"completed_at" gorm:"type:timestamptz"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
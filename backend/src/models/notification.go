@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"time"
 
 	"backend/src/types"
@@ -21,3 +22,28 @@ type Notification struct {
 	UpdatedAt time.Time              `json:"updated_at"`
 	DeletedAt gorm.DeletedAt         `json:"deleted_at" gorm:"index"`
 }
+
+// ErrNotificationSuppressed is returned when a notification is dropped because its recipient
+// and actor have blocked each other or the recipient muted the actor
+var ErrNotificationSuppressed = errors.New("notification suppressed by a block or mute")
+
+// BeforeCreate drops notifications between blocked users and from muted users, so every code
+// path that notifies someone respects these relations
+func (n *Notification) BeforeCreate(tx *gorm.DB) error {
+	if n.ActorID == nil || *n.ActorID == n.UserID {
+		return nil
+	}
+	db := tx.Session(&gorm.Session{NewDB: true})
+
+	var count int64
+	db.Model(&UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", n.UserID, *n.ActorID, *n.ActorID, n.UserID).
+		Count(&count)
+	if count == 0 {
+		db.Model(&UserMute{}).Where("muter_id = ? AND muted_id = ?", n.UserID, *n.ActorID).Count(&count)
+	}
+	if count > 0 {
+		return ErrNotificationSuppressed
+	}
+	return nil
+}
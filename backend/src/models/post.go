@@ -10,6 +10,7 @@ import (
 type Post struct {
 	ID        uint             `json:"id" gorm:"primaryKey"`
 	UserID    uint             `json:"user_id" gorm:"not null"`
+	GroupID   *uint            `json:"group_id" gorm:"index"`
 	Title     string           `json:"title" gorm:"not null;size:255"`
 	Body      string           `json:"body" gorm:"not null;size:255"`
 	Status    types.PostStatus `json:"status" gorm:"default:published;size:50"`
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+)
+
+// SportRating is a user's Elo rating in one sport, keyed by the sport name used on events
+type SportRating struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	UserID      uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_sport_rating"`
+	Sport       string    `json:"sport" gorm:"not null;size:100;uniqueIndex:idx_user_sport_rating;index"`
+	Rating      float64   `json:"rating" gorm:"not null;default:1200"`
+	GamesPlayed int       `json:"games_played" gorm:"not null;default:0"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SportRatingHistory records every rating change and the game that caused it
+type SportRatingHistory struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	UserID       uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_rating_history_result_user;index:idx_rating_history_user_sport"`
+	Sport        string    `json:"sport" gorm:"not null;size:100;index:idx_rating_history_user_sport"`
+	EventID      uint      `json:"event_id" gorm:"not null"`
+	ResultID     uint      `json:"result_id" gorm:"not null;uniqueIndex:idx_rating_history_result_user"`
+	RatingBefore float64   `json:"rating_before" gorm:"not null"`
+	RatingAfter  float64   `json:"rating_after" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+// SuggestionDismissal keeps a user out of another user's "people you may know" suggestions
+type SuggestionDismissal struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	UserID      uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_suggestion_dismissal"`
+	DismissedID uint      `json:"dismissed_id" gorm:"not null;uniqueIndex:idx_suggestion_dismissal"`
+	CreatedAt   time.Time `json:"created_at"`
+}
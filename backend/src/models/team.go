@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Team is a persistent squad of players. Captains manage the roster and can sign the whole
+// team up for events at once.
+type Team struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	Name        string         `json:"name" gorm:"not null;size:100"`
+	Sport       string         `json:"sport" gorm:"size:100;index"`
+	Description string         `json:"description" gorm:"type:text"`
+	AvatarData  []byte         `json:"-" gorm:"type:bytea"`
+	AvatarType  string         `json:"avatar_type" gorm:"size:50"`
+	CreatedByID uint           `json:"created_by_id" gorm:"not null"`
+	Members     []TeamMember   `json:"members" gorm:"foreignKey:TeamID"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// TeamMember is a player on a team's roster
+type TeamMember struct {
+	ID       uint      `json:"id" gorm:"primaryKey"`
+	TeamID   uint      `json:"team_id" gorm:"not null;uniqueIndex:idx_team_member"`
+	UserID   uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_team_member;index"`
+	Role     string    `json:"role" gorm:"not null;size:20;default:'member';check:role IN ('captain','member')"`
+	JoinedAt time.Time `json:"joined_at"`
+	User     User      `json:"user" gorm:"foreignKey:UserID"`
+}
+
+// TeamInvitation is a captain's invitation for a user to join the team
+type TeamInvitation struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	TeamID      uint       `json:"team_id" gorm:"not null;uniqueIndex:idx_team_invitation"`
+	InviteeID   uint       `json:"invitee_id" gorm:"not null;uniqueIndex:idx_team_invitation;index"`
+	InviterID   uint       `json:"inviter_id" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','accepted','declined')"`
+	Team        Team       `json:"-" gorm:"foreignKey:TeamID"`
+	Invitee     User       `json:"invitee" gorm:"foreignKey:InviteeID"`
+	RespondedAt *time.Time `json:"responded_at" gorm:"type:timestamptz"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TeamJoinRequest is a user's request to join a team, answered by its captains
+type TeamJoinRequest struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	TeamID        uint       `json:"team_id" gorm:"not null;uniqueIndex:idx_team_join_request"`
+	UserID        uint       `json:"user_id" gorm:"not null;uniqueIndex:idx_team_join_request;index"`
+	Status        string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','accepted','rejected')"`
+	RespondedByID *uint      `json:"responded_by_id"`
+	RespondedAt   *time.Time `json:"responded_at" gorm:"type:timestamptz"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	User          User       `json:"user" gorm:"foreignKey:UserID"`
+}
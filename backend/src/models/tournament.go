@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+)
+
+// Tournament turns an event into a bracket or round robin. The event holds the title, sport,
+// place and start time; every match is scheduled as a child game event (Event.ParentEventID).
+type Tournament struct {
+	ID                   uint              `json:"id" gorm:"primaryKey"`
+	EventID              uint              `json:"event_id" gorm:"not null;uniqueIndex"`
+	Event                Event             `json:"-" gorm:"foreignKey:EventID"`
+	Format               string            `json:"format" gorm:"not null;size:30;check:format IN ('single_elimination','double_elimination','round_robin')"`
+	EntryType            string            `json:"entry_type" gorm:"not null;size:20;default:'player';check:entry_type IN ('player','team')"`
+	TeamSize             int               `json:"team_size" gorm:"not null;default:1"`
+	MaxEntries           *int              `json:"max_entries"`
+	SeedBy               string            `json:"seed_by" gorm:"not null;size:20;default:'registration';check:seed_by IN ('registration','rating','manual')"`
+	MatchDurationMinutes int               `json:"match_duration_minutes" gorm:"not null;default:60"`
+	Status               string            `json:"status" gorm:"not null;size:20;default:'registration';check:status IN ('registration','in_progress','complete')"`
+	WinnerEntryID        *uint             `json:"winner_entry_id"`
+	Entries              []TournamentEntry `json:"entries" gorm:"foreignKey:TournamentID"`
+	Matches              []TournamentMatch `json:"matches" gorm:"foreignKey:TournamentID"`
+	CreatedAt            time.Time         `json:"created_at"`
+	UpdatedAt            time.Time         `json:"updated_at"`
+}
+
+// TournamentEntry is a registered player or team
+type TournamentEntry struct {
+	ID             uint                    `json:"id" gorm:"primaryKey"`
+	TournamentID   uint                    `json:"tournament_id" gorm:"not null;index"`
+	Name           string                  `json:"name" gorm:"not null;size:100"`
+	RegisteredByID uint                    `json:"registered_by_id" gorm:"not null"`
+	Seed           *int                    `json:"seed"`
+	Members        []TournamentEntryMember `json:"members" gorm:"foreignKey:EntryID;constraint:OnDelete:CASCADE"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+// TournamentEntryMember is a player of an entry; a player can only be in one entry per tournament
+type TournamentEntryMember struct {
+	ID           uint `json:"id" gorm:"primaryKey"`
+	TournamentID uint `json:"tournament_id" gorm:"not null;uniqueIndex:idx_tournament_member"`
+	EntryID      uint `json:"entry_id" gorm:"not null;index"`
+	UserID       uint `json:"user_id" gorm:"not null;uniqueIndex:idx_tournament_member"`
+	User         User `json:"user" gorm:"foreignKey:UserID"`
+}
+
+// TournamentMatch is one match of the bracket. The winner (and in double elimination the
+// loser) moves on to a slot of another match; a match is played once both of its slots
+// are known, and is a bye when one of them stays empty.
+type TournamentMatch struct {
+	ID               uint       `json:"id" gorm:"primaryKey"`
+	TournamentID     uint       `json:"tournament_id" gorm:"not null;uniqueIndex:idx_tournament_match_position"`
+	Bracket          string     `json:"bracket" gorm:"not null;size:20;uniqueIndex:idx_tournament_match_position;check:bracket IN ('winners','losers','final','round_robin')"`
+	Round            int        `json:"round" gorm:"not null;uniqueIndex:idx_tournament_match_position"`
+	Position         int        `json:"position" gorm:"not null;uniqueIndex:idx_tournament_match_position"`
+	ScheduledAt      time.Time  `json:"scheduled_at" gorm:"type:timestamptz;not null"`
+	Entry1ID         *uint      `json:"entry1_id"`
+	Entry2ID         *uint      `json:"entry2_id"`
+	EventID          *uint      `json:"event_id" gorm:"uniqueIndex"`
+	Status           string     `json:"status" gorm:"not null;size:20;default:'pending';check:status IN ('pending','scheduled','complete','bye')"`
+	WinnerEntryID    *uint      `json:"winner_entry_id"`
+	LoserEntryID     *uint      `json:"loser_entry_id"`
+	Score1           *int       `json:"score1"`
+	Score2           *int       `json:"score2"`
+	NextMatchID      *uint      `json:"next_match_id"`
+	NextSlot         int        `json:"next_slot"`
+	LoserNextMatchID *uint      `json:"loser_next_match_id"`
+	LoserNextSlot    int        `json:"loser_next_slot"`
+	CompletedAt      *time.Time `json:"completed_at" gorm:"type:timestamptz"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
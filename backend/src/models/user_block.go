@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// UserBlock cuts all contact between two users: no follows, mentions, comments, event joins or
+// messages, and each is hidden from the other's search and feeds
+type UserBlock struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	BlockerID uint      `json:"blocker_id" gorm:"not null;uniqueIndex:idx_user_block"`
+	BlockedID uint      `json:"blocked_id" gorm:"not null;uniqueIndex:idx_user_block;index"`
+	Blocked   User      `json:"blocked" gorm:"foreignKey:BlockedID"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UserMute hides the muted user's posts, events and notifications from the muter only
+type UserMute struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	MuterID   uint      `json:"muter_id" gorm:"not null;uniqueIndex:idx_user_mute"`
+	MutedID   uint      `json:"muted_id" gorm:"not null;uniqueIndex:idx_user_mute"`
+	Muted     User      `json:"muted" gorm:"foreignKey:MutedID"`
+	CreatedAt time.Time `json:"created_at"`
+}
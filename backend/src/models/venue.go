@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Venue is a reusable place where activities take place. Events linked through
+// Event.VenueID copy its name and coordinates, so location based queries keep working.
+type Venue struct {
+	ID          uint           `json:"id" gorm:"primaryKey"`
+	CreatedByID *uint          `json:"created_by_id" gorm:"index"`
+	Name        string         `json:"name" gorm:"not null;size:255;index"`
+	Address     string         `json:"address" gorm:"size:500"`
+	Latitude    *float64       `json:"latitude" gorm:"not null"`
+	Longitude   *float64       `json:"longitude" gorm:"not null"`
+	Surface     string         `json:"surface" gorm:"size:30"`
+	Indoor      bool           `json:"indoor" gorm:"not null;default:false"`
+	Lit         bool           `json:"lit" gorm:"not null;default:false"`
+	Timezone    string         `json:"timezone" gorm:"not null;size:64;default:'UTC'"`
+	Sports      []Sport        `json:"sports" gorm:"many2many:venue_sports;"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// VenuePhoto is an image of a venue uploaded by a user
+type VenuePhoto struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	VenueID      uint      `json:"venue_id" gorm:"not null;index"`
+	UploadedByID uint      `json:"uploaded_by_id" gorm:"not null"`
+	ImageData    []byte    `json:"-" gorm:"type:bytea"`
+	ImageType    string    `json:"image_type" gorm:"size:50"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// VenueResource is a bookable part of a venue, such as a court or a field. Events booking
+// a resource reference it through Event.ResourceID.
+type VenueResource struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	VenueID   uint           `json:"venue_id" gorm:"not null;index"`
+	Name      string         `json:"name" gorm:"not null;size:100"`
+	Sport     string         `json:"sport" gorm:"size:100"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// VenueOpeningHours is one opening interval of a venue on a day of the week, in minutes after
+// midnight in the venue's time zone. A day can have several intervals.
+type VenueOpeningHours struct {
+	ID       uint `json:"id" gorm:"primaryKey"`
+	VenueID  uint `json:"venue_id" gorm:"not null;index"`
+	Weekday  int  `json:"weekday" gorm:"not null;check:weekday BETWEEN 0 AND 6"`
+	OpensAt  int  `json:"opens_at" gorm:"not null;check:opens_at BETWEEN 0 AND 1439"`
+	ClosesAt int  `json:"closes_at" gorm:"not null;check:closes_at BETWEEN 1 AND 1440"`
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupBlockRoutes configures block and mute routes
+func SetupBlockRoutes(router *gin.Engine, blockController *controllers.BlockController) {
+	blockGroup := router.Group("/api/users")
+	blockGroup.Use(middleware.JWTAuth())
+	{
+		// Users the current user blocked or muted
+		blockGroup.GET("/blocked", blockController.GetBlockedUsers)
+		blockGroup.GET("/muted", blockController.GetMutedUsers)
+
+		// Block/Unblock and Mute/Unmute actions
+		blockGroup.POST("/:id/block", blockController.BlockUser)
+		blockGroup.DELETE("/:id/block", blockController.UnblockUser)
+		blockGroup.POST("/:id/mute", blockController.MuteUser)
+		blockGroup.DELETE("/:id/mute", blockController.UnmuteUser)
+	}
+}
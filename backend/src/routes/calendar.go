@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupCalendarRoutes(router *gin.Engine, calendarController *controllers.CalendarController) {
+	calendarGroup := router.Group("/api/calendar")
+
+	{
+		// Subscribable feed, authenticated by the secret token in the URL
+		calendarGroup.GET("/feed/:token", calendarController.GetFeed)
+	}
+
+	protected := calendarGroup.Group("")
+	protected.Use(middleware.JWTAuth())
+	{
+		// Create (or rotate) and revoke the feed token
+		protected.POST("/token", calendarController.CreateFeedToken)
+		protected.DELETE("/token", calendarController.RevokeFeedToken)
+	}
+}
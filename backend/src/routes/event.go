@@ -18,9 +18,21 @@ func SetupEventRoutes(router *gin.Engine, eventController *controllers.EventCont
 		// Get all events (with filtering)
 		eventGroup.GET("/", eventController.GetEvents)
 
+		// Get upcoming events ranked for the current user
+		eventGroup.GET("/for-you", eventController.GetRecommendedEvents)
+
+		// Get events inside a map viewport, clustered by zoom level
+		eventGroup.GET("/map", eventController.GetEventMap)
+
+		// Export events as GeoJSON (optionally streamed)
+		eventGroup.GET("/geojson", eventController.GetEventsGeoJSON)
+
 		// Get user's own events
 		eventGroup.GET("/my", eventController.GetUserEvents)
 
+		// Get the current user's pending invitations
+		eventGroup.GET("/invitations", eventController.GetMyInvitations)
+
 		// Get another user's events by organizer ID
 		eventGroup.GET("/user/:id", eventController.GetUserEventsByID)
 
@@ -40,6 +52,44 @@ func SetupEventRoutes(router *gin.Engine, eventController *controllers.EventCont
 		// Get event participants
 		eventGroup.GET("/:id/participants", eventController.GetEventParticipants)
 
+		// Event chat (organizer and participants)
+		eventGroup.GET("/:id/messages", eventController.GetEventMessages)
+		eventGroup.POST("/:id/messages", eventController.SendEventMessage)
+
+		// Roster management (roles, removal, ownership transfer)
+		eventGroup.PUT("/:id/participants/:userId/role", eventController.SetParticipantRole)
+		eventGroup.DELETE("/:id/participants/:userId", eventController.RemoveEventParticipant)
+		eventGroup.POST("/:id/transfer", eventController.TransferEventOwnership)
+
+		// Join requests of approval-required events and bans
+		eventGroup.POST("/:id/join-requests/:userId/accept", eventController.AcceptJoinRequest)
+		eventGroup.POST("/:id/join-requests/:userId/reject", eventController.RejectJoinRequest)
+		eventGroup.GET("/:id/bans", eventController.GetEventBans)
+		eventGroup.DELETE("/:id/bans/:userId", eventController.UnbanEventUser)
+
+		// Game sides and results
+		eventGroup.PUT("/:id/sides", eventController.SetEventSides)
+		eventGroup.POST("/:id/result", eventController.RecordResult)
+		eventGroup.POST("/:id/result/confirm", eventController.ConfirmResult)
+		eventGroup.POST("/:id/result/dispute", eventController.DisputeResult)
+
+		// Attendance check-in
+		eventGroup.POST("/:id/check-in/code", eventController.OpenCheckIn)
+		eventGroup.POST("/:id/check-in", eventController.CheckIn)
+		eventGroup.PUT("/:id/participants/:userId/check-in", eventController.SetParticipantCheckIn)
+
+		// Export event as iCalendar file
+		eventGroup.GET("/:id/ics", eventController.GetEventICS)
+
+		// Get event waitlist (queue order)
+		eventGroup.GET("/:id/waitlist", eventController.GetEventWaitlist)
+
+		// Invitations (organizer invites, invitee accepts/declines)
+		eventGroup.POST("/:id/invitations", eventController.InviteToEvent)
+		eventGroup.GET("/:id/invitations", eventController.GetEventInvitations)
+		eventGroup.POST("/:id/invitations/accept", eventController.AcceptInvitation)
+		eventGroup.POST("/:id/invitations/decline", eventController.DeclineInvitation)
+
 		// Status update routes
 		eventGroup.POST("/update-statuses", eventController.UpdateEventStatuses)
 		eventGroup.GET("/needing-update", eventController.GetEventsNeedingUpdate)
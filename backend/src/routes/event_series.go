@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupEventSeriesRoutes(router *gin.Engine, seriesController *controllers.EventSeriesController) {
+	seriesGroup := router.Group("/api/series")
+	seriesGroup.Use(middleware.JWTAuth())
+
+	{
+		// Create recurring series
+		seriesGroup.POST("/", seriesController.CreateSeries)
+
+		// Get series with upcoming occurrences
+		seriesGroup.GET("/:id", seriesController.GetSeries)
+
+		// Edit/cancel occurrences (scope=this|following|all)
+		seriesGroup.PUT("/:id/occurrences/:eventId", seriesController.UpdateSeriesOccurrence)
+		seriesGroup.DELETE("/:id/occurrences/:eventId", seriesController.CancelSeriesOccurrence)
+	}
+}
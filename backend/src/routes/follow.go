@@ -17,6 +17,12 @@ func SetupFollowRoutes(router *gin.Engine, followController *controllers.FollowC
 		followGroup.DELETE("/:id/unfollow", followController.UnfollowUser)
 		followGroup.GET("/:id/follow-status", followController.GetFollowStatus)
 
+		// Follow requests to and from private accounts
+		followGroup.GET("/follow-requests", followController.GetFollowRequests)
+		followGroup.POST("/follow-requests/:id/approve", followController.ApproveFollowRequest)
+		followGroup.POST("/follow-requests/:id/deny", followController.DenyFollowRequest)
+		followGroup.DELETE("/:id/follow-request", followController.CancelFollowRequest)
+
 		// Follow lists and statistics
 		followGroup.GET("/:id/followers", followController.GetFollowers)
 		followGroup.GET("/:id/following", followController.GetFollowing)
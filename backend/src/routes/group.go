@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupGroupRoutes configures group routes
+func SetupGroupRoutes(router *gin.Engine, groupController *controllers.GroupController) {
+	groupGroup := router.Group("/api/groups")
+	groupGroup.Use(middleware.JWTAuth())
+	{
+		// Create and list groups
+		groupGroup.POST("/", groupController.CreateGroup)
+		groupGroup.GET("/", groupController.GetGroups)
+
+		// Groups of the current user
+		groupGroup.GET("/my", groupController.GetMyGroups)
+
+		// Group page and settings (admins)
+		groupGroup.GET("/:id", groupController.GetGroup)
+		groupGroup.PUT("/:id", groupController.UpdateGroup)
+		groupGroup.DELETE("/:id", groupController.DeleteGroup)
+
+		// Joining (public groups directly, private ones by request)
+		groupGroup.POST("/:id/join", groupController.JoinGroup)
+		groupGroup.DELETE("/:id/join-requests", groupController.CancelGroupJoinRequest)
+		groupGroup.GET("/:id/join-requests", groupController.GetGroupJoinRequests)
+		groupGroup.POST("/:id/join-requests/:userId/accept", groupController.AcceptGroupJoinRequest)
+		groupGroup.POST("/:id/join-requests/:userId/reject", groupController.RejectGroupJoinRequest)
+
+		// Members and roles
+		groupGroup.GET("/:id/members", groupController.GetGroupMembers)
+		groupGroup.PUT("/:id/members/:userId/role", groupController.SetGroupMemberRole)
+		groupGroup.DELETE("/:id/members/:userId", groupController.RemoveGroupMember)
+	}
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupLeagueRoutes configures league routes
+func SetupLeagueRoutes(router *gin.Engine, leagueController *controllers.LeagueController) {
+	leagueGroup := router.Group("/api/leagues")
+	leagueGroup.Use(middleware.JWTAuth())
+	{
+		// Create, list and view leagues
+		leagueGroup.POST("/", leagueController.CreateLeague)
+		leagueGroup.GET("/", leagueController.GetLeagues)
+		leagueGroup.GET("/:id", leagueController.GetLeague)
+
+		// Teams and their players
+		leagueGroup.POST("/:id/teams", leagueController.CreateLeagueTeam)
+		leagueGroup.POST("/:id/teams/:teamId/members", leagueController.AddLeagueTeamMember)
+		leagueGroup.DELETE("/:id/teams/:teamId/members/:userId", leagueController.RemoveLeagueTeamMember)
+
+		// Seasons, fixtures and standings
+		leagueGroup.POST("/:id/seasons", leagueController.CreateLeagueSeason)
+		leagueGroup.GET("/:id/seasons/:seasonId", leagueController.GetLeagueSeason)
+		leagueGroup.GET("/:id/seasons/:seasonId/standings", leagueController.GetLeagueStandings)
+		leagueGroup.POST("/:id/seasons/:seasonId/fixtures", leagueController.GenerateFixtures)
+
+		// Result entry updates the standings
+		leagueGroup.POST("/:id/seasons/:seasonId/fixtures/:fixtureId/result", leagueController.RecordFixtureResult)
+	}
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupMessageRoutes configures direct message routes
+func SetupMessageRoutes(router *gin.Engine, messageController *controllers.MessageController) {
+	conversationGroup := router.Group("/api/conversations")
+	conversationGroup.Use(middleware.JWTAuth())
+	{
+		// Inbox and starting a conversation
+		conversationGroup.GET("/", messageController.GetConversations)
+		conversationGroup.POST("/", messageController.StartConversation)
+
+		// Message history, sending and read receipts
+		conversationGroup.GET("/:id/messages", messageController.GetMessages)
+		conversationGroup.POST("/:id/messages", messageController.SendMessage)
+		conversationGroup.POST("/:id/read", messageController.MarkConversationRead)
+	}
+}
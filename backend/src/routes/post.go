@@ -34,8 +34,9 @@ func SetupPostRoutes(router *gin.Engine, postController *controllers.PostControl
 		// Update a post
 		postGroup.PUT("/:id", postController.UpdatePost)
 
-		// Upload post image
+		// Upload and fetch post image
 		postGroup.POST("/:id/image", postController.UploadPostImage)
+		postGroup.GET("/:id/image", postController.GetPostImage)
 
 		// Toggle like on a post
 		postGroup.POST("/:id/like", postController.ToggleLike)
@@ -48,7 +49,4 @@ func SetupPostRoutes(router *gin.Engine, postController *controllers.PostControl
 		// Temporary: Ping route to verify posts group reachability
 		postGroup.GET("/ping", func(c *gin.Context) { c.Status(200) })
 	}
-
-	// Public route to fetch post images without requiring JWT
-	router.GET("/api/posts/:id/image", postController.GetPostImage)
 }
@@ -14,6 +14,8 @@ func SetupProfileRoutes(router *gin.Engine, profileController *controllers.Profi
 	{
 		profileGroup.GET("/profile", profileController.GetProfile)
 		profileGroup.GET("/profile/:id", profileController.GetPublicProfile)
+		profileGroup.GET("/profile/:id/results", profileController.GetUserResults)
+		profileGroup.GET("/profile/:id/ratings/history", profileController.GetUserRatingHistory)
 		profileGroup.DELETE("/profile", profileController.DeleteAccount)
 		profileGroup.PUT("/profile", profileController.UpdateProfile)
 	}
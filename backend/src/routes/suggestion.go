@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSuggestionRoutes configures "people you may know" routes
+func SetupSuggestionRoutes(router *gin.Engine, suggestionController *controllers.SuggestionController) {
+	suggestionGroup := router.Group("/api/users")
+	suggestionGroup.Use(middleware.JWTAuth())
+	{
+		// Suggested users and dismissing them
+		suggestionGroup.GET("/suggestions", suggestionController.GetSuggestions)
+		suggestionGroup.POST("/suggestions/:id/dismiss", suggestionController.DismissSuggestion)
+	}
+}
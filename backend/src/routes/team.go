@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTeamRoutes configures team routes
+func SetupTeamRoutes(router *gin.Engine, teamController *controllers.TeamController) {
+	teamGroup := router.Group("/api/teams")
+
+	// Public route to fetch team avatars without requiring JWT
+	teamGroup.GET("/:id/avatar", teamController.GetTeamAvatar)
+
+	protected := teamGroup.Group("")
+	protected.Use(middleware.JWTAuth())
+	{
+		// Create and list teams
+		protected.POST("/", teamController.CreateTeam)
+		protected.GET("/", teamController.GetTeams)
+
+		// Teams and pending invitations of the current user
+		protected.GET("/my", teamController.GetMyTeams)
+		protected.GET("/invitations", teamController.GetMyTeamInvitations)
+
+		// Team page, settings and avatar (captains)
+		protected.GET("/:id", teamController.GetTeam)
+		protected.PUT("/:id", teamController.UpdateTeam)
+		protected.DELETE("/:id", teamController.DeleteTeam)
+		protected.POST("/:id/avatar", teamController.UploadTeamAvatar)
+		protected.DELETE("/:id/avatar", teamController.DeleteTeamAvatar)
+
+		// Invitations (captains invite, invitee accepts/declines)
+		protected.POST("/:id/invitations", teamController.InviteToTeam)
+		protected.GET("/:id/invitations", teamController.GetTeamInvitations)
+		protected.POST("/:id/invitations/accept", teamController.AcceptTeamInvitation)
+		protected.POST("/:id/invitations/decline", teamController.DeclineTeamInvitation)
+
+		// Join requests (user asks, captains accept/reject)
+		protected.POST("/:id/join-requests", teamController.RequestToJoinTeam)
+		protected.DELETE("/:id/join-requests", teamController.CancelTeamJoinRequest)
+		protected.GET("/:id/join-requests", teamController.GetTeamJoinRequests)
+		protected.POST("/:id/join-requests/:userId/accept", teamController.AcceptTeamJoinRequest)
+		protected.POST("/:id/join-requests/:userId/reject", teamController.RejectTeamJoinRequest)
+
+		// Roster management
+		protected.PUT("/:id/members/:userId/role", teamController.SetTeamMemberRole)
+		protected.DELETE("/:id/members/:userId", teamController.RemoveTeamMember)
+	}
+}
@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTournamentRoutes configures tournament routes
+func SetupTournamentRoutes(router *gin.Engine, tournamentController *controllers.TournamentController) {
+	tournamentGroup := router.Group("/api/tournaments")
+	tournamentGroup.Use(middleware.JWTAuth())
+	{
+		// Create a tournament for an event and view its bracket
+		tournamentGroup.POST("/", tournamentController.CreateTournament)
+		tournamentGroup.GET("/:id", tournamentController.GetTournament)
+
+		// Registration
+		tournamentGroup.POST("/:id/entries", tournamentController.RegisterEntry)
+		tournamentGroup.DELETE("/:id/entries/:entryId", tournamentController.WithdrawEntry)
+
+		// Seeding and drawing the bracket (organizers)
+		tournamentGroup.PUT("/:id/seeds", tournamentController.SetSeeds)
+		tournamentGroup.POST("/:id/start", tournamentController.StartTournament)
+
+		// Score entry advances the bracket
+		tournamentGroup.POST("/:id/matches/:matchId/score", tournamentController.RecordMatchScore)
+	}
+
+	// Tournament held at an event
+	eventGroup := router.Group("/api/events")
+	eventGroup.Use(middleware.JWTAuth())
+	{
+		eventGroup.GET("/:id/tournament", tournamentController.GetEventTournament)
+	}
+}
@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"backend/src/controllers"
+	"backend/src/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupVenueRoutes configures venue routes
+func SetupVenueRoutes(router *gin.Engine, venueController *controllers.VenueController) {
+	venueGroup := router.Group("/api/venues")
+
+	// Public route to fetch venue photos without requiring JWT
+	venueGroup.GET("/:id/photos/:photoId", venueController.GetVenuePhoto)
+
+	protected := venueGroup.Group("")
+	protected.Use(middleware.JWTAuth())
+	{
+		// Create and search venues
+		protected.POST("/", venueController.CreateVenue)
+		protected.GET("/", venueController.SearchVenues)
+
+		// Venue page with upcoming events
+		protected.GET("/:id", venueController.GetVenue)
+
+		// Update a venue (user who added it)
+		protected.PUT("/:id", venueController.UpdateVenue)
+
+		// Venue photos
+		protected.POST("/:id/photos", venueController.UploadVenuePhoto)
+		protected.DELETE("/:id/photos/:photoId", venueController.DeleteVenuePhoto)
+
+		// Bookable resources and opening hours (user who added the venue)
+		protected.POST("/:id/resources", venueController.CreateVenueResource)
+		protected.PUT("/:id/resources/:resourceId", venueController.UpdateVenueResource)
+		protected.DELETE("/:id/resources/:resourceId", venueController.DeleteVenueResource)
+		protected.PUT("/:id/hours", venueController.SetVenueOpeningHours)
+
+		// Free and booked slots per resource on a day
+		protected.GET("/:id/availability", venueController.GetVenueAvailability)
+	}
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCannotMessageSelf     = errors.New("cannot message yourself")
+	ErrRecipientNotFound     = errors.New("recipient does not exist")
+	ErrNotConversationMember = errors.New("user is not part of this conversation")
+	ErrConversationNotFound  = errors.New("conversation does not exist")
+)
+
+// CanMessage checks whether the sender may start or continue a conversation with the recipient
+func CanMessage(db *gorm.DB, senderID, recipientID uint) error {
+	if senderID == recipientID {
+		return ErrCannotMessageSelf
+	}
+	var count int64
+	db.Model(&models.User{}).Where("id = ?", recipientID).Count(&count)
+	if count == 0 {
+		return ErrRecipientNotFound
+	}
+	if IsBlockedBetween(db, senderID, recipientID) {
+		return ErrUserBlocked
+	}
+	return nil
+}
+
+// GetOrCreateConversation returns the conversation between the two users, creating it if needed
+func GetOrCreateConversation(db *gorm.DB, userID, otherID uint) (*models.Conversation, error) {
+	if err := CanMessage(db, userID, otherID); err != nil {
+		return nil, err
+	}
+
+	a, b := min(userID, otherID), max(userID, otherID)
+	conversation := models.Conversation{UserAID: a, UserBID: b}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&conversation).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_a_id = ? AND user_b_id = ?", a, b).First(&conversation).Error; err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// LoadConversation loads a conversation the user takes part in
+func LoadConversation(db *gorm.DB, conversationID, userID uint) (*models.Conversation, error) {
+	var conversation models.Conversation
+	if err := db.First(&conversation, conversationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrConversationNotFound
+		}
+		return nil, err
+	}
+	if conversation.UserAID != userID && conversation.UserBID != userID {
+		return nil, ErrNotConversationMember
+	}
+	return &conversation, nil
+}
+
+// ConversationPeer returns the other participant of the conversation
+func ConversationPeer(conversation *models.Conversation, userID uint) uint {
+	if conversation.UserAID == userID {
+		return conversation.UserBID
+	}
+	return conversation.UserAID
+}
+
+// SendDirectMessage stores a message in the conversation and delivers it live to both
+// participants' open notification streams. A recipient who muted the sender still gets the
+// message in the conversation but no live push.
+func SendDirectMessage(db *gorm.DB, conversation *models.Conversation, senderID uint, body string) (*models.DirectMessage, error) {
+	recipientID := ConversationPeer(conversation, senderID)
+	if err := CanMessage(db, senderID, recipientID); err != nil {
+		return nil, err
+	}
+
+	message := models.DirectMessage{
+		ConversationID: conversation.ID,
+		SenderID:       senderID,
+		Body:           body,
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&message).Error; err != nil {
+			return err
+		}
+		return tx.Model(conversation).Update("last_message_at", message.CreatedAt).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload := types.JSON{
+		"title":           fmt.Sprintf("New message from %s", userDisplayName(db, senderID)),
+		"body":            message.Body,
+		"target_type":     "conversation",
+		"target_id":       fmt.Sprintf("%d", conversation.ID),
+		"message_id":      message.ID,
+		"conversation_id": conversation.ID,
+		"sender_id":       senderID,
+		"sent_at":         message.CreatedAt,
+	}
+	if !IsHiddenFrom(db, recipientID, senderID) {
+		publishMessageEvent(recipientID, senderID, types.NotificationTypeDirectMessage, payload)
+	}
+	publishMessageEvent(senderID, senderID, types.NotificationTypeDirectMessage, payload)
+	return &message, nil
+}
+
+// MarkConversationRead marks the messages the user received in the conversation as read and
+// sends a read receipt to the other participant
+func MarkConversationRead(db *gorm.DB, conversation *models.Conversation, userID uint) (int64, error) {
+	now := time.Now()
+	result := db.Model(&models.DirectMessage{}).
+		Where("conversation_id = ? AND sender_id <> ? AND read_at IS NULL", conversation.ID, userID).
+		Update("read_at", now)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		publishMessageEvent(ConversationPeer(conversation, userID), userID, types.NotificationTypeMessageRead, types.JSON{
+			"target_type":     "conversation",
+			"target_id":       fmt.Sprintf("%d", conversation.ID),
+			"conversation_id": conversation.ID,
+			"reader_id":       userID,
+			"read_at":         now,
+		})
+	}
+	return result.RowsAffected, nil
+}
+
+// publishMessageEvent pushes a live event to the user's notification streams without storing
+// it as a notification
+func publishMessageEvent(userID, actorID uint, kind types.NotificationType, payload types.JSON) {
+	GetNotificationHub().Publish(models.Notification{
+		UserID:    userID,
+		ActorID:   &actorID,
+		Type:      kind,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	})
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+var ErrNotEventChatMember = errors.New("only the organizer and participants can use the event chat")
+
+// IsEventChatMember reports whether the user is in the event's chat. Membership follows the
+// roster: joining the event adds the user to the chat and leaving removes them.
+func IsEventChatMember(db *gorm.DB, event *models.Event, userID uint) bool {
+	if event.OrganizerID == userID {
+		return true
+	}
+	var count int64
+	db.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&count)
+	return count > 0
+}
+
+// PostEventMessage stores a message in the event chat and delivers it live to every member,
+// except those who blocked or muted the sender or were blocked by them
+func PostEventMessage(db *gorm.DB, event *models.Event, senderID uint, body string) (*models.EventMessage, error) {
+	if !IsEventChatMember(db, event, senderID) {
+		return nil, ErrNotEventChatMember
+	}
+
+	message := models.EventMessage{
+		EventID:  event.ID,
+		SenderID: senderID,
+		Body:     body,
+	}
+	if err := db.Create(&message).Error; err != nil {
+		return nil, err
+	}
+
+	var memberIDs []uint
+	db.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Pluck("user_id", &memberIDs)
+	memberIDs = append(memberIDs, event.OrganizerID)
+
+	payload := types.JSON{
+		"title":       fmt.Sprintf("%s in %s", userDisplayName(db, senderID), event.Title),
+		"body":        message.Body,
+		"target_type": "event",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+		"message_id":  message.ID,
+		"event_id":    event.ID,
+		"sender_id":   senderID,
+		"sent_at":     message.CreatedAt,
+	}
+	seen := make(map[uint]bool, len(memberIDs))
+	for _, uid := range memberIDs {
+		if seen[uid] || (uid != senderID && IsHiddenFrom(db, uid, senderID)) {
+			continue
+		}
+		seen[uid] = true
+		publishMessageEvent(uid, senderID, types.NotificationTypeEventMessage, payload)
+	}
+	return &message, nil
+}
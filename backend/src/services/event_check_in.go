@@ -0,0 +1,184 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// checkInCodeTTL is how long a generated check-in code stays valid
+	checkInCodeTTL = 15 * time.Minute
+	// checkInEarlyWindow lets organizers open check-in shortly before the start
+	checkInEarlyWindow = 30 * time.Minute
+	// checkInMaxAttempts is how many wrong codes a participant may enter before being locked out
+	checkInMaxAttempts = 5
+	// checkInLockout is how long a participant has to wait after too many wrong codes
+	checkInLockout = 15 * time.Minute
+)
+
+var (
+	ErrCheckInClosed      = errors.New("check-in is not open for this event")
+	ErrInvalidCheckInCode = errors.New("invalid or expired check-in code")
+	ErrCheckInLocked      = errors.New("too many wrong check-in codes, try again later")
+)
+
+// OpenCheckIn generates a fresh check-in code for the event, replacing any previous one.
+// Opening check-in also marks the event's attendance as tracked, so unchecked
+// participants become no-shows once it completes.
+func OpenCheckIn(db *gorm.DB, event *models.Event) (string, time.Time, error) {
+	now := time.Now()
+	switch {
+	case event.Status == string(types.EventStatusActive):
+	case event.Status == string(types.EventStatusUpcoming) && now.After(event.StartAt.Add(-checkInEarlyWindow)):
+	default:
+		return "", time.Time{}, ErrCheckInClosed
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	code := fmt.Sprintf("%06d", n.Int64())
+	expiresAt := now.Add(checkInCodeTTL)
+
+	updates := map[string]any{
+		"check_in_code":            code,
+		"check_in_code_expires_at": expiresAt,
+	}
+	if event.CheckInOpenedAt == nil {
+		updates["check_in_opened_at"] = now
+	}
+	if err := db.Model(event).Updates(updates).Error; err != nil {
+		return "", time.Time{}, err
+	}
+
+	return code, expiresAt, nil
+}
+
+// CheckInWithCode checks a participant in with the code shown by the organizer.
+// Only works while the event is active and the code has not expired. After
+// checkInMaxAttempts wrong codes in a row the participant is locked out for checkInLockout.
+func CheckInWithCode(db *gorm.DB, event *models.Event, userID uint, code string) error {
+	if event.Status != string(types.EventStatusActive) {
+		return ErrCheckInClosed
+	}
+
+	var participant models.EventParticipant
+	if err := db.Where("event_id = ? AND user_id = ?", event.ID, userID).First(&participant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotParticipating
+		}
+		return err
+	}
+
+	now := time.Now()
+	if participant.CheckInLockedUntil != nil && now.Before(*participant.CheckInLockedUntil) {
+		return ErrCheckInLocked
+	}
+
+	valid := event.CheckInCode != "" && event.CheckInCodeExpiresAt != nil && now.Before(*event.CheckInCodeExpiresAt) &&
+		subtle.ConstantTimeCompare([]byte(code), []byte(event.CheckInCode)) == 1
+	if !valid {
+		if err := recordFailedCheckIn(db, participant.ID, now); err != nil {
+			return err
+		}
+		return ErrInvalidCheckInCode
+	}
+
+	updates := map[string]any{"check_in_failed_attempts": 0, "check_in_locked_until": nil}
+	if participant.CheckedInAt == nil {
+		updates["checked_in_at"] = now
+		updates["no_show"] = false
+	}
+	return db.Model(&participant).Updates(updates).Error
+}
+
+// recordFailedCheckIn counts a wrong check-in code and locks the participant out once they
+// reach checkInMaxAttempts, starting a fresh count for when the lockout ends
+func recordFailedCheckIn(db *gorm.DB, participantID uint, now time.Time) error {
+	if err := db.Model(&models.EventParticipant{}).Where("id = ?", participantID).
+		Update("check_in_failed_attempts", gorm.Expr("check_in_failed_attempts + 1")).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.EventParticipant{}).
+		Where("id = ? AND check_in_failed_attempts >= ?", participantID, checkInMaxAttempts).
+		Updates(map[string]any{"check_in_failed_attempts": 0, "check_in_locked_until": now.Add(checkInLockout)}).Error
+}
+
+// SetCheckedIn lets the organizer mark a participant as present or absent. It can also be
+// used after the event completed to correct the roster, which clears a no-show flag.
+func SetCheckedIn(db *gorm.DB, event *models.Event, userID uint, checkedIn bool) error {
+	if event.Status != string(types.EventStatusActive) && event.Status != string(types.EventStatusComplete) {
+		return ErrCheckInClosed
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		updates := map[string]any{"checked_in_at": nil, "no_show": event.Status == string(types.EventStatusComplete)}
+		if checkedIn {
+			updates = map[string]any{"checked_in_at": time.Now(), "no_show": false}
+		}
+
+		result := tx.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotParticipating
+		}
+
+		if event.CheckInOpenedAt == nil {
+			return tx.Model(event).Update("check_in_opened_at", time.Now()).Error
+		}
+		return nil
+	})
+}
+
+// MarkNoShows flags participants of completed, check-in tracked events who never checked in
+func MarkNoShows(db *gorm.DB) error {
+	result := db.Exec(`
+		UPDATE event_participants SET no_show = true
+		WHERE checked_in_at IS NULL AND no_show = false AND event_id IN (
+			SELECT id FROM events
+			WHERE status = ? AND check_in_opened_at IS NOT NULL AND deleted_at IS NULL
+		)`, types.EventStatusComplete)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		log.Printf("Marked %d participants as no-shows", result.RowsAffected)
+	}
+	return nil
+}
+
+// ReliabilityFor returns the percentage of check-in tracked, completed events the user
+// attended and how many such events there were. The percentage is nil until one exists.
+func ReliabilityFor(db *gorm.DB, userID uint) (*int, int) {
+	var stats struct {
+		Tracked  int
+		Attended int
+	}
+	db.Raw(`
+		SELECT
+			COUNT(*) AS tracked,
+			COUNT(*) FILTER (WHERE p.checked_in_at IS NOT NULL) AS attended
+		FROM event_participants p
+		JOIN events e ON e.id = p.event_id
+		WHERE p.user_id = ? AND e.status = ? AND e.check_in_opened_at IS NOT NULL AND e.deleted_at IS NULL`,
+		userID, types.EventStatusComplete).Scan(&stats)
+
+	if stats.Tracked == 0 {
+		return nil, 0
+	}
+	pct := stats.Attended * 100 / stats.Tracked
+	return &pct, stats.Tracked
+}
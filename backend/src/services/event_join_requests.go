@@ -0,0 +1,212 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrBannedFromEvent     = errors.New("user is banned from this event")
+	ErrJoinRequestPending  = errors.New("a request to join this event is already pending")
+	ErrJoinRequestRejected = errors.New("the request to join this event was rejected")
+	ErrNoJoinRequest       = errors.New("no pending request to join this event")
+)
+
+// IsBannedFromEvent reports whether the user was banned from the event
+func IsBannedFromEvent(db *gorm.DB, eventID, userID uint) bool {
+	var count int64
+	db.Model(&models.EventBan{}).Where("event_id = ? AND user_id = ?", eventID, userID).Count(&count)
+	return count > 0
+}
+
+// RequestToJoin records a pending request to join an approval-required event and notifies
+// the organizer. Rejected requests can't be renewed; the organizer can still invite the user.
+func RequestToJoin(db *gorm.DB, event *models.Event, userID uint) (*models.EventJoinRequest, error) {
+	var existing int64
+	db.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&existing)
+	if existing > 0 {
+		return nil, ErrAlreadyParticipating
+	}
+	db.Model(&models.EventWaitlistEntry{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&existing)
+	if existing > 0 {
+		return nil, ErrAlreadyWaitlisted
+	}
+
+	var request models.EventJoinRequest
+	err := db.Where("event_id = ? AND user_id = ?", event.ID, userID).First(&request).Error
+	switch {
+	case err == nil && request.Status == string(types.ParticipationStatusPending):
+		return nil, ErrJoinRequestPending
+	case err == nil && request.Status == string(types.ParticipationStatusRejected):
+		return nil, ErrJoinRequestRejected
+	case err == nil:
+		// Accepted earlier but left since: ask again
+		request.Status = string(types.ParticipationStatusPending)
+		request.RespondedByID = nil
+		request.RespondedAt = nil
+		if err := db.Save(&request).Error; err != nil {
+			return nil, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		request = models.EventJoinRequest{
+			EventID: event.ID,
+			UserID:  userID,
+			Status:  string(types.ParticipationStatusPending),
+		}
+		if err := db.Create(&request).Error; err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	var actor models.User
+	_ = db.First(&actor, userID).Error
+	name := actor.DisplayName
+	if name == "" {
+		name = actor.Username
+	}
+	notifyJoinRequest(db, event, userID, event.OrganizerID, fmt.Sprintf("%s asked to join your activity", name))
+
+	return &request, nil
+}
+
+// RespondToJoinRequest accepts or rejects a pending join request. Accepted users join the
+// event, or its waitlist when it is full; either way the user is notified.
+func RespondToJoinRequest(db *gorm.DB, event *models.Event, actorID, userID uint, accept bool) (*models.EventWaitlistEntry, error) {
+	var entry *models.EventWaitlistEntry
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var request models.EventJoinRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("event_id = ? AND user_id = ? AND status = ?", event.ID, userID, types.ParticipationStatusPending).
+			First(&request).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoJoinRequest
+			}
+			return err
+		}
+
+		status := types.ParticipationStatusRejected
+		if accept {
+			status = types.ParticipationStatusAccepted
+			var err error
+			if _, entry, err = JoinOrWaitlist(tx, event.ID, userID); err != nil && !errors.Is(err, ErrAlreadyParticipating) && !errors.Is(err, ErrAlreadyWaitlisted) {
+				return err
+			}
+		}
+
+		now := time.Now()
+		return tx.Model(&request).Updates(map[string]any{
+			"status":          string(status),
+			"responded_by_id": actorID,
+			"responded_at":    now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	title := "Your request to join was declined"
+	switch {
+	case accept && entry != nil:
+		title = "Your request was accepted - you're on the waitlist"
+	case accept:
+		title = "Your request was accepted - you're in!"
+	}
+	notifyJoinRequest(db, event, actorID, userID, title)
+
+	return entry, nil
+}
+
+// CancelJoinRequest withdraws the user's pending request to join
+func CancelJoinRequest(db *gorm.DB, eventID, userID uint) error {
+	result := db.Where("event_id = ? AND user_id = ? AND status = ?", eventID, userID, types.ParticipationStatusPending).
+		Delete(&models.EventJoinRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoJoinRequest
+	}
+	return nil
+}
+
+// JoinRequestStatusFor returns the status of the user's request to join, if it wasn't accepted
+func JoinRequestStatusFor(db *gorm.DB, eventID, userID uint) *types.ParticipationStatus {
+	var request models.EventJoinRequest
+	if db.Where("event_id = ? AND user_id = ? AND status <> ?", eventID, userID, types.ParticipationStatusAccepted).
+		Limit(1).Find(&request).RowsAffected == 0 {
+		return nil
+	}
+	status := types.ParticipationStatus(request.Status)
+	return &status
+}
+
+// BanFromEvent keeps the user out of the event: they lose their spot or waitlist place,
+// pending requests are rejected and they can't join or request to join again.
+func BanFromEvent(db *gorm.DB, event *models.Event, actorID, userID uint, reason string) error {
+	ban := models.EventBan{
+		EventID:    event.ID,
+		UserID:     userID,
+		BannedByID: actorID,
+		Reason:     reason,
+	}
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"banned_by_id", "reason"}),
+	}).Create(&ban).Error; err != nil {
+		return err
+	}
+
+	if _, err := RemoveParticipant(db, event.ID, userID); err != nil && !errors.Is(err, ErrNotParticipating) {
+		return err
+	}
+	if err := LeaveWaitlist(db, event.ID, userID); err != nil && !errors.Is(err, ErrNotWaitlisted) {
+		return err
+	}
+
+	now := time.Now()
+	return db.Model(&models.EventJoinRequest{}).
+		Where("event_id = ? AND user_id = ? AND status = ?", event.ID, userID, types.ParticipationStatusPending).
+		Updates(map[string]any{"status": string(types.ParticipationStatusRejected), "responded_by_id": actorID, "responded_at": now}).Error
+}
+
+// UnbanFromEvent lifts a ban; the user can join (or ask to join) again
+func UnbanFromEvent(db *gorm.DB, eventID, userID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventBan{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		// Give them a clean slate, a rejected request would otherwise block asking again
+		return tx.Where("event_id = ? AND user_id = ? AND status = ?", eventID, userID, types.ParticipationStatusRejected).
+			Delete(&models.EventJoinRequest{}).Error
+	})
+}
+
+// notifyJoinRequest sends a notification about a join request
+func notifyJoinRequest(db *gorm.DB, event *models.Event, actorID, userID uint, title string) {
+	payload := types.JSON{
+		"title":       title,
+		"body":        event.Title,
+		"target_type": "activity",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+	}
+	notif := models.Notification{UserID: userID, ActorID: &actorID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+	if err := db.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about a join request for event %d: %v", userID, event.ID, err)
+		return
+	}
+	GetNotificationHub().Publish(notif)
+}
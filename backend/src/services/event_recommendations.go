@@ -0,0 +1,196 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"backend/src/utils"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// How much each signal adds to an event's "for you" score. Every signal is first scaled to 0..1.
+const (
+	recommendationSportWeight       = 3.0
+	recommendationDistanceWeight    = 2.0
+	recommendationFriendsWeight     = 2.0
+	recommendationReliabilityWeight = 1.0
+	recommendationTimeOfWeekWeight  = 1.0
+)
+
+const (
+	// recommendationPoolSize is how many of the soonest upcoming events get ranked
+	recommendationPoolSize = 500
+	// recommendationMaxDistanceKm is the distance from home at which the distance signal drops to 0
+	recommendationMaxDistanceKm = 50.0
+	// recommendationFriendsCap is the number of friends attending that gives the full friends signal
+	recommendationFriendsCap = 3
+	// recommendationUntrackedReliability is the reliability signal of organizers without tracked attendance
+	recommendationUntrackedReliability = 0.5
+)
+
+// EventRecommendation is an upcoming event ranked for a user, with the signals behind its score
+type EventRecommendation struct {
+	Event                models.Event
+	Score                float64
+	SportScore           float64
+	DistanceScore        float64
+	FriendsScore         float64
+	ReliabilityScore     float64
+	TimeOfWeekScore      float64
+	SportMatch           bool
+	DistanceKm           *float64
+	FriendsAttending     int
+	OrganizerReliability *int
+	TimeSlot             string
+	TimeSlotJoins        int
+}
+
+// RecommendEvents ranks the soonest upcoming events the user can see and hasn't joined by how
+// well they fit them: the user's sports, the distance from their home location, people they
+// follow taking part, the organizer's attendance reliability and how often the user joined
+// events at the same time of the week before
+func RecommendEvents(db *gorm.DB, userID uint) ([]EventRecommendation, error) {
+	var user models.User
+	if err := db.Preload("Sports").First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+
+	var events []models.Event
+	if err := db.Preload("Organizer").
+		Scopes(VisibleEventsScope(userID), HiddenUsersScope(userID, "events.organizer_id")).
+		Where("events.deleted_at IS NULL AND events.status = ? AND events.start_at > ? AND events.organizer_id <> ?",
+			types.EventStatusUpcoming, time.Now(), userID).
+		Where("NOT EXISTS (SELECT 1 FROM event_participants p WHERE p.event_id = events.id AND p.user_id = ?)", userID).
+		Order("events.start_at ASC").
+		Limit(recommendationPoolSize).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return []EventRecommendation{}, nil
+	}
+
+	sports := make(map[string]bool, len(user.Sports))
+	for _, s := range user.Sports {
+		sports[strings.ToLower(s.Name)] = true
+	}
+
+	friends, err := friendsAttending(db, userID, events)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, busiestSlot, err := timeOfWeekJoins(db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reliabilities := make(map[uint]*int)
+	recommendations := make([]EventRecommendation, 0, len(events))
+	for _, event := range events {
+		r := EventRecommendation{Event: event}
+
+		if event.Sport != "" && sports[strings.ToLower(event.Sport)] {
+			r.SportMatch = true
+			r.SportScore = recommendationSportWeight
+		}
+
+		if user.HomeLatitude != nil && user.HomeLongitude != nil && event.Latitude != nil && event.Longitude != nil {
+			distance := utils.HaversineKm(*user.HomeLatitude, *user.HomeLongitude, *event.Latitude, *event.Longitude)
+			r.DistanceKm = &distance
+			if distance < recommendationMaxDistanceKm {
+				r.DistanceScore = (1 - distance/recommendationMaxDistanceKm) * recommendationDistanceWeight
+			}
+		}
+
+		r.FriendsAttending = friends[event.ID]
+		r.FriendsScore = float64(min(r.FriendsAttending, recommendationFriendsCap)) / recommendationFriendsCap * recommendationFriendsWeight
+
+		reliability, ok := reliabilities[event.OrganizerID]
+		if !ok {
+			reliability, _ = ReliabilityFor(db, event.OrganizerID)
+			reliabilities[event.OrganizerID] = reliability
+		}
+		r.OrganizerReliability = reliability
+		if reliability != nil {
+			r.ReliabilityScore = float64(*reliability) / 100 * recommendationReliabilityWeight
+		} else {
+			r.ReliabilityScore = recommendationUntrackedReliability * recommendationReliabilityWeight
+		}
+
+		r.TimeSlot = timeOfWeekSlot(event.StartAt)
+		r.TimeSlotJoins = slots[r.TimeSlot]
+		if busiestSlot > 0 {
+			r.TimeOfWeekScore = float64(r.TimeSlotJoins) / float64(busiestSlot) * recommendationTimeOfWeekWeight
+		}
+
+		r.Score = r.SportScore + r.DistanceScore + r.FriendsScore + r.ReliabilityScore + r.TimeOfWeekScore
+		recommendations = append(recommendations, r)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	return recommendations, nil
+}
+
+// friendsAttending counts, per event, the participants the user follows
+func friendsAttending(db *gorm.DB, userID uint, events []models.Event) (map[uint]int, error) {
+	ids := make([]uint, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+
+	var counts []struct {
+		EventID uint
+		Count   int
+	}
+	if err := db.Model(&models.EventParticipant{}).Select("event_id, COUNT(*) AS count").
+		Where("event_id IN ? AND user_id IN (SELECT followed_id FROM follows WHERE follower_id = ?)", ids, userID).
+		Group("event_id").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	friends := make(map[uint]int, len(counts))
+	for _, row := range counts {
+		friends[row.EventID] = row.Count
+	}
+	return friends, nil
+}
+
+// timeOfWeekJoins counts the user's past joins per time-of-week slot and returns the highest count
+func timeOfWeekJoins(db *gorm.DB, userID uint) (map[string]int, int, error) {
+	var starts []time.Time
+	if err := db.Model(&models.EventParticipant{}).
+		Joins("JOIN events ON events.id = event_participants.event_id").
+		Where("event_participants.user_id = ? AND events.start_at < ? AND events.deleted_at IS NULL", userID, time.Now()).
+		Pluck("events.start_at", &starts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	slots := make(map[string]int)
+	busiest := 0
+	for _, start := range starts {
+		slot := timeOfWeekSlot(start)
+		slots[slot]++
+		busiest = max(busiest, slots[slot])
+	}
+	return slots, busiest, nil
+}
+
+// timeOfWeekSlot names the part of the week a time falls in, e.g. "Saturday morning"
+func timeOfWeekSlot(t time.Time) string {
+	part := "evening"
+	switch hour := t.Hour(); {
+	case hour < 12:
+		part = "morning"
+	case hour < 17:
+		part = "afternoon"
+	}
+	return fmt.Sprintf("%s %s", t.Weekday(), part)
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/types"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// defaultReminderOffsets is used when EVENT_REMINDER_OFFSETS is not set
+var defaultReminderOffsets = []time.Duration{24 * time.Hour, 1 * time.Hour}
+
+type EventReminderScheduler struct {
+	db      *gorm.DB
+	offsets []time.Duration
+	ticker  *time.Ticker
+	done    chan bool
+}
+
+// NewEventReminderScheduler creates a new event reminder service. Offsets before the start
+// of an event are read from EVENT_REMINDER_OFFSETS as comma separated durations (e.g. "24h,1h").
+func NewEventReminderScheduler() *EventReminderScheduler {
+	return &EventReminderScheduler{
+		db:      config.DB,
+		offsets: parseReminderOffsets(os.Getenv("EVENT_REMINDER_OFFSETS")),
+		done:    make(chan bool),
+	}
+}
+
+// Start begins sending reminders for upcoming events
+// It runs every minute so reminders go out close to their offset
+func (ers *EventReminderScheduler) Start() {
+	log.Println("Starting Event Reminder Scheduler service...")
+
+	// Run immediately on start
+	ers.sendDueReminders()
+
+	ers.ticker = time.NewTicker(1 * time.Minute)
+
+	go func() {
+		for {
+			select {
+			case <-ers.ticker.C:
+				ers.sendDueReminders()
+			case <-ers.done:
+				log.Println("Event Reminder Scheduler service stopped")
+				return
+			}
+		}
+	}()
+
+	log.Printf("Event Reminder Scheduler service started successfully (offsets: %v)", ers.offsets)
+}
+
+// Stop gracefully stops the reminder service
+func (ers *EventReminderScheduler) Stop() {
+	if ers.ticker != nil {
+		ers.ticker.Stop()
+	}
+	ers.done <- true
+}
+
+// sendDueReminders sends the reminders of every offset whose window contains the current time.
+// The window of an offset runs until the next smaller offset, so someone joining late only
+// gets the reminders that are still ahead, and a backend that was down catches up once.
+func (ers *EventReminderScheduler) sendDueReminders() {
+	now := time.Now()
+	for i, offset := range ers.offsets {
+		var until time.Duration
+		if i+1 < len(ers.offsets) {
+			until = ers.offsets[i+1]
+		}
+
+		sent, err := ers.sendReminders(now, offset, until)
+		if err != nil {
+			log.Printf("Error sending %v event reminders: %v", offset, err)
+			continue
+		}
+		if sent > 0 {
+			log.Printf("Sent %d event reminders (%v before start)", sent, offset)
+		}
+	}
+}
+
+// sendReminders notifies organizers and participants of upcoming events starting between
+// now+until and now+offset. Reminders are claimed by inserting into event_reminders first;
+// the unique index lets only one instance (or run) claim each, so none is sent twice.
+func (ers *EventReminderScheduler) sendReminders(now time.Time, offset, until time.Duration) (int, error) {
+	type claimed struct {
+		EventID uint
+		UserID  uint
+	}
+	var rows []claimed
+	if err := ers.db.Raw(`
+		INSERT INTO event_reminders (event_id, user_id, offset_minutes, sent_at)
+		SELECT r.event_id, r.user_id, ?, ?
+		FROM (
+			SELECT e.id AS event_id, e.organizer_id AS user_id FROM events e
+			WHERE e.status = ? AND e.deleted_at IS NULL AND e.start_at > ? AND e.start_at <= ?
+			UNION
+			SELECT e.id, p.user_id FROM events e
+			JOIN event_participants p ON p.event_id = e.id
+			WHERE e.status = ? AND e.deleted_at IS NULL AND e.start_at > ? AND e.start_at <= ?
+		) r
+		JOIN users u ON u.id = r.user_id AND u.deleted_at IS NULL AND u.event_reminders
+		ON CONFLICT (event_id, user_id, offset_minutes) DO NOTHING
+		RETURNING event_id, user_id`,
+		int(offset/time.Minute), now,
+		types.EventStatusUpcoming, now.Add(until), now.Add(offset),
+		types.EventStatusUpcoming, now.Add(until), now.Add(offset),
+	).Scan(&rows).Error; err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	eventIDs := make([]uint, 0, len(rows))
+	for _, r := range rows {
+		eventIDs = append(eventIDs, r.EventID)
+	}
+	var events []models.Event
+	if err := ers.db.Where("id IN ?", eventIDs).Find(&events).Error; err != nil {
+		return 0, err
+	}
+	byID := make(map[uint]*models.Event, len(events))
+	for i := range events {
+		byID[events[i].ID] = &events[i]
+	}
+
+	sent := 0
+	for _, r := range rows {
+		event, ok := byID[r.EventID]
+		if !ok {
+			continue
+		}
+		payload := types.JSON{
+			"title":       fmt.Sprintf("Starts in %s", formatReminderLead(event.StartAt.Sub(now))),
+			"body":        event.Title,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", event.ID),
+		}
+		notif := models.Notification{UserID: r.UserID, ActorID: &event.OrganizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := ers.db.Create(&notif).Error; err != nil {
+			log.Printf("Failed to remind user %d about event %d: %v", r.UserID, event.ID, err)
+			continue
+		}
+		GetNotificationHub().Publish(notif)
+		sent++
+	}
+
+	return sent, nil
+}
+
+// parseReminderOffsets parses comma separated durations, largest first.
+// Invalid or non-positive values are skipped; an empty result falls back to the defaults.
+func parseReminderOffsets(value string) []time.Duration {
+	seen := make(map[time.Duration]bool)
+	var offsets []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil || d < time.Minute {
+			log.Printf("Ignoring invalid event reminder offset %q", part)
+			continue
+		}
+		d = d.Truncate(time.Minute)
+		if !seen[d] {
+			seen[d] = true
+			offsets = append(offsets, d)
+		}
+	}
+	if len(offsets) == 0 {
+		offsets = append(offsets, defaultReminderOffsets...)
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] > offsets[j] })
+	return offsets
+}
+
+// formatReminderLead renders the time left before the start, e.g. "1 day", "3 hours" or "45 minutes"
+func formatReminderLead(d time.Duration) string {
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s", unit)
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+
+	switch {
+	case d >= 24*time.Hour:
+		return plural(int((d+12*time.Hour)/(24*time.Hour)), "day")
+	case d >= time.Hour:
+		return plural(int((d+30*time.Minute)/time.Hour), "hour")
+	default:
+		return plural(max(int((d+30*time.Second)/time.Minute), 1), "minute")
+	}
+}
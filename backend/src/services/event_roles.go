@@ -0,0 +1,143 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInvalidRole      = errors.New("invalid participant role")
+	ErrAlreadyOrganizer = errors.New("user already organizes this event")
+)
+
+// ParticipantRoleFor returns the user's role in the event, or "" if they don't take part
+func ParticipantRoleFor(db *gorm.DB, event *models.Event, userID uint) types.ParticipantRole {
+	if event.OrganizerID == userID {
+		return types.ParticipantRoleOrganizer
+	}
+
+	var participant models.EventParticipant
+	if db.Select("role").Where("event_id = ? AND user_id = ?", event.ID, userID).Limit(1).Find(&participant).RowsAffected == 0 {
+		return ""
+	}
+	if participant.Role == "" {
+		return types.ParticipantRoleParticipant
+	}
+	return types.ParticipantRole(participant.Role)
+}
+
+// CanManageEvent reports whether the user may edit the event and manage its roster:
+// the organizer and co-organizers.
+func CanManageEvent(db *gorm.DB, event *models.Event, userID uint) bool {
+	return HasEventRole(db, event, userID, types.ParticipantRoleOrganizer, types.ParticipantRoleCoOrganizer)
+}
+
+// HasEventRole reports whether the user has one of the given roles in the event
+func HasEventRole(db *gorm.DB, event *models.Event, userID uint, roles ...types.ParticipantRole) bool {
+	role := ParticipantRoleFor(db, event, userID)
+	for _, r := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// SetParticipantRole changes the role of a participant and notifies them.
+// Returns the previous role; unchanged roles don't generate a notification.
+func SetParticipantRole(db *gorm.DB, event *models.Event, actorID, userID uint, role types.ParticipantRole) (types.ParticipantRole, error) {
+	if !role.IsAssignable() {
+		return "", ErrInvalidRole
+	}
+	if event.OrganizerID == userID {
+		return "", ErrAlreadyOrganizer
+	}
+
+	var participant models.EventParticipant
+	if err := db.Where("event_id = ? AND user_id = ?", event.ID, userID).First(&participant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrNotParticipating
+		}
+		return "", err
+	}
+
+	previous := types.ParticipantRole(participant.Role)
+	if previous == role {
+		return previous, nil
+	}
+	if err := db.Model(&participant).Update("role", string(role)).Error; err != nil {
+		return "", err
+	}
+
+	notifyEventRole(db, event, actorID, userID, fmt.Sprintf("You are now %s", role.Label()))
+	return previous, nil
+}
+
+// TransferOwnership hands the event over to one of its participants. The new organizer's
+// participation is replaced by one for the previous organizer, who stays on as co-organizer,
+// so the number of taken spots doesn't change. Only this event is affected, not its series.
+func TransferOwnership(db *gorm.DB, event *models.Event, newOwnerID uint) error {
+	previousOwnerID := event.OrganizerID
+	if previousOwnerID == newOwnerID {
+		return ErrAlreadyOrganizer
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(event, event.ID).Error; err != nil {
+			return err
+		}
+		if event.OrganizerID != previousOwnerID {
+			return ErrAlreadyOrganizer
+		}
+
+		result := tx.Where("event_id = ? AND user_id = ?", event.ID, newOwnerID).Delete(&models.EventParticipant{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotParticipating
+		}
+
+		coOrganizer := models.EventParticipant{
+			EventID:  event.ID,
+			UserID:   previousOwnerID,
+			Role:     string(types.ParticipantRoleCoOrganizer),
+			JoinedAt: time.Now(),
+		}
+		if err := tx.Create(&coOrganizer).Error; err != nil {
+			return err
+		}
+
+		event.OrganizerID = newOwnerID
+		return tx.Model(event).Update("organizer_id", newOwnerID).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	notifyEventRole(db, event, previousOwnerID, newOwnerID, "You are now the organizer")
+	return nil
+}
+
+// notifyEventRole tells a user about their new role in an event
+func notifyEventRole(db *gorm.DB, event *models.Event, actorID, userID uint, title string) {
+	payload := types.JSON{
+		"title":       title,
+		"body":        event.Title,
+		"target_type": "activity",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+	}
+	notif := models.Notification{UserID: userID, ActorID: &actorID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+	if err := db.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about their role in event %d: %v", userID, event.ID, err)
+		return
+	}
+	GetNotificationHub().Publish(notif)
+}
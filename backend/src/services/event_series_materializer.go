@@ -0,0 +1,202 @@
+package services
+
+import (
+	"backend/src/config"
+	"backend/src/models"
+	"backend/src/types"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// seriesHorizon is how far ahead occurrences of a series are created
+	seriesHorizon = 8 * 7 * 24 * time.Hour
+	// maxOccurrencesPerRun bounds the work done for one series in a single pass
+	maxOccurrencesPerRun = 200
+)
+
+type EventSeriesMaterializer struct {
+	db     *gorm.DB
+	ticker *time.Ticker
+	done   chan bool
+}
+
+// NewEventSeriesMaterializer creates a new recurring series materializer service
+func NewEventSeriesMaterializer() *EventSeriesMaterializer {
+	return &EventSeriesMaterializer{
+		db:   config.DB,
+		done: make(chan bool),
+	}
+}
+
+// Start begins materializing occurrences of active series
+// It runs every hour so the horizon keeps rolling forward
+func (m *EventSeriesMaterializer) Start() {
+	log.Println("Starting Event Series Materializer service...")
+
+	// Run immediately on start
+	m.materializeAll()
+
+	m.ticker = time.NewTicker(1 * time.Hour)
+
+	go func() {
+		for {
+			select {
+			case <-m.ticker.C:
+				m.materializeAll()
+			case <-m.done:
+				log.Println("Event Series Materializer service stopped")
+				return
+			}
+		}
+	}()
+
+	log.Println("Event Series Materializer service started successfully")
+}
+
+// Stop gracefully stops the materializer service
+func (m *EventSeriesMaterializer) Stop() {
+	if m.ticker != nil {
+		m.ticker.Stop()
+	}
+	m.done <- true
+}
+
+// materializeAll creates missing occurrences for every active series
+func (m *EventSeriesMaterializer) materializeAll() {
+	var series []models.EventSeries
+	if err := m.db.Where("status = ?", types.SeriesStatusActive).Find(&series).Error; err != nil {
+		log.Printf("Error loading event series: %v", err)
+		return
+	}
+
+	total := 0
+	for i := range series {
+		created, err := m.MaterializeSeries(&series[i])
+		if err != nil {
+			log.Printf("Error materializing event series %d: %v", series[i].ID, err)
+			continue
+		}
+		total += created
+	}
+
+	if total > 0 {
+		log.Printf("Materialized %d new event series occurrences", total)
+	}
+}
+
+// MaterializeSeries creates the occurrences of a series that fall within the horizon.
+// Occurrences already in the past are skipped rather than back-filled. Inserts are
+// conflict-free on (series_id, occurrence_index), so running this concurrently from
+// several instances, or after an occurrence was cancelled, never duplicates events.
+func (m *EventSeriesMaterializer) MaterializeSeries(series *models.EventSeries) (int, error) {
+	if series.Status != string(types.SeriesStatusActive) {
+		return 0, nil
+	}
+
+	now := time.Now()
+	horizon := now.Add(seriesHorizon)
+	created := 0
+	index := series.MaterializedCount
+
+	for index-series.MaterializedCount < maxOccurrencesPerRun {
+		start := SeriesOccurrenceStart(series, index)
+		if !SeriesIncludes(series, index, start) || start.After(horizon) {
+			break
+		}
+
+		if start.After(now) {
+			event := newSeriesOccurrence(series, index, start)
+			result := m.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&event)
+			if result.Error != nil {
+				return created, result.Error
+			}
+			created += int(result.RowsAffected)
+		}
+		index++
+	}
+
+	if index != series.MaterializedCount {
+		if err := m.db.Model(&models.EventSeries{}).
+			Where("id = ? AND materialized_count < ?", series.ID, index).
+			Update("materialized_count", index).Error; err != nil {
+			return created, err
+		}
+		series.MaterializedCount = index
+	}
+
+	return created, nil
+}
+
+// SeriesOccurrenceStart returns the start time of the occurrence at the given 0-based index.
+// The rule is evaluated in the series time zone so local wall-clock time survives DST changes.
+// Monthly occurrences on days the month doesn't have (e.g. the 31st) fall on its last day.
+func SeriesOccurrenceStart(series *models.EventSeries, index int) time.Time {
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	start := series.StartAt.In(loc)
+
+	switch types.RecurrenceFrequency(series.Frequency) {
+	case types.RecurrenceBiweekly:
+		return start.AddDate(0, 0, 14*index)
+	case types.RecurrenceMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(index), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), loc)
+		lastDay := first.AddDate(0, 1, -1).Day()
+		day := start.Day()
+		if day > lastDay {
+			day = lastDay
+		}
+		return first.AddDate(0, 0, day-1)
+	default:
+		return start.AddDate(0, 0, 7*index)
+	}
+}
+
+// SeriesIncludes reports whether the occurrence at index (starting at start) is within the series bounds
+func SeriesIncludes(series *models.EventSeries, index int, start time.Time) bool {
+	if series.Count != nil && index >= *series.Count {
+		return false
+	}
+	if series.Until != nil && start.After(*series.Until) {
+		return false
+	}
+	return true
+}
+
+// newSeriesOccurrence builds the event for one occurrence from the series template
+func newSeriesOccurrence(series *models.EventSeries, index int, start time.Time) models.Event {
+	var endAt *time.Time
+	if series.DurationMinutes != nil {
+		end := start.Add(time.Duration(*series.DurationMinutes) * time.Minute).UTC()
+		endAt = &end
+	}
+
+	seriesID := series.ID
+	occurrenceIndex := index
+
+	return models.Event{
+		OrganizerID:     series.OrganizerID,
+		Type:            series.Type,
+		Title:           series.Title,
+		Description:     series.Description,
+		Sport:           series.Sport,
+		StartAt:         start.UTC(),
+		EndAt:           endAt,
+		Capacity:        series.Capacity,
+		LocationName:    series.LocationName,
+		Latitude:        series.Latitude,
+		Longitude:       series.Longitude,
+		VenueID:         series.VenueID,
+		Status:          string(types.CalculateEventStatus(start, endAt)),
+		Visibility:      series.Visibility,
+		MinReliability:  series.MinReliability,
+		JoinMode:        series.JoinMode,
+		SeriesID:        &seriesID,
+		OccurrenceIndex: &occurrenceIndex,
+	}
+}
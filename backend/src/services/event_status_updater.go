@@ -70,6 +70,16 @@ func (esu *EventStatusUpdater) updateEventStatuses() {
 	if err := esu.updateActiveToComplete(now); err != nil {
 		log.Printf("Error updating active events to complete: %v", err)
 	}
+
+	// Flag participants who never checked in to completed events
+	if err := MarkNoShows(esu.db); err != nil {
+		log.Printf("Error marking no-shows: %v", err)
+	}
+
+	// Confirm game results that went undisputed
+	if err := ConfirmStaleResults(esu.db); err != nil {
+		log.Printf("Error confirming game results: %v", err)
+	}
 }
 
 // updateUpcomingToActive transitions upcoming events to active when their start time has passed
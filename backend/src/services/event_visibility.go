@@ -0,0 +1,76 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+
+	"gorm.io/gorm"
+)
+
+// VisibleEventsScope limits an events query to the events the user is allowed to see:
+// public events, their own events, followers-only events of people they follow, and any
+// event they participate in, are waitlisted for or hold a non-declined invitation to.
+// Events of a private group are further limited to the group's members and the organizer, and
+// events of organizers blocked either way are left out. Events of a private account are only
+// shown to its followers and to users it took part in or invited.
+func VisibleEventsScope(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(BlockedUsersScope(userID, "events.organizer_id"))
+		db = db.Where(privateAccountsCondition("events.organizer_id")+`
+			OR EXISTS (SELECT 1 FROM event_participants p WHERE p.event_id = events.id AND p.user_id = ?)
+			OR EXISTS (SELECT 1 FROM event_invitations i WHERE i.event_id = events.id AND i.invitee_id = ? AND i.status <> ?)`,
+			userID, userID,
+			userID,
+			userID, types.InvitationStatusDeclined,
+		)
+		db = db.Where(`(events.group_id IS NULL
+			OR events.organizer_id = ?
+			OR events.group_id NOT IN (SELECT id FROM groups WHERE visibility = ?)
+			OR events.group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))`,
+			userID,
+			types.GroupVisibilityPrivate,
+			userID,
+		)
+		return db.Where(`events.visibility = ?
+			OR events.organizer_id = ?
+			OR (events.visibility = ? AND events.organizer_id IN (SELECT followed_id FROM follows WHERE follower_id = ?))
+			OR EXISTS (SELECT 1 FROM event_participants p WHERE p.event_id = events.id AND p.user_id = ?)
+			OR EXISTS (SELECT 1 FROM event_waitlist_entries w WHERE w.event_id = events.id AND w.user_id = ?)
+			OR EXISTS (SELECT 1 FROM event_invitations i WHERE i.event_id = events.id AND i.invitee_id = ? AND i.status <> ?)`,
+			types.EventVisibilityPublic,
+			userID,
+			types.EventVisibilityFollowers, userID,
+			userID,
+			userID,
+			userID, types.InvitationStatusDeclined,
+		)
+	}
+}
+
+// CanViewEvent reports whether the user is allowed to see (and therefore join) the event
+func CanViewEvent(db *gorm.DB, event *models.Event, userID uint) bool {
+	if event.OrganizerID == userID {
+		return true
+	}
+	if IsBlockedBetween(db, event.OrganizerID, userID) {
+		return false
+	}
+	if event.GroupID == nil && (event.Visibility == "" || event.Visibility == string(types.EventVisibilityPublic)) &&
+		CanSeePrivateContent(db, userID, event.OrganizerID) {
+		return true
+	}
+
+	var count int64
+	db.Model(&models.Event{}).Scopes(VisibleEventsScope(userID)).Where("events.id = ?", event.ID).Count(&count)
+	return count > 0
+}
+
+// InvitationStatusFor returns the user's invitation status for the event, or nil if not invited
+func InvitationStatusFor(db *gorm.DB, eventID, userID uint) *types.InvitationStatus {
+	var invitation models.EventInvitation
+	if err := db.Select("status").Where("event_id = ? AND invitee_id = ?", eventID, userID).First(&invitation).Error; err != nil {
+		return nil
+	}
+	status := types.InvitationStatus(invitation.Status)
+	return &status
+}
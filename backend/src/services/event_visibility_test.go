@@ -0,0 +1,123 @@
+package services
+
+import (
+	"backend/src/models"
+	"strings"
+	"testing"
+	"unicode"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB returns a database handle that only builds SQL, without connecting
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run database: %v", err)
+	}
+	return db
+}
+
+// ungroupedOr reports whether the WHERE clause of the query has an OR outside any
+// parentheses, which would let a single condition bypass every other one
+func ungroupedOr(sql string) bool {
+	where := strings.Index(sql, " WHERE ")
+	if where < 0 {
+		return false
+	}
+	sql = sql[where+len(" WHERE "):]
+
+	depth := 0
+	for i := 0; i < len(sql); i++ {
+		switch sql[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case 'O':
+			if depth == 0 && strings.HasPrefix(sql[i:], "OR") &&
+				i > 0 && unicode.IsSpace(rune(sql[i-1])) &&
+				i+2 < len(sql) && unicode.IsSpace(rune(sql[i+2])) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestVisibilityScopesGroupConditions(t *testing.T) {
+	db := dryRunDB(t)
+
+	tests := []struct {
+		name  string
+		query func(db *gorm.DB) *gorm.DB
+	}{
+		{
+			name: "events",
+			query: func(db *gorm.DB) *gorm.DB {
+				var events []models.Event
+				return db.Scopes(VisibleEventsScope(7)).Find(&events)
+			},
+		},
+		{
+			name: "events with further conditions",
+			query: func(db *gorm.DB) *gorm.DB {
+				var events []models.Event
+				return db.Scopes(VisibleEventsScope(7), HiddenUsersScope(7, "events.organizer_id")).
+					Where("events.status = ?", "upcoming").
+					Find(&events)
+			},
+		},
+		{
+			name: "event count",
+			query: func(db *gorm.DB) *gorm.DB {
+				var count int64
+				return db.Model(&models.Event{}).Scopes(VisibleEventsScope(7)).Where("events.id = ?", 1).Count(&count)
+			},
+		},
+		{
+			name: "posts",
+			query: func(db *gorm.DB) *gorm.DB {
+				var posts []models.Post
+				return db.Scopes(VisiblePostsScope(7)).Find(&posts)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := tt.query(db.Session(&gorm.Session{})).Statement.SQL.String()
+			if !strings.Contains(sql, " WHERE ") {
+				t.Fatalf("query has no WHERE clause: %s", sql)
+			}
+			if ungroupedOr(sql) {
+				t.Errorf("WHERE clause has an OR outside parentheses:\n%s", sql)
+			}
+		})
+	}
+}
+
+func TestUngroupedOr(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want bool
+	}{
+		{sql: `SELECT * FROM "events" WHERE a = $1 AND b = $2`, want: false},
+		{sql: `SELECT * FROM "events" WHERE (a = $1 OR b = $2) AND c = $3`, want: false},
+		{sql: "SELECT * FROM \"events\" WHERE (a = $1\n\t\tOR b = $2) AND c = $3", want: false},
+		{sql: "SELECT * FROM \"events\" WHERE a = $1\n\t\tOR b = $2 AND c = $3", want: true},
+		{sql: `SELECT * FROM "events" WHERE (a = $1) OR (b = $2)`, want: true},
+		{sql: `SELECT * FROM "events" WHERE sport = 'ORIENTEERING'`, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := ungroupedOr(tt.sql); got != tt.want {
+			t.Errorf("ungroupedOr(%q) = %v, want %v", tt.sql, got, tt.want)
+		}
+	}
+}
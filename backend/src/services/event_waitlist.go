@@ -0,0 +1,240 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAlreadyParticipating = errors.New("user is already participating in this event")
+	ErrAlreadyWaitlisted    = errors.New("user is already on the waitlist for this event")
+	ErrNotParticipating     = errors.New("user is not participating in this event")
+	ErrNotWaitlisted        = errors.New("user is not on the waitlist for this event")
+)
+
+// JoinOrWaitlist adds the user as a participant, or appends them to the waitlist
+// when the event is at capacity (or others are already queued).
+// The event row is locked for the duration so concurrent joins cannot overfill it.
+// Exactly one of the returned participant/entry is non-nil on success.
+func JoinOrWaitlist(db *gorm.DB, eventID, userID uint) (*models.EventParticipant, *models.EventWaitlistEntry, error) {
+	var participant *models.EventParticipant
+	var entry *models.EventWaitlistEntry
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var event models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, eventID).Error; err != nil {
+			return err
+		}
+
+		var existing int64
+		tx.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", eventID, userID).Count(&existing)
+		if existing > 0 {
+			return ErrAlreadyParticipating
+		}
+		tx.Model(&models.EventWaitlistEntry{}).Where("event_id = ? AND user_id = ?", eventID, userID).Count(&existing)
+		if existing > 0 {
+			return ErrAlreadyWaitlisted
+		}
+
+		if event.Capacity != nil {
+			var current, queued int64
+			tx.Model(&models.EventParticipant{}).Where("event_id = ?", eventID).Count(&current)
+			tx.Model(&models.EventWaitlistEntry{}).Where("event_id = ?", eventID).Count(&queued)
+
+			// Don't let newcomers jump the queue if people are already waiting
+			if current >= int64(*event.Capacity) || queued > 0 {
+				entry = &models.EventWaitlistEntry{
+					EventID:  eventID,
+					UserID:   userID,
+					Position: int(queued) + 1,
+				}
+				return tx.Create(entry).Error
+			}
+		}
+
+		participant = &models.EventParticipant{
+			EventID:  eventID,
+			UserID:   userID,
+			Role:     "participant",
+			JoinedAt: time.Now(),
+		}
+		return tx.Create(participant).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return participant, entry, nil
+}
+
+// RemoveParticipant deletes the user's participation and, in the same transaction,
+// promotes waitlisted users into the freed spot. Promoted users are notified.
+func RemoveParticipant(db *gorm.DB, eventID, userID uint) ([]models.EventParticipant, error) {
+	var event models.Event
+	var promoted []models.EventParticipant
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, eventID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventParticipant{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotParticipating
+		}
+
+		var err error
+		promoted, err = promoteWaitlisted(tx, &event)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyPromoted(db, &event, promoted)
+	return promoted, nil
+}
+
+// PromoteFromWaitlist fills any free spots of the event from its waitlist, in queue order.
+// Used when capacity is raised; promoted users are notified.
+func PromoteFromWaitlist(db *gorm.DB, eventID uint) ([]models.EventParticipant, error) {
+	var event models.Event
+	var promoted []models.EventParticipant
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, eventID).Error; err != nil {
+			return err
+		}
+
+		var err error
+		promoted, err = promoteWaitlisted(tx, &event)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyPromoted(db, &event, promoted)
+	return promoted, nil
+}
+
+// LeaveWaitlist removes the user from the event's waitlist and closes the gap in positions
+func LeaveWaitlist(db *gorm.DB, eventID, userID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.Event{}, eventID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&models.EventWaitlistEntry{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotWaitlisted
+		}
+
+		return compactWaitlist(tx, eventID)
+	})
+}
+
+// WaitlistPosition returns the user's 1-based waitlist position, or nil if not waitlisted
+func WaitlistPosition(db *gorm.DB, eventID, userID uint) *int {
+	var entry models.EventWaitlistEntry
+	if err := db.Select("position").Where("event_id = ? AND user_id = ?", eventID, userID).First(&entry).Error; err != nil {
+		return nil
+	}
+	return &entry.Position
+}
+
+// promoteWaitlisted moves as many users as there are free spots from the head of the queue
+// into the participant list. The caller must hold a lock on the event row.
+func promoteWaitlisted(tx *gorm.DB, event *models.Event) ([]models.EventParticipant, error) {
+	// Only upcoming events accept new participants
+	if event.Status != string(types.EventStatusUpcoming) {
+		return nil, nil
+	}
+
+	query := tx.Where("event_id = ?", event.ID).Order("position ASC")
+	if event.Capacity != nil {
+		var current int64
+		tx.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Count(&current)
+		free := int64(*event.Capacity) - current
+		if free <= 0 {
+			return nil, nil
+		}
+		query = query.Limit(int(free))
+	}
+
+	var entries []models.EventWaitlistEntry
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	promoted := make([]models.EventParticipant, 0, len(entries))
+	entryIDs := make([]uint, 0, len(entries))
+	for _, e := range entries {
+		promoted = append(promoted, models.EventParticipant{
+			EventID:  event.ID,
+			UserID:   e.UserID,
+			Role:     "participant",
+			JoinedAt: time.Now(),
+		})
+		entryIDs = append(entryIDs, e.ID)
+	}
+
+	if err := tx.Create(&promoted).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Where("id IN ?", entryIDs).Delete(&models.EventWaitlistEntry{}).Error; err != nil {
+		return nil, err
+	}
+	if err := compactWaitlist(tx, event.ID); err != nil {
+		return nil, err
+	}
+
+	return promoted, nil
+}
+
+// compactWaitlist renumbers the remaining entries of an event to 1..n, keeping their order
+func compactWaitlist(tx *gorm.DB, eventID uint) error {
+	return tx.Exec(`
+		UPDATE event_waitlist_entries AS w
+		SET position = r.rn
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS rn
+			FROM event_waitlist_entries
+			WHERE event_id = ?
+		) AS r
+		WHERE w.id = r.id AND w.position <> r.rn`, eventID).Error
+}
+
+// notifyPromoted tells each promoted user that they got a spot
+func notifyPromoted(db *gorm.DB, event *models.Event, promoted []models.EventParticipant) {
+	for _, p := range promoted {
+		payload := types.JSON{
+			"title":       "A spot opened up - you're in!",
+			"body":        event.Title,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", event.ID),
+		}
+		notif := models.Notification{UserID: p.UserID, ActorID: &event.OrganizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := db.Create(&notif).Error; err != nil {
+			log.Printf("Failed to notify user %d about waitlist promotion for event %d: %v", p.UserID, event.ID, err)
+			continue
+		}
+		GetNotificationHub().Publish(notif)
+	}
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrFollowRequestPending = errors.New("follow request already pending")
+	ErrNoFollowRequest      = errors.New("no pending follow request")
+)
+
+// RequestToFollow files a request to follow a private account and notifies its owner
+func RequestToFollow(db *gorm.DB, requesterID, targetID uint) error {
+	request := models.FollowRequest{RequesterID: requesterID, TargetID: targetID}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&request)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrFollowRequestPending
+	}
+
+	notifyFollowRequest(db, requesterID, targetID, fmt.Sprintf("%s asked to follow you", userDisplayName(db, requesterID)))
+	return nil
+}
+
+// ApproveFollowRequest turns a pending request into a follow and notifies the requester
+func ApproveFollowRequest(db *gorm.DB, targetID, requesterID uint) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := deleteFollowRequest(tx, requesterID, targetID); err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.Follow{FollowerID: requesterID, FollowedID: targetID}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	notifyFollowRequest(db, targetID, requesterID, fmt.Sprintf("%s accepted your follow request", userDisplayName(db, targetID)))
+	return nil
+}
+
+// DenyFollowRequest drops a pending request. The requester is not told.
+func DenyFollowRequest(db *gorm.DB, targetID, requesterID uint) error {
+	return deleteFollowRequest(db, requesterID, targetID)
+}
+
+// CancelFollowRequest withdraws the requester's own pending request
+func CancelFollowRequest(db *gorm.DB, requesterID, targetID uint) error {
+	return deleteFollowRequest(db, requesterID, targetID)
+}
+
+// ApproveAllFollowRequests accepts every pending request, used when an account goes public
+func ApproveAllFollowRequests(db *gorm.DB, targetID uint) error {
+	var requesterIDs []uint
+	db.Model(&models.FollowRequest{}).Where("target_id = ?", targetID).Pluck("requester_id", &requesterIDs)
+	for _, requesterID := range requesterIDs {
+		if err := ApproveFollowRequest(db, targetID, requesterID); err != nil && !errors.Is(err, ErrNoFollowRequest) {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasPendingFollowRequest reports whether the requester is waiting for the target's approval
+func HasPendingFollowRequest(db *gorm.DB, requesterID, targetID uint) bool {
+	var count int64
+	db.Model(&models.FollowRequest{}).Where("requester_id = ? AND target_id = ?", requesterID, targetID).Count(&count)
+	return count > 0
+}
+
+// CanSeePrivateContent reports whether the viewer may see the owner's posts, events and full
+// profile: always for public accounts, and for private ones only for the owner and approved
+// followers
+func CanSeePrivateContent(db *gorm.DB, viewerID, ownerID uint) bool {
+	if viewerID == ownerID {
+		return true
+	}
+	var owner models.User
+	if err := db.Select("id", "is_private").First(&owner, ownerID).Error; err != nil || !owner.IsPrivate {
+		return true
+	}
+	var count int64
+	db.Model(&models.Follow{}).Where("follower_id = ? AND followed_id = ?", viewerID, ownerID).Count(&count)
+	return count > 0
+}
+
+// PrivateAccountsScope leaves out rows whose column holds a private account the given user
+// neither is nor follows
+func PrivateAccountsScope(userID uint, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(privateAccountsCondition(column), userID, userID)
+	}
+}
+
+// privateAccountsCondition is the condition behind PrivateAccountsScope, taking the user ID twice
+func privateAccountsCondition(column string) string {
+	return `(` + column + ` = ?
+		OR ` + column + ` NOT IN (SELECT id FROM users WHERE is_private)
+		OR ` + column + ` IN (SELECT followed_id FROM follows WHERE follower_id = ?))`
+}
+
+// deleteFollowRequest removes a pending request, reporting ErrNoFollowRequest if there is none
+func deleteFollowRequest(db *gorm.DB, requesterID, targetID uint) error {
+	result := db.Where("requester_id = ? AND target_id = ?", requesterID, targetID).Delete(&models.FollowRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoFollowRequest
+	}
+	return nil
+}
+
+// notifyFollowRequest sends a follow request notification pointing at the actor's profile
+func notifyFollowRequest(db *gorm.DB, actorID, userID uint, title string) {
+	notif := models.Notification{
+		UserID:  userID,
+		ActorID: &actorID,
+		Type:    types.NotificationTypeFollow,
+		Payload: types.JSON{
+			"title":       title,
+			"target_type": "user",
+			"target_id":   fmt.Sprintf("%d", actorID),
+		},
+	}
+	if err := db.Create(&notif).Error; err == nil {
+		GetNotificationHub().Publish(notif)
+	}
+}
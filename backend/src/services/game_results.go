@@ -0,0 +1,541 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// resultAutoConfirmAfter is how long a pending result may go without a dispute before it counts
+const resultAutoConfirmAfter = 48 * time.Hour
+
+var (
+	ErrInvalidSides    = errors.New("invalid sides")
+	ErrInvalidScores   = errors.New("invalid scores")
+	ErrResultConfirmed = errors.New("result has already been confirmed")
+	ErrNoResult        = errors.New("no result has been recorded for this event")
+	ErrNotOnASide      = errors.New("user is not playing on a side of this game")
+)
+
+// SetEventSides replaces the sides of a game and assigns participants to them.
+// Any unconfirmed result is discarded because its scores refer to the old sides.
+func SetEventSides(db *gorm.DB, event *models.Event, sides []types.SideRequest) ([]models.EventSide, error) {
+	if len(sides) < 2 {
+		return nil, fmt.Errorf("%w: a game needs at least two sides", ErrInvalidSides)
+	}
+	if match, _ := isTournamentMatch(db, event.ID); match {
+		return nil, fmt.Errorf("%w: the sides of a tournament match are its entries", ErrInvalidSides)
+	}
+	if isLeagueFixture(db, event.ID) {
+		return nil, fmt.Errorf("%w: the sides of a league fixture are its home and away teams", ErrInvalidSides)
+	}
+
+	assigned := make(map[uint]bool)
+	for _, side := range sides {
+		if strings.TrimSpace(side.Name) == "" || len(side.Name) > 100 {
+			return nil, fmt.Errorf("%w: every side needs a name of at most 100 characters", ErrInvalidSides)
+		}
+		if len(side.UserIDs) == 0 {
+			return nil, fmt.Errorf("%w: side %q has no players", ErrInvalidSides, side.Name)
+		}
+		for _, uid := range side.UserIDs {
+			if assigned[uid] {
+				return nil, fmt.Errorf("%w: user %d is on more than one side", ErrInvalidSides, uid)
+			}
+			assigned[uid] = true
+		}
+	}
+
+	var created []models.EventSide
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var result models.GameResult
+		if err := tx.Where("event_id = ?", event.ID).First(&result).Error; err == nil {
+			if result.Status == string(types.ResultStatusConfirmed) {
+				return ErrResultConfirmed
+			}
+			if err := deleteGameResult(tx, result.ID); err != nil {
+				return err
+			}
+		}
+
+		userIDs := make([]uint, 0, len(assigned))
+		for uid := range assigned {
+			userIDs = append(userIDs, uid)
+		}
+		var participating int64
+		tx.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id IN ?", event.ID, userIDs).Count(&participating)
+		if int(participating) != len(userIDs) {
+			return fmt.Errorf("%w: every player must be a participant of the event", ErrInvalidSides)
+		}
+
+		if err := tx.Model(&models.EventParticipant{}).Where("event_id = ?", event.ID).Update("side_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("event_id = ?", event.ID).Delete(&models.EventSide{}).Error; err != nil {
+			return err
+		}
+
+		for i, side := range sides {
+			s := models.EventSide{EventID: event.ID, Name: strings.TrimSpace(side.Name), Position: i + 1}
+			if err := tx.Create(&s).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.EventParticipant{}).
+				Where("event_id = ? AND user_id IN ?", event.ID, side.UserIDs).
+				Update("side_id", s.ID).Error; err != nil {
+				return err
+			}
+			created = append(created, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// RecordResult validates the final scores against the sport's format and stores them as a
+// pending result, replacing any earlier unconfirmed one. Players are asked to confirm it.
+func RecordResult(db *gorm.DB, event *models.Event, recordedByID uint, scores []types.SideScoreRequest) (*models.GameResult, error) {
+	var sides []models.EventSide
+	if err := db.Where("event_id = ?", event.ID).Order("position ASC").Find(&sides).Error; err != nil {
+		return nil, err
+	}
+	if len(sides) < 2 {
+		return nil, fmt.Errorf("%w: split the participants into sides first", ErrInvalidScores)
+	}
+
+	format := types.ScoreFormatForSport(event.Sport)
+	rows, winner, err := scoreResult(format, sides, scores)
+	if err != nil {
+		return nil, err
+	}
+	if _, needsWinner := isTournamentMatch(db, event.ID); needsWinner && winner == nil {
+		return nil, fmt.Errorf("%w: an elimination match needs a winner", ErrInvalidScores)
+	}
+
+	result := models.GameResult{
+		EventID:      event.ID,
+		RecordedByID: recordedByID,
+		Format:       string(format),
+		Status:       string(types.ResultStatusPending),
+		WinnerSideID: winner,
+		Scores:       rows,
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var existing models.GameResult
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ?", event.ID).First(&existing).Error; err == nil {
+			if existing.Status == string(types.ResultStatusConfirmed) {
+				return ErrResultConfirmed
+			}
+			if err := deleteGameResult(tx, existing.ID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(&result).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifySidePlayers(db, event, "Confirm the result of your game")
+	return &result, nil
+}
+
+// RespondToResult records a player's confirmation or dispute. A single dispute marks the
+// result as disputed; it is confirmed once a player of every side has confirmed it.
+func RespondToResult(db *gorm.DB, event *models.Event, userID uint, response types.ResultStatus, comment string) (*models.GameResult, error) {
+	var result models.GameResult
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ?", event.ID).First(&result).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoResult
+			}
+			return err
+		}
+		if result.Status == string(types.ResultStatusConfirmed) {
+			return ErrResultConfirmed
+		}
+
+		var participant models.EventParticipant
+		if err := tx.Where("event_id = ? AND user_id = ? AND side_id IS NOT NULL", event.ID, userID).First(&participant).Error; err != nil {
+			return ErrNotOnASide
+		}
+
+		confirmation := models.GameResultConfirmation{
+			ResultID: result.ID,
+			UserID:   userID,
+			Response: string(response),
+			Comment:  comment,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "result_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"response", "comment", "created_at"}),
+		}).Create(&confirmation).Error; err != nil {
+			return err
+		}
+
+		return updateResultStatus(tx, &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == string(types.ResultStatusDisputed) && response == types.ResultStatusDisputed {
+		payload := types.JSON{
+			"title":       "A game result was disputed",
+			"body":        event.Title,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", event.ID),
+		}
+		notif := models.Notification{UserID: event.OrganizerID, ActorID: &userID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := db.Create(&notif).Error; err == nil {
+			GetNotificationHub().Publish(notif)
+		}
+	}
+
+	return &result, nil
+}
+
+// matchSide is a side of a match event to create, with its players
+type matchSide struct {
+	name    string
+	userIDs []uint
+}
+
+// createMatchEvent creates a game event with the given sides, in order, whose players take
+// part as participants
+func createMatchEvent(tx *gorm.DB, game *models.Event, sides []matchSide) error {
+	if err := tx.Create(game).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i, s := range sides {
+		side := models.EventSide{EventID: game.ID, Name: s.name, Position: i + 1}
+		if err := tx.Create(&side).Error; err != nil {
+			return err
+		}
+		for _, uid := range s.userIDs {
+			participant := models.EventParticipant{
+				EventID:  game.ID,
+				UserID:   uid,
+				Role:     string(types.ParticipantRoleParticipant),
+				SideID:   &side.ID,
+				JoinedAt: now,
+			}
+			if err := tx.Create(&participant).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordFinalResult stores the score of a two-sided game entered by the staff of a tournament
+// or league as a confirmed result, replacing any unconfirmed one. first scores the side at
+// position 1, second the side at position 2.
+func recordFinalResult(db *gorm.DB, eventID, recordedByID uint, first, second types.MatchSideScore, allowDraw bool) (*models.GameResult, error) {
+	var event models.Event
+	if err := db.First(&event, eventID).Error; err != nil {
+		return nil, err
+	}
+	var sides []models.EventSide
+	if err := db.Where("event_id = ?", event.ID).Order("position ASC").Find(&sides).Error; err != nil {
+		return nil, err
+	}
+	if len(sides) != 2 {
+		return nil, fmt.Errorf("%w: the match does not have two sides", ErrInvalidScores)
+	}
+
+	format := types.ScoreFormatForSport(event.Sport)
+	rows, winner, err := scoreResult(format, sides, []types.SideScoreRequest{
+		{SideID: sides[0].ID, Score: first.Score, Sets: first.Sets},
+		{SideID: sides[1].ID, Score: second.Score, Sets: second.Sets},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if winner == nil && !allowDraw {
+		return nil, fmt.Errorf("%w: an elimination match needs a winner", ErrInvalidScores)
+	}
+
+	result := models.GameResult{
+		EventID:      event.ID,
+		RecordedByID: recordedByID,
+		Format:       string(format),
+		Status:       string(types.ResultStatusPending),
+		WinnerSideID: winner,
+		Scores:       rows,
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var existing models.GameResult
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ?", event.ID).First(&existing).Error; err == nil {
+			if existing.Status == string(types.ResultStatusConfirmed) {
+				return ErrResultConfirmed
+			}
+			if err := deleteGameResult(tx, existing.ID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(&result).Error; err != nil {
+			return err
+		}
+		return confirmResult(tx, &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ConfirmStaleResults confirms pending results nobody disputed within the confirmation window
+func ConfirmStaleResults(db *gorm.DB) error {
+	var results []models.GameResult
+	if err := db.Where("status = ? AND created_at <= ?", types.ResultStatusPending, time.Now().Add(-resultAutoConfirmAfter)).Find(&results).Error; err != nil {
+		return err
+	}
+
+	for i := range results {
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			// A dispute may have come in since the results were loaded
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&results[i], results[i].ID).Error; err != nil {
+				return err
+			}
+			if results[i].Status != string(types.ResultStatusPending) {
+				return nil
+			}
+			return confirmResult(tx, &results[i])
+		}); err != nil {
+			log.Printf("Error auto-confirming result %d: %v", results[i].ID, err)
+		}
+	}
+
+	if len(results) > 0 {
+		log.Printf("Auto-confirmed %d game results", len(results))
+	}
+	return nil
+}
+
+// GameRecordFor returns the user's win/loss/draw record over confirmed results
+func GameRecordFor(db *gorm.DB, userID uint) types.GameRecordResponse {
+	var record types.GameRecordResponse
+	db.Raw(`
+		SELECT
+			COUNT(*) AS played,
+			COUNT(*) FILTER (WHERE r.winner_side_id = p.side_id) AS wins,
+			COUNT(*) FILTER (WHERE r.winner_side_id IS NOT NULL AND r.winner_side_id <> p.side_id) AS losses,
+			COUNT(*) FILTER (WHERE r.winner_side_id IS NULL) AS draws
+		FROM event_participants p
+		JOIN game_results r ON r.event_id = p.event_id
+		WHERE p.user_id = ? AND p.side_id IS NOT NULL AND r.status = ?`,
+		userID, types.ResultStatusConfirmed).Scan(&record)
+	return record
+}
+
+// OutcomeFor returns a side's outcome for a result
+func OutcomeFor(result *models.GameResult, sideID uint) types.GameOutcome {
+	switch {
+	case result.WinnerSideID == nil:
+		return types.GameOutcomeDraw
+	case *result.WinnerSideID == sideID:
+		return types.GameOutcomeWin
+	default:
+		return types.GameOutcomeLoss
+	}
+}
+
+// ParseSets converts a stored set list ("6,3,7") back into games per set
+func ParseSets(sets string) []int {
+	if sets == "" {
+		return nil
+	}
+	parts := strings.Split(sets, ",")
+	games := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			continue
+		}
+		games = append(games, n)
+	}
+	return games
+}
+
+// scoreResult checks that every side has exactly one valid score and determines the winner.
+// For the sets format a side's score is the number of sets it won.
+func scoreResult(format types.ScoreFormat, sides []models.EventSide, scores []types.SideScoreRequest) ([]models.GameResultScore, *uint, error) {
+	if len(scores) != len(sides) {
+		return nil, nil, fmt.Errorf("%w: a score is required for each of the %d sides", ErrInvalidScores, len(sides))
+	}
+
+	bySide := make(map[uint]types.SideScoreRequest, len(scores))
+	for _, s := range scores {
+		bySide[s.SideID] = s
+	}
+
+	rows := make([]models.GameResultScore, 0, len(sides))
+	switch format {
+	case types.ScoreFormatSets:
+		setCount := -1
+		for _, side := range sides {
+			s, ok := bySide[side.ID]
+			if !ok {
+				return nil, nil, fmt.Errorf("%w: missing score for side %q", ErrInvalidScores, side.Name)
+			}
+			if len(s.Sets) == 0 || len(s.Sets) > 5 {
+				return nil, nil, fmt.Errorf("%w: between 1 and 5 sets are required", ErrInvalidScores)
+			}
+			if setCount >= 0 && len(s.Sets) != setCount {
+				return nil, nil, fmt.Errorf("%w: every side must have the same number of sets", ErrInvalidScores)
+			}
+			setCount = len(s.Sets)
+		}
+
+		won := make(map[uint]int, len(sides))
+		for set := 0; set < setCount; set++ {
+			var best uint
+			bestGames, tied := -1, false
+			for _, side := range sides {
+				games := bySide[side.ID].Sets[set]
+				if games < 0 {
+					return nil, nil, fmt.Errorf("%w: games cannot be negative", ErrInvalidScores)
+				}
+				switch {
+				case games > bestGames:
+					best, bestGames, tied = side.ID, games, false
+				case games == bestGames:
+					tied = true
+				}
+			}
+			if tied {
+				return nil, nil, fmt.Errorf("%w: set %d has no winner", ErrInvalidScores, set+1)
+			}
+			won[best]++
+		}
+
+		for _, side := range sides {
+			sets := make([]string, 0, setCount)
+			for _, g := range bySide[side.ID].Sets {
+				sets = append(sets, strconv.Itoa(g))
+			}
+			rows = append(rows, models.GameResultScore{SideID: side.ID, Score: won[side.ID], Sets: strings.Join(sets, ",")})
+		}
+	default:
+		for _, side := range sides {
+			s, ok := bySide[side.ID]
+			if !ok || s.Score == nil {
+				return nil, nil, fmt.Errorf("%w: missing score for side %q", ErrInvalidScores, side.Name)
+			}
+			if *s.Score < 0 {
+				return nil, nil, fmt.Errorf("%w: scores cannot be negative", ErrInvalidScores)
+			}
+			rows = append(rows, models.GameResultScore{SideID: side.ID, Score: *s.Score})
+		}
+	}
+
+	// The highest score wins; a shared highest score is a draw
+	winnerIdx, best, tied := -1, -1, false
+	for i := range rows {
+		switch {
+		case rows[i].Score > best:
+			winnerIdx, best, tied = i, rows[i].Score, false
+		case rows[i].Score == best:
+			tied = true
+		}
+	}
+	var winner *uint
+	if !tied && winnerIdx >= 0 {
+		id := rows[winnerIdx].SideID
+		winner = &id
+	}
+
+	return rows, winner, nil
+}
+
+// updateResultStatus recomputes the status of a result from its confirmations
+func updateResultStatus(tx *gorm.DB, result *models.GameResult) error {
+	var disputes int64
+	tx.Model(&models.GameResultConfirmation{}).Where("result_id = ? AND response = ?", result.ID, types.ResultStatusDisputed).Count(&disputes)
+	if disputes > 0 {
+		result.Status = string(types.ResultStatusDisputed)
+		return tx.Model(result).Update("status", result.Status).Error
+	}
+
+	// Sides with no confirming player yet
+	var unconfirmedSides int64
+	tx.Raw(`
+		SELECT COUNT(*) FROM event_sides s
+		WHERE s.event_id = ? AND NOT EXISTS (
+			SELECT 1 FROM game_result_confirmations c
+			JOIN event_participants p ON p.user_id = c.user_id AND p.event_id = s.event_id
+			WHERE c.result_id = ? AND c.response = ? AND p.side_id = s.id
+		)`, result.EventID, result.ID, types.ResultStatusConfirmed).Scan(&unconfirmedSides)
+	if unconfirmedSides == 0 {
+		return confirmResult(tx, result)
+	}
+
+	result.Status = string(types.ResultStatusPending)
+	return tx.Model(result).Update("status", result.Status).Error
+}
+
+// confirmResult marks a result as final, updates the players' ratings and, for a tournament
+// match or league fixture, advances the bracket or updates the standings
+func confirmResult(tx *gorm.DB, result *models.GameResult) error {
+	now := time.Now()
+	result.Status = string(types.ResultStatusConfirmed)
+	result.ConfirmedAt = &now
+	if err := tx.Model(result).Updates(map[string]any{"status": result.Status, "confirmed_at": now}).Error; err != nil {
+		return err
+	}
+	if err := ApplyRatings(tx, result); err != nil {
+		return err
+	}
+	if err := advanceTournamentMatch(tx, result); err != nil {
+		return err
+	}
+	return recordLeagueFixtureResult(tx, result)
+}
+
+// deleteGameResult removes a result together with its scores and confirmations
+func deleteGameResult(tx *gorm.DB, resultID uint) error {
+	if err := tx.Where("result_id = ?", resultID).Delete(&models.GameResultScore{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("result_id = ?", resultID).Delete(&models.GameResultConfirmation{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&models.GameResult{}, resultID).Error
+}
+
+// notifySidePlayers sends a notification to every participant assigned to a side
+func notifySidePlayers(db *gorm.DB, event *models.Event, title string) {
+	var userIDs []uint
+	db.Model(&models.EventParticipant{}).Where("event_id = ? AND side_id IS NOT NULL", event.ID).Pluck("user_id", &userIDs)
+
+	for _, uid := range userIDs {
+		payload := types.JSON{
+			"title":       title,
+			"body":        event.Title,
+			"target_type": "activity",
+			"target_id":   fmt.Sprintf("%d", event.ID),
+		}
+		notif := models.Notification{UserID: uid, ActorID: &event.OrganizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := db.Create(&notif).Error; err != nil {
+			log.Printf("Failed to notify user %d about result of event %d: %v", uid, event.ID, err)
+			continue
+		}
+		GetNotificationHub().Publish(notif)
+	}
+}
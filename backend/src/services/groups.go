@@ -0,0 +1,315 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrAlreadyGroupMember       = errors.New("user is already a member of this group")
+	ErrNotGroupMember           = errors.New("user is not a member of this group")
+	ErrLastGroupAdmin           = errors.New("a group needs at least one admin")
+	ErrGroupRoleTooHigh         = errors.New("moderators can only remove regular members")
+	ErrGroupJoinRequestPending  = errors.New("a request to join this group is already pending")
+	ErrGroupJoinRequestRejected = errors.New("the request to join this group was rejected")
+	ErrNoGroupJoinRequest       = errors.New("no pending request to join this group")
+)
+
+// CreateGroup creates a group with its creator as admin
+func CreateGroup(db *gorm.DB, creatorID uint, req types.CreateGroupRequest) (*models.Group, error) {
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = types.GroupVisibilityPublic
+	}
+	group := models.Group{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Sport:       strings.TrimSpace(req.Sport),
+		City:        strings.TrimSpace(req.City),
+		Visibility:  string(visibility),
+		CreatedByID: creatorID,
+		Members: []models.GroupMember{{
+			UserID:   creatorID,
+			Role:     string(types.GroupRoleAdmin),
+			JoinedAt: time.Now(),
+		}},
+	}
+	if err := db.Create(&group).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GroupRoleFor returns the user's role in the group, or "" if they aren't a member
+func GroupRoleFor(db *gorm.DB, groupID, userID uint) types.GroupRole {
+	var member models.GroupMember
+	if db.Select("role").Where("group_id = ? AND user_id = ?", groupID, userID).Limit(1).Find(&member).RowsAffected == 0 {
+		return ""
+	}
+	return types.GroupRole(member.Role)
+}
+
+// IsGroupMember reports whether the user belongs to the group
+func IsGroupMember(db *gorm.DB, groupID, userID uint) bool {
+	return GroupRoleFor(db, groupID, userID) != ""
+}
+
+// CanModerateGroup reports whether the user is an admin or moderator of the group
+func CanModerateGroup(db *gorm.DB, groupID, userID uint) bool {
+	role := GroupRoleFor(db, groupID, userID)
+	return role == types.GroupRoleAdmin || role == types.GroupRoleModerator
+}
+
+// CanViewGroupContent reports whether the user may see the group's posts, events and members
+func CanViewGroupContent(db *gorm.DB, group *models.Group, userID uint) bool {
+	return group.Visibility != string(types.GroupVisibilityPrivate) || IsGroupMember(db, group.ID, userID)
+}
+
+// DeleteGroup removes a group with its members and join requests. Its posts and events are
+// kept but no longer belong to a group.
+func DeleteGroup(db *gorm.DB, group *models.Group) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Post{}).Where("group_id = ?", group.ID).Update("group_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Event{}).Where("group_id = ?", group.ID).Update("group_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("group_id = ?", group.ID).Delete(&models.GroupMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("group_id = ?", group.ID).Delete(&models.GroupJoinRequest{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(group).Error
+	})
+}
+
+// JoinGroup adds the user to a public group right away. For a private group it records a
+// pending join request instead and notifies the admins and moderators. Rejected requests
+// can't be renewed. The returned request is nil when the user joined directly.
+func JoinGroup(db *gorm.DB, group *models.Group, userID uint) (*models.GroupJoinRequest, error) {
+	if IsGroupMember(db, group.ID, userID) {
+		return nil, ErrAlreadyGroupMember
+	}
+
+	if group.Visibility != string(types.GroupVisibilityPrivate) {
+		if err := addGroupMember(db, group.ID, userID); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	var request models.GroupJoinRequest
+	err := db.Where("group_id = ? AND user_id = ?", group.ID, userID).First(&request).Error
+	switch {
+	case err == nil && request.Status == string(types.ParticipationStatusPending):
+		return nil, ErrGroupJoinRequestPending
+	case err == nil && request.Status == string(types.ParticipationStatusRejected):
+		return nil, ErrGroupJoinRequestRejected
+	case err == nil:
+		// Accepted earlier but left since: ask again
+		request.Status = string(types.ParticipationStatusPending)
+		request.RespondedByID = nil
+		request.RespondedAt = nil
+		if err := db.Save(&request).Error; err != nil {
+			return nil, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		request = models.GroupJoinRequest{
+			GroupID: group.ID,
+			UserID:  userID,
+			Status:  string(types.ParticipationStatusPending),
+		}
+		if err := db.Create(&request).Error; err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	notifyGroupModerators(db, group, userID, fmt.Sprintf("%s asked to join your group", userDisplayName(db, userID)))
+	return &request, nil
+}
+
+// RespondToGroupJoinRequest accepts or rejects a pending join request and notifies the user
+func RespondToGroupJoinRequest(db *gorm.DB, group *models.Group, actorID, userID uint, accept bool) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var request models.GroupJoinRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("group_id = ? AND user_id = ? AND status = ?", group.ID, userID, types.ParticipationStatusPending).
+			First(&request).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoGroupJoinRequest
+			}
+			return err
+		}
+
+		status := types.ParticipationStatusRejected
+		if accept {
+			status = types.ParticipationStatusAccepted
+			if err := addGroupMember(tx, group.ID, userID); err != nil && !errors.Is(err, ErrAlreadyGroupMember) {
+				return err
+			}
+		}
+		return tx.Model(&request).Updates(map[string]any{
+			"status":          string(status),
+			"responded_by_id": actorID,
+			"responded_at":    time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	title := "Your request to join the group was declined"
+	if accept {
+		title = "Your request was accepted - welcome to the group!"
+	}
+	notifyGroup(db, group, actorID, userID, title)
+	return nil
+}
+
+// CancelGroupJoinRequest withdraws the user's pending request to join the group
+func CancelGroupJoinRequest(db *gorm.DB, groupID, userID uint) error {
+	result := db.Where("group_id = ? AND user_id = ? AND status = ?", groupID, userID, types.ParticipationStatusPending).
+		Delete(&models.GroupJoinRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoGroupJoinRequest
+	}
+	return nil
+}
+
+// SetGroupMemberRole changes a member's role. The last admin can't step down.
+func SetGroupMemberRole(db *gorm.DB, group *models.Group, userID uint, role types.GroupRole) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		member, err := lockGroupMember(tx, group.ID, userID)
+		if err != nil {
+			return err
+		}
+		if role != types.GroupRoleAdmin && member.Role == string(types.GroupRoleAdmin) && isLastGroupAdmin(tx, group.ID) {
+			return ErrLastGroupAdmin
+		}
+		return tx.Model(member).Update("role", string(role)).Error
+	})
+}
+
+// RemoveGroupMember takes a user out of the group. Moderators may only remove regular
+// members; admins anyone. The last admin can't leave; they can promote someone else or
+// delete the group instead.
+func RemoveGroupMember(db *gorm.DB, group *models.Group, actorRole types.GroupRole, userID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		member, err := lockGroupMember(tx, group.ID, userID)
+		if err != nil {
+			return err
+		}
+		if actorRole == types.GroupRoleModerator && member.Role != string(types.GroupRoleMember) {
+			return ErrGroupRoleTooHigh
+		}
+		if member.Role == string(types.GroupRoleAdmin) && isLastGroupAdmin(tx, group.ID) {
+			return ErrLastGroupAdmin
+		}
+		return tx.Delete(member).Error
+	})
+}
+
+// VisiblePostsScope limits a posts query to the posts the user is allowed to see: posts
+// outside any group, posts in public groups and posts in groups the user belongs to. Posts of
+// private accounts are only shown to their followers.
+func VisiblePostsScope(userID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(PrivateAccountsScope(userID, "posts.user_id")).Where(`(posts.group_id IS NULL
+			OR posts.group_id IN (SELECT id FROM groups WHERE visibility = ? AND deleted_at IS NULL)
+			OR posts.group_id IN (SELECT group_id FROM group_members WHERE user_id = ?))`,
+			types.GroupVisibilityPublic,
+			userID,
+		)
+	}
+}
+
+// CanViewPost reports whether the user is allowed to see the post
+func CanViewPost(db *gorm.DB, post *models.Post, userID uint) bool {
+	if post.UserID == userID {
+		return true
+	}
+	if post.GroupID == nil {
+		return CanSeePrivateContent(db, userID, post.UserID)
+	}
+
+	var count int64
+	db.Model(&models.Post{}).Scopes(VisiblePostsScope(userID)).Where("posts.id = ?", post.ID).Count(&count)
+	return count > 0
+}
+
+// addGroupMember adds the user to the group as a regular member
+func addGroupMember(tx *gorm.DB, groupID, userID uint) error {
+	var existing int64
+	tx.Model(&models.GroupMember{}).Where("group_id = ? AND user_id = ?", groupID, userID).Count(&existing)
+	if existing > 0 {
+		return ErrAlreadyGroupMember
+	}
+	return tx.Create(&models.GroupMember{
+		GroupID:  groupID,
+		UserID:   userID,
+		Role:     string(types.GroupRoleMember),
+		JoinedAt: time.Now(),
+	}).Error
+}
+
+// lockGroupMember loads a member of the group for update
+func lockGroupMember(tx *gorm.DB, groupID, userID uint) (*models.GroupMember, error) {
+	var member models.GroupMember
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("group_id = ? AND user_id = ?", groupID, userID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotGroupMember
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+// isLastGroupAdmin reports whether the group has a single admin left
+func isLastGroupAdmin(tx *gorm.DB, groupID uint) bool {
+	var admins int64
+	tx.Model(&models.GroupMember{}).Where("group_id = ? AND role = ?", groupID, types.GroupRoleAdmin).Count(&admins)
+	return admins <= 1
+}
+
+// notifyGroupModerators sends a notification about the group to its admins and moderators
+func notifyGroupModerators(db *gorm.DB, group *models.Group, actorID uint, title string) {
+	var userIDs []uint
+	db.Model(&models.GroupMember{}).Where("group_id = ? AND role IN ?", group.ID, []types.GroupRole{types.GroupRoleAdmin, types.GroupRoleModerator}).
+		Pluck("user_id", &userIDs)
+	for _, uid := range userIDs {
+		if uid != actorID {
+			notifyGroup(db, group, actorID, uid, title)
+		}
+	}
+}
+
+// notifyGroup sends a notification pointing at the group
+func notifyGroup(db *gorm.DB, group *models.Group, actorID, userID uint, title string) {
+	payload := types.JSON{
+		"title":       title,
+		"body":        group.Name,
+		"target_type": "group",
+		"target_id":   fmt.Sprintf("%d", group.ID),
+	}
+	notif := models.Notification{UserID: userID, ActorID: &actorID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+	if err := db.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about group %d: %v", userID, group.ID, err)
+		return
+	}
+	GetNotificationHub().Publish(notif)
+}
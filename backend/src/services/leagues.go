@@ -0,0 +1,505 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultLeagueLegs             = 2
+	defaultMatchIntervalDays      = 7
+	defaultFixtureDurationMinutes = 90
+	defaultPointsWin              = 3
+	defaultPointsDraw             = 1
+)
+
+var (
+	ErrLeagueTeamExists    = errors.New("a team with this name already plays in the league")
+	ErrInvalidLeagueTeam   = errors.New("invalid league team")
+	ErrAlreadyInLeagueTeam = errors.New("player already plays for a team in this league")
+	ErrInvalidSeason       = errors.New("invalid season")
+	ErrFixturesGenerated   = errors.New("fixtures have already been generated for this season")
+)
+
+// CreateLeagueTeam enters a team into the league with the captain and the given players
+func CreateLeagueTeam(db *gorm.DB, league *models.League, captainID uint, name string, userIDs []uint) (*models.LeagueTeam, error) {
+	name = strings.TrimSpace(name)
+	players := []uint{captainID}
+	seen := map[uint]bool{captainID: true}
+	for _, uid := range userIDs {
+		if !seen[uid] {
+			seen[uid] = true
+			players = append(players, uid)
+		}
+	}
+
+	var users int64
+	db.Model(&models.User{}).Where("id IN ?", players).Count(&users)
+	if int(users) != len(players) {
+		return nil, fmt.Errorf("%w: unknown player", ErrInvalidLeagueTeam)
+	}
+
+	team := models.LeagueTeam{LeagueID: league.ID, Name: name, CaptainID: captainID}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		tx.Model(&models.LeagueTeam{}).Where("league_id = ? AND LOWER(name) = LOWER(?)", league.ID, name).Count(&existing)
+		if existing > 0 {
+			return ErrLeagueTeamExists
+		}
+		if playsInLeague(tx, league.ID, players) {
+			return ErrAlreadyInLeagueTeam
+		}
+
+		now := time.Now()
+		for _, uid := range players {
+			team.Members = append(team.Members, models.LeagueTeamMember{UserID: uid, JoinedAt: now})
+		}
+		return tx.Create(&team).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// AddLeagueTeamMember adds a player to a team and to the team's upcoming fixtures
+func AddLeagueTeamMember(db *gorm.DB, team *models.LeagueTeam, userID uint) error {
+	var user models.User
+	if err := db.Select("id").First(&user, userID).Error; err != nil {
+		return fmt.Errorf("%w: unknown player", ErrInvalidLeagueTeam)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if playsInLeague(tx, team.LeagueID, []uint{userID}) {
+			return ErrAlreadyInLeagueTeam
+		}
+		member := models.LeagueTeamMember{TeamID: team.ID, UserID: userID, JoinedAt: time.Now()}
+		if err := tx.Create(&member).Error; err != nil {
+			return err
+		}
+
+		sides, err := upcomingFixtureSides(tx, team.ID)
+		if err != nil {
+			return err
+		}
+		for _, side := range sides {
+			var joined int64
+			tx.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", side.EventID, userID).Count(&joined)
+			if joined > 0 {
+				continue
+			}
+			participant := models.EventParticipant{
+				EventID:  side.EventID,
+				UserID:   userID,
+				Role:     string(types.ParticipantRoleParticipant),
+				SideID:   &side.ID,
+				JoinedAt: member.JoinedAt,
+			}
+			if err := tx.Create(&participant).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveLeagueTeamMember removes a player from a team and from the team's upcoming fixtures.
+// The captain cannot be removed.
+func RemoveLeagueTeamMember(db *gorm.DB, team *models.LeagueTeam, userID uint) error {
+	if userID == team.CaptainID {
+		return fmt.Errorf("%w: the captain cannot leave the team", ErrInvalidLeagueTeam)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ? AND user_id = ?", team.ID, userID).Delete(&models.LeagueTeamMember{}).Error; err != nil {
+			return err
+		}
+
+		sides, err := upcomingFixtureSides(tx, team.ID)
+		if err != nil {
+			return err
+		}
+		for _, side := range sides {
+			if err := tx.Where("event_id = ? AND user_id = ? AND side_id = ?", side.EventID, userID, side.ID).
+				Delete(&models.EventParticipant{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CreateLeagueSeason creates a draft season for teams of the league
+func CreateLeagueSeason(db *gorm.DB, league *models.League, req types.CreateLeagueSeasonRequest) (*models.LeagueSeason, error) {
+	season := models.LeagueSeason{
+		LeagueID:             league.ID,
+		Name:                 strings.TrimSpace(req.Name),
+		StartAt:              req.StartAt,
+		Legs:                 defaultLeagueLegs,
+		MatchIntervalDays:    defaultMatchIntervalDays,
+		MatchDurationMinutes: defaultFixtureDurationMinutes,
+		PointsWin:            defaultPointsWin,
+		PointsDraw:           defaultPointsDraw,
+		Status:               string(types.LeagueSeasonDraft),
+	}
+	if req.Legs > 0 {
+		season.Legs = req.Legs
+	}
+	if req.MatchIntervalDays > 0 {
+		season.MatchIntervalDays = req.MatchIntervalDays
+	}
+	if req.MatchDurationMinutes > 0 {
+		season.MatchDurationMinutes = req.MatchDurationMinutes
+	}
+	if req.PointsWin != nil {
+		season.PointsWin = *req.PointsWin
+	}
+	if req.PointsDraw != nil {
+		season.PointsDraw = *req.PointsDraw
+	}
+	if season.PointsDraw > season.PointsWin {
+		return nil, fmt.Errorf("%w: a draw cannot be worth more than a win", ErrInvalidSeason)
+	}
+
+	if err := db.Where("league_id = ? AND id IN ?", league.ID, req.TeamIDs).Find(&season.Teams).Error; err != nil {
+		return nil, err
+	}
+	distinct := make(map[uint]bool, len(req.TeamIDs))
+	for _, id := range req.TeamIDs {
+		distinct[id] = true
+	}
+	if len(season.Teams) != len(distinct) {
+		return nil, fmt.Errorf("%w: every team must belong to the league", ErrInvalidSeason)
+	}
+	if len(season.Teams) < 2 {
+		return nil, fmt.Errorf("%w: a season needs at least two teams", ErrInvalidSeason)
+	}
+
+	if err := db.Create(&season).Error; err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+// GenerateFixtures draws the fixtures of a draft season: every team meets every other team
+// once per leg, with home and away swapped in the second leg. Each fixture is a game event at
+// the league's ground, with the home and away teams as sides, and matchdays are
+// MatchIntervalDays apart in the league's time zone. The players are notified.
+func GenerateFixtures(db *gorm.DB, league *models.League, season *models.LeagueSeason) ([]models.LeagueFixture, error) {
+	var fixtures []models.LeagueFixture
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(season, season.ID).Error; err != nil {
+			return err
+		}
+		if season.Status != string(types.LeagueSeasonDraft) {
+			return ErrFixturesGenerated
+		}
+
+		var teams []models.LeagueTeam
+		if err := tx.Preload("Members").
+			Joins("JOIN league_season_teams st ON st.league_team_id = league_teams.id").
+			Where("st.league_season_id = ?", season.ID).
+			Order("league_teams.id ASC").Find(&teams).Error; err != nil {
+			return err
+		}
+		if len(teams) < 2 {
+			return fmt.Errorf("%w: a season needs at least two teams", ErrInvalidSeason)
+		}
+
+		loc, err := time.LoadLocation(league.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		kickoff := season.StartAt.In(loc)
+		duration := time.Duration(season.MatchDurationMinutes) * time.Minute
+
+		rounds := roundRobinPairings(len(teams))
+		for leg := 0; leg < season.Legs; leg++ {
+			for r, pairs := range rounds {
+				matchday := leg*len(rounds) + r + 1
+				startAt := kickoff.AddDate(0, 0, (matchday-1)*season.MatchIntervalDays)
+				endAt := startAt.Add(duration)
+				for _, pair := range pairs {
+					home, away := teams[pair[0]], teams[pair[1]]
+					if leg%2 == 1 {
+						home, away = away, home
+					}
+
+					game := models.Event{
+						OrganizerID:  league.OrganizerID,
+						Type:         string(types.EventTypeGame),
+						Title:        fmt.Sprintf("%s vs %s", home.Name, away.Name),
+						Description:  fmt.Sprintf("%s – %s, matchday %d", league.Name, season.Name, matchday),
+						Sport:        league.Sport,
+						StartAt:      startAt,
+						EndAt:        &endAt,
+						LocationName: league.LocationName,
+						Latitude:     league.Latitude,
+						Longitude:    league.Longitude,
+						VenueID:      league.VenueID,
+						Visibility:   string(types.EventVisibilityPublic),
+						JoinMode:     string(types.JoinModeApproval),
+					}
+					if err := createMatchEvent(tx, &game, []matchSide{teamSide(home), teamSide(away)}); err != nil {
+						return err
+					}
+
+					fixtures = append(fixtures, models.LeagueFixture{
+						SeasonID:   season.ID,
+						Matchday:   matchday,
+						HomeTeamID: home.ID,
+						AwayTeamID: away.ID,
+						EventID:    game.ID,
+						Status:     string(types.LeagueFixtureScheduled),
+					})
+				}
+			}
+		}
+		if err := tx.Create(&fixtures).Error; err != nil {
+			return err
+		}
+
+		season.Status = string(types.LeagueSeasonActive)
+		return tx.Model(season).Update("status", season.Status).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifySeasonPlayers(db, league, season, "Fixtures published")
+	return fixtures, nil
+}
+
+// RecordFixtureResult enters the final score of a fixture. Scores entered by the league staff
+// are final and count towards the standings right away.
+func RecordFixtureResult(db *gorm.DB, fixture *models.LeagueFixture, recordedByID uint, req types.FixtureResultRequest) (*models.GameResult, error) {
+	if fixture.Status == string(types.LeagueFixtureComplete) {
+		return nil, ErrResultConfirmed
+	}
+	return recordFinalResult(db, fixture.EventID, recordedByID, req.Home, req.Away, true)
+}
+
+// LeagueStandings computes the table of a season from its played fixtures. Teams are ranked
+// by points; teams level on points are separated by the points, goal difference and goals
+// they got in the fixtures between them, then by overall goal difference and goals scored.
+func LeagueStandings(season *models.LeagueSeason, teams []models.LeagueTeam, fixtures []models.LeagueFixture) []types.LeagueStandingResponse {
+	standings := make([]types.LeagueStandingResponse, len(teams))
+	rows := make(map[uint]*types.LeagueStandingResponse, len(teams))
+	for i, team := range teams {
+		standings[i] = types.LeagueStandingResponse{TeamID: team.ID, Name: team.Name}
+		rows[team.ID] = &standings[i]
+	}
+
+	played := make([]models.LeagueFixture, 0, len(fixtures))
+	for _, f := range fixtures {
+		if f.Status != string(types.LeagueFixtureComplete) || f.HomeScore == nil || f.AwayScore == nil {
+			continue
+		}
+		home, away := rows[f.HomeTeamID], rows[f.AwayTeamID]
+		if home == nil || away == nil {
+			continue
+		}
+		tallyFixture(home, *f.HomeScore, *f.AwayScore, season)
+		tallyFixture(away, *f.AwayScore, *f.HomeScore, season)
+		played = append(played, f)
+	}
+
+	overall := func(a, b types.LeagueStandingResponse) bool {
+		if a.GoalDifference != b.GoalDifference {
+			return a.GoalDifference > b.GoalDifference
+		}
+		if a.GoalsFor != b.GoalsFor {
+			return a.GoalsFor > b.GoalsFor
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return overall(standings[i], standings[j])
+	})
+
+	// Break ties on points with a mini table of the fixtures between the tied teams
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && standings[end].Points == standings[start].Points {
+			end++
+		}
+		if end-start > 1 {
+			group := standings[start:end]
+			tied := make(map[uint]*types.LeagueStandingResponse, len(group))
+			mini := make([]types.LeagueStandingResponse, len(group))
+			for i := range group {
+				mini[i].TeamID = group[i].TeamID
+				tied[group[i].TeamID] = &mini[i]
+			}
+			for _, f := range played {
+				home, away := tied[f.HomeTeamID], tied[f.AwayTeamID]
+				if home == nil || away == nil {
+					continue
+				}
+				tallyFixture(home, *f.HomeScore, *f.AwayScore, season)
+				tallyFixture(away, *f.AwayScore, *f.HomeScore, season)
+			}
+			sort.SliceStable(group, func(i, j int) bool {
+				a, b := tied[group[i].TeamID], tied[group[j].TeamID]
+				if a.Points != b.Points {
+					return a.Points > b.Points
+				}
+				if a.GoalDifference != b.GoalDifference {
+					return a.GoalDifference > b.GoalDifference
+				}
+				if a.GoalsFor != b.GoalsFor {
+					return a.GoalsFor > b.GoalsFor
+				}
+				return overall(group[i], group[j])
+			})
+		}
+		start = end
+	}
+
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
+
+// recordLeagueFixtureResult stores the score of a confirmed result on the fixture played in
+// its event, if any, and completes the season once every fixture has been played
+func recordLeagueFixtureResult(tx *gorm.DB, result *models.GameResult) error {
+	var fixture models.LeagueFixture
+	if err := tx.Where("event_id = ?", result.EventID).Limit(1).Find(&fixture).Error; err != nil || fixture.ID == 0 {
+		return err
+	}
+	if fixture.Status == string(types.LeagueFixtureComplete) {
+		return nil
+	}
+
+	var sides []models.EventSide
+	if err := tx.Where("event_id = ?", result.EventID).Find(&sides).Error; err != nil {
+		return err
+	}
+	var scores []models.GameResultScore
+	if err := tx.Where("result_id = ?", result.ID).Find(&scores).Error; err != nil {
+		return err
+	}
+	for _, s := range scores {
+		score := s.Score
+		for _, side := range sides {
+			if side.ID != s.SideID {
+				continue
+			}
+			switch side.Position {
+			case 1:
+				fixture.HomeScore = &score
+			case 2:
+				fixture.AwayScore = &score
+			}
+		}
+	}
+
+	now := time.Now()
+	fixture.Status = string(types.LeagueFixtureComplete)
+	fixture.CompletedAt = &now
+	if err := tx.Save(&fixture).Error; err != nil {
+		return err
+	}
+
+	var remaining int64
+	tx.Model(&models.LeagueFixture{}).Where("season_id = ? AND status = ?", fixture.SeasonID, types.LeagueFixtureScheduled).Count(&remaining)
+	if remaining > 0 {
+		return nil
+	}
+	return tx.Model(&models.LeagueSeason{}).Where("id = ?", fixture.SeasonID).Update("status", types.LeagueSeasonComplete).Error
+}
+
+// isLeagueFixture reports whether the event is a fixture of a league season
+func isLeagueFixture(db *gorm.DB, eventID uint) bool {
+	var fixtures int64
+	db.Model(&models.LeagueFixture{}).Where("event_id = ?", eventID).Count(&fixtures)
+	return fixtures > 0
+}
+
+// playsInLeague reports whether any of the users is a member of a team in the league
+func playsInLeague(db *gorm.DB, leagueID uint, userIDs []uint) bool {
+	var members int64
+	db.Model(&models.LeagueTeamMember{}).
+		Joins("JOIN league_teams ON league_teams.id = league_team_members.team_id").
+		Where("league_teams.league_id = ? AND league_team_members.user_id IN ?", leagueID, userIDs).
+		Count(&members)
+	return members > 0
+}
+
+// upcomingFixtureSides returns the team's sides in its fixtures that haven't started yet
+func upcomingFixtureSides(tx *gorm.DB, teamID uint) ([]models.EventSide, error) {
+	var sides []models.EventSide
+	err := tx.Table("event_sides").
+		Select("event_sides.*").
+		Joins("JOIN league_fixtures f ON f.event_id = event_sides.event_id").
+		Joins("JOIN events e ON e.id = f.event_id AND e.deleted_at IS NULL").
+		Where("f.status = ? AND e.status = ?", types.LeagueFixtureScheduled, types.EventStatusUpcoming).
+		Where("(f.home_team_id = ? AND event_sides.position = 1) OR (f.away_team_id = ? AND event_sides.position = 2)", teamID, teamID).
+		Find(&sides).Error
+	return sides, err
+}
+
+// teamSide turns a league team into a side of a fixture; its Members must be loaded
+func teamSide(team models.LeagueTeam) matchSide {
+	side := matchSide{name: team.Name}
+	for _, member := range team.Members {
+		side.userIDs = append(side.userIDs, member.UserID)
+	}
+	return side
+}
+
+// tallyFixture adds a played fixture to a team's standing
+func tallyFixture(row *types.LeagueStandingResponse, scored, conceded int, season *models.LeagueSeason) {
+	row.Played++
+	row.GoalsFor += scored
+	row.GoalsAgainst += conceded
+	row.GoalDifference = row.GoalsFor - row.GoalsAgainst
+	switch {
+	case scored > conceded:
+		row.Wins++
+		row.Points += season.PointsWin
+	case scored == conceded:
+		row.Draws++
+		row.Points += season.PointsDraw
+	default:
+		row.Losses++
+	}
+}
+
+// notifySeasonPlayers sends one notification to every player of the season's teams
+func notifySeasonPlayers(db *gorm.DB, league *models.League, season *models.LeagueSeason, title string) {
+	var userIDs []uint
+	db.Model(&models.LeagueTeamMember{}).
+		Joins("JOIN league_season_teams st ON st.league_team_id = league_team_members.team_id").
+		Where("st.league_season_id = ?", season.ID).
+		Distinct().Pluck("league_team_members.user_id", &userIDs)
+
+	for _, uid := range userIDs {
+		payload := types.JSON{
+			"title":       title,
+			"body":        fmt.Sprintf("%s – %s", league.Name, season.Name),
+			"target_type": "league",
+			"target_id":   fmt.Sprintf("%d", league.ID),
+		}
+		notif := models.Notification{UserID: uid, ActorID: &league.OrganizerID, Type: types.NotificationTypeSystem, Payload: payload, Read: false}
+		if err := db.Create(&notif).Error; err != nil {
+			log.Printf("Failed to notify user %d about season %d: %v", uid, season.ID, err)
+			continue
+		}
+		GetNotificationHub().Publish(notif)
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"testing"
+)
+
+func TestLeagueStandings(t *testing.T) {
+	season := &models.LeagueSeason{PointsWin: 3, PointsDraw: 1}
+	teams := []models.LeagueTeam{
+		{ID: 1, Name: "Alpha"},
+		{ID: 2, Name: "Bravo"},
+		{ID: 3, Name: "Charlie"},
+		{ID: 4, Name: "Delta"},
+	}
+
+	played := func(home, away uint, homeScore, awayScore int) models.LeagueFixture {
+		return models.LeagueFixture{
+			HomeTeamID: home,
+			AwayTeamID: away,
+			Status:     string(types.LeagueFixtureComplete),
+			HomeScore:  &homeScore,
+			AwayScore:  &awayScore,
+		}
+	}
+	scheduled := func(home, away uint) models.LeagueFixture {
+		return models.LeagueFixture{HomeTeamID: home, AwayTeamID: away, Status: string(types.LeagueFixtureScheduled)}
+	}
+
+	tests := []struct {
+		name     string
+		fixtures []models.LeagueFixture
+		want     []uint
+		points   []int
+	}{
+		{
+			name:     "no fixtures played sorts by name",
+			fixtures: []models.LeagueFixture{scheduled(4, 1), scheduled(2, 3)},
+			want:     []uint{1, 2, 3, 4},
+			points:   []int{0, 0, 0, 0},
+		},
+		{
+			name: "points rank before goal difference",
+			fixtures: []models.LeagueFixture{
+				played(1, 3, 1, 0),
+				played(1, 4, 0, 0),
+				played(2, 4, 9, 0),
+				scheduled(1, 2),
+			},
+			want:   []uint{1, 2, 4, 3},
+			points: []int{4, 3, 1, 0},
+		},
+		{
+			name: "head-to-head decides a tie between two teams over goal difference",
+			fixtures: []models.LeagueFixture{
+				played(1, 2, 1, 0),
+				played(2, 3, 8, 0),
+				played(3, 1, 1, 0),
+				played(4, 3, 0, 1),
+			},
+			want:   []uint{3, 1, 2, 4},
+			points: []int{6, 3, 3, 0},
+		},
+		{
+			name: "mini table goal difference decides a three-way tie",
+			fixtures: []models.LeagueFixture{
+				played(1, 2, 3, 0),
+				played(2, 3, 1, 0),
+				played(3, 1, 1, 0),
+				played(1, 4, 1, 0),
+				played(2, 4, 9, 0),
+				played(3, 4, 2, 0),
+			},
+			// Overall goal difference would put Bravo (+7) ahead of Alpha (+3) and Charlie (+2)
+			want:   []uint{1, 3, 2, 4},
+			points: []int{6, 6, 6, 0},
+		},
+		{
+			name: "mini table goals scored decides a three-way tie level on mini goal difference",
+			fixtures: []models.LeagueFixture{
+				played(1, 2, 2, 2),
+				played(2, 3, 1, 1),
+				played(3, 1, 0, 0),
+				played(4, 1, 5, 0),
+				played(4, 2, 5, 0),
+				played(4, 3, 5, 0),
+			},
+			want:   []uint{4, 2, 1, 3},
+			points: []int{9, 2, 2, 2},
+		},
+		{
+			name: "overall goal difference decides a three-way tie level in the mini table",
+			fixtures: []models.LeagueFixture{
+				played(1, 2, 1, 0),
+				played(2, 3, 1, 0),
+				played(3, 1, 1, 0),
+				played(1, 4, 1, 0),
+				played(2, 4, 3, 0),
+				played(3, 4, 2, 0),
+			},
+			want:   []uint{2, 3, 1, 4},
+			points: []int{6, 6, 6, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standings := LeagueStandings(season, teams, tt.fixtures)
+			if len(standings) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d", len(standings), len(tt.want))
+			}
+			for i, row := range standings {
+				if row.TeamID != tt.want[i] || row.Points != tt.points[i] || row.Rank != i+1 {
+					t.Errorf("row %d: got team %d with %d points at rank %d, want team %d with %d points at rank %d",
+						i, row.TeamID, row.Points, row.Rank, tt.want[i], tt.points[i], i+1)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"backend/src/models"
+	"math"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultRating is the rating of a player without rated games in a sport
+	DefaultRating = 1200
+	// ProvisionalGames is the number of rated games before a rating is considered settled
+	ProvisionalGames = 10
+
+	ratingKFactor            = 32
+	provisionalRatingKFactor = 48
+)
+
+// ApplyRatings updates the Elo rating of every player of a confirmed result.
+// A side's strength is the average rating of its players; with more than two sides each
+// side is compared against every other side and the outcomes are averaged. All players of
+// a side move by the same expected/actual difference, scaled by their own K-factor.
+// Safe to call more than once per result: rated results are skipped.
+func ApplyRatings(tx *gorm.DB, result *models.GameResult) error {
+	var rated int64
+	tx.Model(&models.SportRatingHistory{}).Where("result_id = ?", result.ID).Count(&rated)
+	if rated > 0 {
+		return nil
+	}
+
+	var event models.Event
+	if err := tx.Unscoped().Select("id", "sport").First(&event, result.EventID).Error; err != nil {
+		return err
+	}
+	sport := strings.TrimSpace(event.Sport)
+	if sport == "" {
+		return nil
+	}
+
+	var scores []models.GameResultScore
+	if err := tx.Where("result_id = ?", result.ID).Find(&scores).Error; err != nil {
+		return err
+	}
+
+	var players []models.EventParticipant
+	if err := tx.Where("event_id = ? AND side_id IS NOT NULL", result.EventID).Find(&players).Error; err != nil {
+		return err
+	}
+
+	userIDs := make([]uint, 0, len(players))
+	for _, p := range players {
+		userIDs = append(userIDs, p.UserID)
+	}
+	ratings, err := lockRatings(tx, userIDs, sport)
+	if err != nil {
+		return err
+	}
+
+	// Average rating per side
+	sideTotal := make(map[uint]float64)
+	sideCount := make(map[uint]int)
+	for _, p := range players {
+		sideTotal[*p.SideID] += ratings[p.UserID].Rating
+		sideCount[*p.SideID]++
+	}
+
+	// Expected vs. actual score per side, averaged over all opponents
+	performance := make(map[uint]float64)
+	for _, own := range scores {
+		if sideCount[own.SideID] == 0 {
+			continue
+		}
+		ownRating := sideTotal[own.SideID] / float64(sideCount[own.SideID])
+
+		var expected, actual float64
+		opponents := 0
+		for _, other := range scores {
+			if other.SideID == own.SideID || sideCount[other.SideID] == 0 {
+				continue
+			}
+			otherRating := sideTotal[other.SideID] / float64(sideCount[other.SideID])
+			expected += 1 / (1 + math.Pow(10, (otherRating-ownRating)/400))
+			switch {
+			case own.Score > other.Score:
+				actual += 1
+			case own.Score == other.Score:
+				actual += 0.5
+			}
+			opponents++
+		}
+		if opponents > 0 {
+			performance[own.SideID] = (actual - expected) / float64(opponents)
+		}
+	}
+
+	for _, p := range players {
+		diff, ok := performance[*p.SideID]
+		if !ok {
+			continue
+		}
+
+		rating := ratings[p.UserID]
+		k := float64(ratingKFactor)
+		if rating.GamesPlayed < ProvisionalGames {
+			k = provisionalRatingKFactor
+		}
+		before := rating.Rating
+		rating.Rating = before + k*diff
+		rating.GamesPlayed++
+
+		if err := tx.Save(rating).Error; err != nil {
+			return err
+		}
+		history := models.SportRatingHistory{
+			UserID:       p.UserID,
+			Sport:        sport,
+			EventID:      result.EventID,
+			ResultID:     result.ID,
+			RatingBefore: before,
+			RatingAfter:  rating.Rating,
+		}
+		if err := tx.Create(&history).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RatingFor returns the user's rating in a sport, or DefaultRating if they have none
+func RatingFor(db *gorm.DB, userID uint, sport string) float64 {
+	var rating models.SportRating
+	if err := db.Where("user_id = ? AND sport = ?", userID, sport).First(&rating).Error; err != nil {
+		return DefaultRating
+	}
+	return rating.Rating
+}
+
+// lockRatings loads (creating where missing) and locks the ratings of the given users in a sport
+func lockRatings(tx *gorm.DB, userIDs []uint, sport string) (map[uint]*models.SportRating, error) {
+	ratings := make(map[uint]*models.SportRating, len(userIDs))
+	if len(userIDs) == 0 {
+		return ratings, nil
+	}
+
+	missing := make([]models.SportRating, 0, len(userIDs))
+	for _, uid := range userIDs {
+		missing = append(missing, models.SportRating{UserID: uid, Sport: sport, Rating: DefaultRating})
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&missing).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []models.SportRating
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id IN ? AND sport = ?", userIDs, sport).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		ratings[rows[i].UserID] = &rows[i]
+	}
+	return ratings, nil
+}
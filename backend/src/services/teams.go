@@ -0,0 +1,546 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Reasons a team member is left out when the team signs up for an event
+const (
+	TeamSkipOrganizer      = "organizer"
+	TeamSkipAlreadyJoined  = "already_joined"
+	TeamSkipBanned         = "banned"
+	TeamSkipBlocked        = "blocked"
+	TeamSkipNotVisible     = "not_visible"
+	TeamSkipLowReliability = "low_reliability"
+)
+
+var (
+	ErrAlreadyTeamMember       = errors.New("user is already on this team")
+	ErrNotTeamMember           = errors.New("user is not on this team")
+	ErrLastCaptain             = errors.New("a team needs at least one captain")
+	ErrTeamJoinRequestPending  = errors.New("a request to join this team is already pending")
+	ErrTeamJoinRequestRejected = errors.New("the request to join this team was rejected")
+	ErrNoTeamJoinRequest       = errors.New("no pending request to join this team")
+	ErrNoTeamInvitation        = errors.New("no pending invitation to this team")
+	ErrTeamNeedsApproval       = errors.New("the event requires approval and the captain has not been invited")
+	ErrEventNotUpcoming        = errors.New("event is not upcoming")
+	ErrNoEligibleTeamMembers   = errors.New("no team member can join this event")
+	ErrTeamDoesNotFit          = errors.New("not enough free spots for the whole team")
+	ErrTeamNotRegistered       = errors.New("the team is not signed up for this event")
+)
+
+// TeamSkip is a team member left out of an event sign-up, with the reason
+type TeamSkip struct {
+	UserID uint
+	Reason string
+}
+
+// CreateTeam creates a team with its creator as captain
+func CreateTeam(db *gorm.DB, creatorID uint, req types.CreateTeamRequest) (*models.Team, error) {
+	team := models.Team{
+		Name:        strings.TrimSpace(req.Name),
+		Sport:       strings.TrimSpace(req.Sport),
+		Description: req.Description,
+		CreatedByID: creatorID,
+		Members: []models.TeamMember{{
+			UserID:   creatorID,
+			Role:     string(types.TeamRoleCaptain),
+			JoinedAt: time.Now(),
+		}},
+	}
+	if err := db.Create(&team).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// TeamRoleFor returns the user's role on the team, or "" if they aren't on it
+func TeamRoleFor(db *gorm.DB, teamID, userID uint) types.TeamRole {
+	var member models.TeamMember
+	if db.Select("role").Where("team_id = ? AND user_id = ?", teamID, userID).Limit(1).Find(&member).RowsAffected == 0 {
+		return ""
+	}
+	return types.TeamRole(member.Role)
+}
+
+// IsTeamCaptain reports whether the user captains the team
+func IsTeamCaptain(db *gorm.DB, teamID, userID uint) bool {
+	return TeamRoleFor(db, teamID, userID) == types.TeamRoleCaptain
+}
+
+// DeleteTeam removes a team with its roster, invitations and join requests. Event sign-ups
+// already made stay; the players remain participants.
+func DeleteTeam(db *gorm.DB, team *models.Team) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ?", team.ID).Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id = ?", team.ID).Delete(&models.TeamInvitation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("team_id = ?", team.ID).Delete(&models.TeamJoinRequest{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(team).Error
+	})
+}
+
+// InviteToTeam invites users who aren't on the team yet and notifies them. Declined
+// invitations are re-opened; pending ones are left as they are.
+func InviteToTeam(db *gorm.DB, team *models.Team, inviterID uint, userIDs []uint) ([]models.TeamInvitation, error) {
+	var invitees []models.User
+	db.Where("id IN ?", userIDs).
+		Where("id NOT IN (?)", db.Model(&models.TeamMember{}).Select("user_id").Where("team_id = ?", team.ID)).
+		Find(&invitees)
+	if len(invitees) == 0 {
+		return nil, nil
+	}
+
+	invitations := make([]models.TeamInvitation, 0, len(invitees))
+	for _, u := range invitees {
+		invitations = append(invitations, models.TeamInvitation{
+			TeamID:    team.ID,
+			InviteeID: u.ID,
+			InviterID: inviterID,
+			Status:    string(types.InvitationStatusPending),
+		})
+	}
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "team_id"}, {Name: "invitee_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"status": types.InvitationStatusPending, "inviter_id": inviterID, "responded_at": nil, "updated_at": time.Now()}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Neq{Column: "team_invitations.status", Value: types.InvitationStatusPending}}},
+	}).Create(&invitations).Error; err != nil {
+		return nil, err
+	}
+
+	// Reload to pick up the rows that were already pending
+	var sent []models.TeamInvitation
+	if err := db.Preload("Invitee").Where("team_id = ? AND invitee_id IN ? AND status = ?", team.ID, userIDs, types.InvitationStatusPending).
+		Order("created_at ASC").Find(&sent).Error; err != nil {
+		return nil, err
+	}
+
+	name := userDisplayName(db, inviterID)
+	for _, invitation := range sent {
+		notifyTeam(db, team, inviterID, invitation.InviteeID, types.NotificationTypeInvite, fmt.Sprintf("%s invited you to join a team", name))
+	}
+	return sent, nil
+}
+
+// RespondToTeamInvitation accepts or declines the user's pending invitation. Accepting adds
+// them to the roster and answers any pending join request of theirs.
+func RespondToTeamInvitation(db *gorm.DB, team *models.Team, userID uint, accept bool) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var invitation models.TeamInvitation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("team_id = ? AND invitee_id = ? AND status = ?", team.ID, userID, types.InvitationStatusPending).
+			First(&invitation).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoTeamInvitation
+			}
+			return err
+		}
+
+		status := types.InvitationStatusDeclined
+		if accept {
+			status = types.InvitationStatusAccepted
+			if err := addTeamMember(tx, team.ID, userID); err != nil && !errors.Is(err, ErrAlreadyTeamMember) {
+				return err
+			}
+			if err := tx.Model(&models.TeamJoinRequest{}).
+				Where("team_id = ? AND user_id = ? AND status = ?", team.ID, userID, types.ParticipationStatusPending).
+				Updates(map[string]any{"status": string(types.ParticipationStatusAccepted), "responded_by_id": invitation.InviterID, "responded_at": time.Now()}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&invitation).Updates(map[string]any{"status": string(status), "responded_at": time.Now()}).Error
+	})
+	if err != nil || !accept {
+		return err
+	}
+
+	notifyCaptains(db, team, userID, fmt.Sprintf("%s joined your team", userDisplayName(db, userID)))
+	return nil
+}
+
+// RequestToJoinTeam records a pending request to join the team and notifies its captains.
+// A user with a pending invitation joins right away instead. Rejected requests can't be
+// renewed; the captains can still invite the user.
+func RequestToJoinTeam(db *gorm.DB, team *models.Team, userID uint) (*models.TeamJoinRequest, bool, error) {
+	if TeamRoleFor(db, team.ID, userID) != "" {
+		return nil, false, ErrAlreadyTeamMember
+	}
+
+	var invited int64
+	db.Model(&models.TeamInvitation{}).Where("team_id = ? AND invitee_id = ? AND status = ?", team.ID, userID, types.InvitationStatusPending).Count(&invited)
+	if invited > 0 {
+		return nil, true, RespondToTeamInvitation(db, team, userID, true)
+	}
+
+	var request models.TeamJoinRequest
+	err := db.Where("team_id = ? AND user_id = ?", team.ID, userID).First(&request).Error
+	switch {
+	case err == nil && request.Status == string(types.ParticipationStatusPending):
+		return nil, false, ErrTeamJoinRequestPending
+	case err == nil && request.Status == string(types.ParticipationStatusRejected):
+		return nil, false, ErrTeamJoinRequestRejected
+	case err == nil:
+		// Accepted earlier but left since: ask again
+		request.Status = string(types.ParticipationStatusPending)
+		request.RespondedByID = nil
+		request.RespondedAt = nil
+		if err := db.Save(&request).Error; err != nil {
+			return nil, false, err
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		request = models.TeamJoinRequest{
+			TeamID: team.ID,
+			UserID: userID,
+			Status: string(types.ParticipationStatusPending),
+		}
+		if err := db.Create(&request).Error; err != nil {
+			return nil, false, err
+		}
+	default:
+		return nil, false, err
+	}
+
+	notifyCaptains(db, team, userID, fmt.Sprintf("%s asked to join your team", userDisplayName(db, userID)))
+	return &request, false, nil
+}
+
+// RespondToTeamJoinRequest accepts or rejects a pending join request and notifies the user
+func RespondToTeamJoinRequest(db *gorm.DB, team *models.Team, actorID, userID uint, accept bool) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var request models.TeamJoinRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("team_id = ? AND user_id = ? AND status = ?", team.ID, userID, types.ParticipationStatusPending).
+			First(&request).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNoTeamJoinRequest
+			}
+			return err
+		}
+
+		status := types.ParticipationStatusRejected
+		if accept {
+			status = types.ParticipationStatusAccepted
+			if err := addTeamMember(tx, team.ID, userID); err != nil && !errors.Is(err, ErrAlreadyTeamMember) {
+				return err
+			}
+		}
+		return tx.Model(&request).Updates(map[string]any{
+			"status":          string(status),
+			"responded_by_id": actorID,
+			"responded_at":    time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	title := "Your request to join the team was declined"
+	if accept {
+		title = "Your request was accepted - welcome to the team!"
+	}
+	notifyTeam(db, team, actorID, userID, types.NotificationTypeSystem, title)
+	return nil
+}
+
+// CancelTeamJoinRequest withdraws the user's pending request to join the team
+func CancelTeamJoinRequest(db *gorm.DB, teamID, userID uint) error {
+	result := db.Where("team_id = ? AND user_id = ? AND status = ?", teamID, userID, types.ParticipationStatusPending).
+		Delete(&models.TeamJoinRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoTeamJoinRequest
+	}
+	return nil
+}
+
+// SetTeamMemberRole makes a player captain or member. The last captain can't step down.
+func SetTeamMemberRole(db *gorm.DB, team *models.Team, userID uint, role types.TeamRole) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		member, err := lockTeamMember(tx, team.ID, userID)
+		if err != nil {
+			return err
+		}
+		if role == types.TeamRoleMember && member.Role == string(types.TeamRoleCaptain) && isLastCaptain(tx, team.ID) {
+			return ErrLastCaptain
+		}
+		return tx.Model(member).Update("role", string(role)).Error
+	})
+}
+
+// RemoveTeamMember takes a player off the roster. The last captain can't leave; they can
+// hand the captaincy over or delete the team instead.
+func RemoveTeamMember(db *gorm.DB, team *models.Team, userID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		member, err := lockTeamMember(tx, team.ID, userID)
+		if err != nil {
+			return err
+		}
+		if member.Role == string(types.TeamRoleCaptain) && isLastCaptain(tx, team.ID) {
+			return ErrLastCaptain
+		}
+		return tx.Delete(member).Error
+	})
+}
+
+// RegisterTeamForEvent signs the whole team up for an event in one go. Members who are the
+// organizer, already take part or are waitlisted, are banned, are blocked either way by the
+// organizer, or fall below the event's reliability threshold are skipped. Everyone else joins
+// together or not at all: if the event can't take all of them (or others are already
+// waitlisted), nobody joins.
+// Approval-required events only take teams whose captain was invited.
+func RegisterTeamForEvent(db *gorm.DB, event *models.Event, team *models.Team, actorID uint) ([]models.EventParticipant, []TeamSkip, error) {
+	if event.JoinMode == string(types.JoinModeApproval) {
+		invitation := InvitationStatusFor(db, event.ID, actorID)
+		if invitation == nil || *invitation == types.InvitationStatusDeclined {
+			return nil, nil, ErrTeamNeedsApproval
+		}
+	}
+
+	var members []models.TeamMember
+	if err := db.Where("team_id = ?", team.ID).Order("joined_at ASC").Find(&members).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var joined []models.EventParticipant
+	var skipped []TeamSkip
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var locked models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&locked, event.ID).Error; err != nil {
+			return err
+		}
+		if locked.Status != string(types.EventStatusUpcoming) {
+			return ErrEventNotUpcoming
+		}
+
+		skipped = nil
+		now := time.Now()
+		joining := make([]models.EventParticipant, 0, len(members))
+		for _, m := range members {
+			if reason := teamSkipReason(tx, &locked, m.UserID); reason != "" {
+				skipped = append(skipped, TeamSkip{UserID: m.UserID, Reason: reason})
+				continue
+			}
+			joining = append(joining, models.EventParticipant{
+				EventID:  locked.ID,
+				UserID:   m.UserID,
+				Role:     string(types.ParticipantRoleParticipant),
+				TeamID:   &team.ID,
+				JoinedAt: now,
+			})
+		}
+		if len(joining) == 0 {
+			return ErrNoEligibleTeamMembers
+		}
+
+		if locked.Capacity != nil {
+			var current, queued int64
+			tx.Model(&models.EventParticipant{}).Where("event_id = ?", locked.ID).Count(&current)
+			tx.Model(&models.EventWaitlistEntry{}).Where("event_id = ?", locked.ID).Count(&queued)
+			if queued > 0 || current+int64(len(joining)) > int64(*locked.Capacity) {
+				return ErrTeamDoesNotFit
+			}
+		}
+
+		if err := tx.Create(&joining).Error; err != nil {
+			return err
+		}
+
+		// Signing up also answers the players' open invitations and join requests
+		userIDs := make([]uint, 0, len(joining))
+		for _, p := range joining {
+			userIDs = append(userIDs, p.UserID)
+		}
+		if err := tx.Model(&models.EventInvitation{}).
+			Where("event_id = ? AND invitee_id IN ? AND status <> ?", locked.ID, userIDs, types.InvitationStatusAccepted).
+			Updates(map[string]any{"status": types.InvitationStatusAccepted, "responded_at": now}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.EventJoinRequest{}).
+			Where("event_id = ? AND user_id IN ? AND status = ?", locked.ID, userIDs, types.ParticipationStatusPending).
+			Updates(map[string]any{"status": types.ParticipationStatusAccepted, "responded_by_id": locked.OrganizerID, "responded_at": now}).Error; err != nil {
+			return err
+		}
+
+		joined = joining
+		return nil
+	})
+	if err != nil {
+		return nil, skipped, err
+	}
+
+	for _, p := range joined {
+		if p.UserID == actorID {
+			continue
+		}
+		notifyTeamEvent(db, event, actorID, p.UserID, fmt.Sprintf("%s signed you up for an activity", team.Name))
+	}
+	notifyTeamEvent(db, event, actorID, event.OrganizerID, fmt.Sprintf("%s joined your activity", team.Name))
+
+	return joined, skipped, nil
+}
+
+// WithdrawTeamFromEvent removes every player the team signed up for the event and hands the
+// freed spots to the waitlist. Promoted users are notified.
+func WithdrawTeamFromEvent(db *gorm.DB, eventID, teamID uint) ([]models.EventParticipant, error) {
+	var event models.Event
+	var promoted []models.EventParticipant
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, eventID).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("event_id = ? AND team_id = ?", eventID, teamID).Delete(&models.EventParticipant{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTeamNotRegistered
+		}
+
+		var err error
+		promoted, err = promoteWaitlisted(tx, &event)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyPromoted(db, &event, promoted)
+	return promoted, nil
+}
+
+// teamSkipReason tells why a team member can't be signed up for the event, or "" if they can
+func teamSkipReason(tx *gorm.DB, event *models.Event, userID uint) string {
+	if userID == event.OrganizerID {
+		return TeamSkipOrganizer
+	}
+
+	var existing int64
+	tx.Model(&models.EventParticipant{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&existing)
+	if existing == 0 {
+		tx.Model(&models.EventWaitlistEntry{}).Where("event_id = ? AND user_id = ?", event.ID, userID).Count(&existing)
+	}
+	if existing > 0 {
+		return TeamSkipAlreadyJoined
+	}
+
+	if IsBannedFromEvent(tx, event.ID, userID) {
+		return TeamSkipBanned
+	}
+	if IsBlockedBetween(tx, event.OrganizerID, userID) {
+		return TeamSkipBlocked
+	}
+	if !CanViewEvent(tx, event, userID) {
+		return TeamSkipNotVisible
+	}
+	if event.MinReliability != nil {
+		if reliability, _ := ReliabilityFor(tx, userID); reliability != nil && *reliability < *event.MinReliability {
+			return TeamSkipLowReliability
+		}
+	}
+	return ""
+}
+
+// addTeamMember puts the user on the roster as a member
+func addTeamMember(tx *gorm.DB, teamID, userID uint) error {
+	var existing int64
+	tx.Model(&models.TeamMember{}).Where("team_id = ? AND user_id = ?", teamID, userID).Count(&existing)
+	if existing > 0 {
+		return ErrAlreadyTeamMember
+	}
+	return tx.Create(&models.TeamMember{
+		TeamID:   teamID,
+		UserID:   userID,
+		Role:     string(types.TeamRoleMember),
+		JoinedAt: time.Now(),
+	}).Error
+}
+
+// lockTeamMember loads a player of the team for update
+func lockTeamMember(tx *gorm.DB, teamID, userID uint) (*models.TeamMember, error) {
+	var member models.TeamMember
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("team_id = ? AND user_id = ?", teamID, userID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotTeamMember
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+// isLastCaptain reports whether the team has a single captain left
+func isLastCaptain(tx *gorm.DB, teamID uint) bool {
+	var captains int64
+	tx.Model(&models.TeamMember{}).Where("team_id = ? AND role = ?", teamID, types.TeamRoleCaptain).Count(&captains)
+	return captains <= 1
+}
+
+// userDisplayName returns the user's display name, falling back to the username
+func userDisplayName(db *gorm.DB, userID uint) string {
+	var user models.User
+	_ = db.Select("username, display_name").First(&user, userID).Error
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.Username
+}
+
+// notifyCaptains sends a notification about the team to each of its captains
+func notifyCaptains(db *gorm.DB, team *models.Team, actorID uint, title string) {
+	var captainIDs []uint
+	db.Model(&models.TeamMember{}).Where("team_id = ? AND role = ?", team.ID, types.TeamRoleCaptain).Pluck("user_id", &captainIDs)
+	for _, uid := range captainIDs {
+		if uid != actorID {
+			notifyTeam(db, team, actorID, uid, types.NotificationTypeSystem, title)
+		}
+	}
+}
+
+// notifyTeam sends a notification pointing at the team
+func notifyTeam(db *gorm.DB, team *models.Team, actorID, userID uint, kind types.NotificationType, title string) {
+	payload := types.JSON{
+		"title":       title,
+		"body":        team.Name,
+		"target_type": "team",
+		"target_id":   fmt.Sprintf("%d", team.ID),
+	}
+	notif := models.Notification{UserID: userID, ActorID: &actorID, Type: kind, Payload: payload, Read: false}
+	if err := db.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about team %d: %v", userID, team.ID, err)
+		return
+	}
+	GetNotificationHub().Publish(notif)
+}
+
+// notifyTeamEvent sends a notification about a team sign-up pointing at the event
+func notifyTeamEvent(db *gorm.DB, event *models.Event, actorID, userID uint, title string) {
+	payload := types.JSON{
+		"title":       title,
+		"body":        event.Title,
+		"target_type": "activity",
+		"target_id":   fmt.Sprintf("%d", event.ID),
+	}
+	notif := models.Notification{UserID: userID, ActorID: &actorID, Type: types.NotificationTypeMessage, Payload: payload, Read: false}
+	if err := db.Create(&notif).Error; err != nil {
+		log.Printf("Failed to notify user %d about a team sign-up for event %d: %v", userID, event.ID, err)
+		return
+	}
+	GetNotificationHub().Publish(notif)
+}
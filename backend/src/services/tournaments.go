@@ -0,0 +1,853 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultMatchDurationMinutes is the time slot per round of matches when none is given
+const DefaultMatchDurationMinutes = 60
+
+var (
+	ErrTournamentExists   = errors.New("event already has a tournament")
+	ErrRegistrationClosed = errors.New("tournament registration is closed")
+	ErrTournamentFull     = errors.New("tournament is full")
+	ErrInvalidEntry       = errors.New("invalid tournament entry")
+	ErrAlreadyRegistered  = errors.New("player is already registered for this tournament")
+	ErrInvalidSeeds       = errors.New("invalid seeds")
+	ErrNotEnoughEntries   = errors.New("not enough entries to start the tournament")
+	ErrMatchNotScheduled  = errors.New("tournament match is not ready to be played")
+)
+
+// plannedMatch is a match of a bracket being drawn, linked to other planned matches by index (-1 for none)
+type plannedMatch struct {
+	match     models.TournamentMatch
+	next      int
+	nextSlot  int
+	loserNext int
+	loserSlot int
+}
+
+// CreateTournament turns the event into a tournament that is open for registration
+func CreateTournament(db *gorm.DB, event *models.Event, req types.CreateTournamentRequest) (*models.Tournament, error) {
+	tournament := models.Tournament{
+		EventID:              event.ID,
+		Format:               string(req.Format),
+		EntryType:            string(types.TournamentEntryPlayer),
+		TeamSize:             1,
+		MaxEntries:           req.MaxEntries,
+		SeedBy:               string(types.TournamentSeedByRegistration),
+		MatchDurationMinutes: DefaultMatchDurationMinutes,
+		Status:               string(types.TournamentStatusRegistration),
+	}
+	if req.EntryType == types.TournamentEntryTeam {
+		tournament.EntryType = string(req.EntryType)
+		tournament.TeamSize = 2
+		if req.TeamSize > 0 {
+			tournament.TeamSize = req.TeamSize
+		}
+	}
+	if req.SeedBy != "" {
+		tournament.SeedBy = string(req.SeedBy)
+	}
+	if req.MatchDurationMinutes > 0 {
+		tournament.MatchDurationMinutes = req.MatchDurationMinutes
+	}
+
+	var existing int64
+	db.Model(&models.Tournament{}).Where("event_id = ?", event.ID).Count(&existing)
+	if existing > 0 {
+		return nil, ErrTournamentExists
+	}
+	if err := db.Create(&tournament).Error; err != nil {
+		return nil, err
+	}
+	return &tournament, nil
+}
+
+// RegisterEntry registers a player or team while registration is open. Player entries have
+// exactly one player; team entries need a name and exactly TeamSize players.
+func RegisterEntry(db *gorm.DB, tournament *models.Tournament, registeredByID uint, name string, userIDs []uint) (*models.TournamentEntry, error) {
+	seen := make(map[uint]bool, len(userIDs))
+	players := make([]uint, 0, len(userIDs))
+	for _, uid := range userIDs {
+		if !seen[uid] {
+			seen[uid] = true
+			players = append(players, uid)
+		}
+	}
+
+	name = strings.TrimSpace(name)
+	if tournament.EntryType == string(types.TournamentEntryTeam) {
+		if name == "" {
+			return nil, fmt.Errorf("%w: a team needs a name", ErrInvalidEntry)
+		}
+		if len(players) != tournament.TeamSize {
+			return nil, fmt.Errorf("%w: a team needs exactly %d players", ErrInvalidEntry, tournament.TeamSize)
+		}
+	} else if len(players) != 1 {
+		return nil, fmt.Errorf("%w: a player entry has exactly one player", ErrInvalidEntry)
+	}
+
+	var users []models.User
+	if err := db.Where("id IN ?", players).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	if len(users) != len(players) {
+		return nil, fmt.Errorf("%w: unknown player", ErrInvalidEntry)
+	}
+	if name == "" {
+		name = users[0].DisplayName
+		if name == "" {
+			name = users[0].Username
+		}
+	}
+
+	entry := models.TournamentEntry{TournamentID: tournament.ID, Name: name, RegisteredByID: registeredByID}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenTournament(tx, tournament); err != nil {
+			return err
+		}
+
+		if tournament.MaxEntries != nil {
+			var entries int64
+			tx.Model(&models.TournamentEntry{}).Where("tournament_id = ?", tournament.ID).Count(&entries)
+			if int(entries) >= *tournament.MaxEntries {
+				return ErrTournamentFull
+			}
+		}
+
+		var registered int64
+		tx.Model(&models.TournamentEntryMember{}).Where("tournament_id = ? AND user_id IN ?", tournament.ID, players).Count(&registered)
+		if registered > 0 {
+			return ErrAlreadyRegistered
+		}
+
+		for _, uid := range players {
+			entry.Members = append(entry.Members, models.TournamentEntryMember{TournamentID: tournament.ID, UserID: uid})
+		}
+		return tx.Create(&entry).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// WithdrawEntry removes an entry while registration is open
+func WithdrawEntry(db *gorm.DB, tournament *models.Tournament, entryID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenTournament(tx, tournament); err != nil {
+			return err
+		}
+		if err := tx.Where("entry_id = ?", entryID).Delete(&models.TournamentEntryMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.TournamentEntry{}, entryID).Error
+	})
+}
+
+// SetTournamentSeeds seeds every entry in the given order and switches the tournament to manual seeding
+func SetTournamentSeeds(db *gorm.DB, tournament *models.Tournament, entryIDs []uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenTournament(tx, tournament); err != nil {
+			return err
+		}
+
+		var registered []uint
+		if err := tx.Model(&models.TournamentEntry{}).Where("tournament_id = ?", tournament.ID).Pluck("id", &registered).Error; err != nil {
+			return err
+		}
+		remaining := make(map[uint]bool, len(registered))
+		for _, id := range registered {
+			remaining[id] = true
+		}
+		for _, id := range entryIDs {
+			if !remaining[id] {
+				return fmt.Errorf("%w: entry %d is unknown or listed twice", ErrInvalidSeeds, id)
+			}
+			delete(remaining, id)
+		}
+		if len(remaining) > 0 {
+			return fmt.Errorf("%w: every entry must be seeded", ErrInvalidSeeds)
+		}
+
+		for i, id := range entryIDs {
+			if err := tx.Model(&models.TournamentEntry{}).Where("id = ?", id).Update("seed", i+1).Error; err != nil {
+				return err
+			}
+		}
+		tournament.SeedBy = string(types.TournamentSeedManual)
+		return tx.Model(tournament).Update("seed_by", tournament.SeedBy).Error
+	})
+}
+
+// StartTournament closes registration, seeds the entries and draws the bracket. Matches are
+// scheduled one time slot per round from the event's start; those whose entries are known
+// right away get their game event, and byes are played through.
+func StartTournament(db *gorm.DB, tournament *models.Tournament) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := lockOpenTournament(tx, tournament); err != nil {
+			return err
+		}
+
+		var event models.Event
+		if err := tx.First(&event, tournament.EventID).Error; err != nil {
+			return err
+		}
+
+		var entries []models.TournamentEntry
+		if err := tx.Preload("Members").Where("tournament_id = ?", tournament.ID).Order("id ASC").Find(&entries).Error; err != nil {
+			return err
+		}
+		minEntries := 2
+		if tournament.Format == string(types.TournamentDoubleElimination) {
+			minEntries = 3
+		}
+		if len(entries) < minEntries {
+			return fmt.Errorf("%w: a %s tournament needs at least %d entries", ErrNotEnoughEntries, strings.ReplaceAll(tournament.Format, "_", " "), minEntries)
+		}
+
+		seeded := seedEntries(tx, tournament, strings.TrimSpace(event.Sport), entries)
+		for i := range seeded {
+			seed := i + 1
+			seeded[i].Seed = &seed
+			if err := tx.Model(&seeded[i]).Update("seed", seed).Error; err != nil {
+				return err
+			}
+		}
+
+		slot := time.Duration(tournament.MatchDurationMinutes) * time.Minute
+		at := func(stage int) time.Time { return event.StartAt.Add(time.Duration(stage) * slot) }
+		var plan []plannedMatch
+		if tournament.Format == string(types.TournamentRoundRobin) {
+			plan = planRoundRobin(seeded, at)
+		} else {
+			plan = planElimination(seeded, tournament.Format == string(types.TournamentDoubleElimination), at)
+		}
+
+		matches := make([]models.TournamentMatch, len(plan))
+		for i := range plan {
+			matches[i] = plan[i].match
+			matches[i].TournamentID = tournament.ID
+		}
+		if err := tx.Create(&matches).Error; err != nil {
+			return err
+		}
+		for i, p := range plan {
+			if p.next < 0 && p.loserNext < 0 {
+				continue
+			}
+			if p.next >= 0 {
+				matches[i].NextMatchID, matches[i].NextSlot = &matches[p.next].ID, p.nextSlot
+			}
+			if p.loserNext >= 0 {
+				matches[i].LoserNextMatchID, matches[i].LoserNextSlot = &matches[p.loserNext].ID, p.loserSlot
+			}
+			if err := tx.Save(&matches[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		tournament.Status = string(types.TournamentStatusInProgress)
+		if err := tx.Model(tournament).Update("status", tournament.Status).Error; err != nil {
+			return err
+		}
+		return resolveTournament(tx, tournament)
+	})
+}
+
+// RecordTournamentScore enters the final score of a scheduled match. Scores entered by the
+// tournament staff are final: the result is confirmed right away, which advances the bracket.
+func RecordTournamentScore(db *gorm.DB, tournament *models.Tournament, match *models.TournamentMatch, recordedByID uint, req types.TournamentScoreRequest) (*models.GameResult, error) {
+	if match.Status == string(types.TournamentMatchComplete) {
+		return nil, ErrResultConfirmed
+	}
+	if match.Status != string(types.TournamentMatchScheduled) || match.EventID == nil {
+		return nil, ErrMatchNotScheduled
+	}
+
+	allowDraw := tournament.Format == string(types.TournamentRoundRobin)
+	return recordFinalResult(db, *match.EventID, recordedByID, req.Entry1, req.Entry2, allowDraw)
+}
+
+// TournamentStandings ranks the entries of a round robin: 3 points for a win and 1 for a draw,
+// then score difference and score for
+func TournamentStandings(entries []models.TournamentEntry, matches []models.TournamentMatch) []types.TournamentStandingResponse {
+	rows := make(map[uint]*types.TournamentStandingResponse, len(entries))
+	standings := make([]types.TournamentStandingResponse, len(entries))
+	for i, entry := range entries {
+		standings[i] = types.TournamentStandingResponse{EntryID: entry.ID, Name: entry.Name}
+		rows[entry.ID] = &standings[i]
+	}
+
+	for _, m := range matches {
+		if m.Status != string(types.TournamentMatchComplete) || m.Entry1ID == nil || m.Entry2ID == nil || m.Score1 == nil || m.Score2 == nil {
+			continue
+		}
+		home, away := rows[*m.Entry1ID], rows[*m.Entry2ID]
+		if home == nil || away == nil {
+			continue
+		}
+		tallyMatch(home, *m.Score1, *m.Score2)
+		tallyMatch(away, *m.Score2, *m.Score1)
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.ScoreDifference != b.ScoreDifference {
+			return a.ScoreDifference > b.ScoreDifference
+		}
+		return a.ScoreFor > b.ScoreFor
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
+
+// TournamentRoundName returns the display name of a round, given the last round of its bracket
+func TournamentRoundName(format, bracket string, round, lastRound int) string {
+	switch types.TournamentBracket(bracket) {
+	case types.TournamentBracketFinal:
+		if round > 1 {
+			return "Grand final reset"
+		}
+		return "Grand final"
+	case types.TournamentBracketLosers:
+		if round == lastRound {
+			return "Losers final"
+		}
+		return fmt.Sprintf("Losers round %d", round)
+	case types.TournamentBracketWinners:
+		if format == string(types.TournamentDoubleElimination) {
+			if round == lastRound {
+				return "Winners final"
+			}
+			return fmt.Sprintf("Winners round %d", round)
+		}
+		switch lastRound - round {
+		case 0:
+			return "Final"
+		case 1:
+			return "Semifinals"
+		case 2:
+			return "Quarterfinals"
+		}
+	}
+	return fmt.Sprintf("Round %d", round)
+}
+
+// advanceTournamentMatch completes the tournament match played in the result's event, if any,
+// and moves its entries on through the bracket. Called when a result is confirmed.
+func advanceTournamentMatch(tx *gorm.DB, result *models.GameResult) error {
+	var match models.TournamentMatch
+	if err := tx.Where("event_id = ?", result.EventID).Limit(1).Find(&match).Error; err != nil || match.ID == 0 {
+		return err
+	}
+
+	var tournament models.Tournament
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&tournament, match.TournamentID).Error; err != nil {
+		return err
+	}
+	// Reload under the tournament lock, another result may have been processed meanwhile
+	if err := tx.First(&match, match.ID).Error; err != nil {
+		return err
+	}
+	if match.Status != string(types.TournamentMatchScheduled) {
+		return nil
+	}
+	if result.WinnerSideID == nil && tournament.Format != string(types.TournamentRoundRobin) {
+		return nil
+	}
+
+	var sides []models.EventSide
+	if err := tx.Where("event_id = ?", result.EventID).Order("position ASC").Find(&sides).Error; err != nil {
+		return err
+	}
+	var scores []models.GameResultScore
+	if err := tx.Where("result_id = ?", result.ID).Find(&scores).Error; err != nil {
+		return err
+	}
+	for _, s := range scores {
+		score := s.Score
+		for _, side := range sides {
+			if side.ID != s.SideID {
+				continue
+			}
+			switch side.Position {
+			case 1:
+				match.Score1 = &score
+			case 2:
+				match.Score2 = &score
+			}
+		}
+	}
+
+	if result.WinnerSideID != nil {
+		match.WinnerEntryID, match.LoserEntryID = match.Entry2ID, match.Entry1ID
+		for _, side := range sides {
+			if side.ID == *result.WinnerSideID && side.Position == 1 {
+				match.WinnerEntryID, match.LoserEntryID = match.Entry1ID, match.Entry2ID
+			}
+		}
+	}
+
+	now := time.Now()
+	match.Status = string(types.TournamentMatchComplete)
+	match.CompletedAt = &now
+	if err := tx.Save(&match).Error; err != nil {
+		return err
+	}
+	// The winners bracket champion won the grand final without a loss: the reset is not played
+	// and becomes an empty bye
+	if match.Bracket == string(types.TournamentBracketFinal) && match.Round == 1 && sameEntry(match.WinnerEntryID, match.Entry1ID) {
+		return resolveTournament(tx, &tournament)
+	}
+	if err := placeEntry(tx, match.NextMatchID, match.NextSlot, match.WinnerEntryID); err != nil {
+		return err
+	}
+	if err := placeEntry(tx, match.LoserNextMatchID, match.LoserNextSlot, match.LoserEntryID); err != nil {
+		return err
+	}
+
+	return resolveTournament(tx, &tournament)
+}
+
+// isTournamentMatch reports whether the event is a match of a tournament bracket, and whether
+// that match must have a winner (every format except round robin)
+func isTournamentMatch(db *gorm.DB, eventID uint) (match bool, needsWinner bool) {
+	var formats []string
+	db.Model(&models.TournamentMatch{}).
+		Joins("JOIN tournaments ON tournaments.id = tournament_matches.tournament_id").
+		Where("tournament_matches.event_id = ?", eventID).
+		Pluck("tournaments.format", &formats)
+	if len(formats) == 0 {
+		return false, false
+	}
+	return true, formats[0] != string(types.TournamentRoundRobin)
+}
+
+// lockOpenTournament locks the tournament row and checks registration is still open
+func lockOpenTournament(tx *gorm.DB, tournament *models.Tournament) error {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(tournament, tournament.ID).Error; err != nil {
+		return err
+	}
+	if tournament.Status != string(types.TournamentStatusRegistration) {
+		return ErrRegistrationClosed
+	}
+	return nil
+}
+
+// seedEntries orders the entries (given in registration order) by the tournament's seeding rule
+func seedEntries(db *gorm.DB, tournament *models.Tournament, sport string, entries []models.TournamentEntry) []models.TournamentEntry {
+	seeded := append([]models.TournamentEntry(nil), entries...)
+	switch types.TournamentSeeding(tournament.SeedBy) {
+	case types.TournamentSeedByRating:
+		ratings := make(map[uint]float64, len(seeded))
+		for _, entry := range seeded {
+			total := 0.0
+			for _, member := range entry.Members {
+				total += RatingFor(db, member.UserID, sport)
+			}
+			if len(entry.Members) > 0 {
+				ratings[entry.ID] = total / float64(len(entry.Members))
+			}
+		}
+		sort.SliceStable(seeded, func(i, j int) bool { return ratings[seeded[i].ID] > ratings[seeded[j].ID] })
+	case types.TournamentSeedManual:
+		// Entries registered after seeding go last
+		seedOf := func(entry models.TournamentEntry) int {
+			if entry.Seed == nil {
+				return math.MaxInt
+			}
+			return *entry.Seed
+		}
+		sort.SliceStable(seeded, func(i, j int) bool { return seedOf(seeded[i]) < seedOf(seeded[j]) })
+	}
+	return seeded
+}
+
+// planElimination draws a single or double elimination bracket. The bracket is padded to a
+// power of two with byes for the top seeds, and seeds are placed so the best two can only meet
+// in the final. Matches of stage n are scheduled in time slot n. A double elimination bracket
+// ends in a grand final between the winners and losers bracket champions and a reset match,
+// played only if the losers bracket champion wins the first one, so that nobody goes out
+// after a single loss.
+func planElimination(entries []models.TournamentEntry, double bool, at func(stage int) time.Time) []plannedMatch {
+	size := 2
+	for size < len(entries) {
+		size *= 2
+	}
+	rounds := bits.Len(uint(size)) - 1
+
+	var plan []plannedMatch
+	add := func(bracket types.TournamentBracket, round, position, stage int) int {
+		plan = append(plan, plannedMatch{
+			match: models.TournamentMatch{
+				Bracket:     string(bracket),
+				Round:       round,
+				Position:    position,
+				ScheduledAt: at(stage),
+				Status:      string(types.TournamentMatchPending),
+			},
+			next:      -1,
+			loserNext: -1,
+		})
+		return len(plan) - 1
+	}
+	link := func(from, to, slot int) {
+		plan[from].next, plan[from].nextSlot = to, slot
+	}
+	dropTo := func(from, to, slot int) {
+		plan[from].loserNext, plan[from].loserSlot = to, slot
+	}
+
+	// winners[r][p] is the plan index of match p in winners bracket round r+1
+	winners := make([][]int, rounds)
+	for r := 0; r < rounds; r++ {
+		for p := 0; p < size>>(r+1); p++ {
+			winners[r] = append(winners[r], add(types.TournamentBracketWinners, r+1, p, r))
+		}
+	}
+	for r := 0; r+1 < rounds; r++ {
+		for p, idx := range winners[r] {
+			link(idx, winners[r+1][p/2], p%2+1)
+		}
+	}
+
+	order := seedOrder(size)
+	for p, idx := range winners[0] {
+		if seed := order[2*p]; seed <= len(entries) {
+			id := entries[seed-1].ID
+			plan[idx].match.Entry1ID = &id
+		}
+		if seed := order[2*p+1]; seed <= len(entries) {
+			id := entries[seed-1].ID
+			plan[idx].match.Entry2ID = &id
+		}
+	}
+
+	if !double {
+		return plan
+	}
+
+	// Losers bracket: odd rounds pair up the survivors, even rounds play them against the
+	// losers dropping down from the next winners round. Losers round n is played in slot n.
+	losers := make([][]int, 2*(rounds-1))
+	for j := 1; j < rounds; j++ {
+		for _, round := range []int{2*j - 1, 2 * j} {
+			for p := 0; p < size>>(j+1); p++ {
+				losers[round-1] = append(losers[round-1], add(types.TournamentBracketLosers, round, p, round))
+			}
+		}
+	}
+	for p, idx := range winners[0] {
+		dropTo(idx, losers[0][p/2], p%2+1)
+	}
+	for j := 1; j < rounds; j++ {
+		for p, idx := range losers[2*j-2] {
+			link(idx, losers[2*j-1][p], 1)
+		}
+		// Drop losers in reverse order so players don't meet again straight away
+		count := len(winners[j])
+		for p, idx := range winners[j] {
+			dropTo(idx, losers[2*j-1][count-1-p], 2)
+		}
+		if j < rounds-1 {
+			for p, idx := range losers[2*j-1] {
+				link(idx, losers[2*j][p/2], p%2+1)
+			}
+		}
+	}
+
+	final := add(types.TournamentBracketFinal, 1, 0, 2*rounds-1)
+	link(winners[rounds-1][0], final, 1)
+	link(losers[len(losers)-1][0], final, 2)
+	reset := add(types.TournamentBracketFinal, 2, 0, 2*rounds)
+	link(final, reset, 1)
+	dropTo(final, reset, 2)
+	return plan
+}
+
+// planRoundRobin pairs every entry with every other one, one round per time slot
+func planRoundRobin(entries []models.TournamentEntry, at func(stage int) time.Time) []plannedMatch {
+	var plan []plannedMatch
+	for r, pairs := range roundRobinPairings(len(entries)) {
+		for position, pair := range pairs {
+			home, away := entries[pair[0]].ID, entries[pair[1]].ID
+			plan = append(plan, plannedMatch{
+				match: models.TournamentMatch{
+					Bracket:     string(types.TournamentBracketRoundRobin),
+					Round:       r + 1,
+					Position:    position,
+					ScheduledAt: at(r),
+					Entry1ID:    &home,
+					Entry2ID:    &away,
+					Status:      string(types.TournamentMatchPending),
+				},
+				next:      -1,
+				loserNext: -1,
+			})
+		}
+	}
+	return plan
+}
+
+// roundRobinPairings returns the rounds in which n competitors (by index) each meet every
+// other one once, using the circle method. With an odd n one competitor sits out each round.
+// Who plays first in a pairing alternates between rounds so nobody is always listed first.
+func roundRobinPairings(n int) [][][2]int {
+	slots := make([]int, 0, n+1)
+	for i := 0; i < n; i++ {
+		slots = append(slots, i)
+	}
+	if n%2 == 1 {
+		slots = append(slots, -1)
+	}
+
+	rounds := make([][][2]int, 0, len(slots)-1)
+	for r := 0; r < len(slots)-1; r++ {
+		var pairs [][2]int
+		for i := 0; i < len(slots)/2; i++ {
+			first, second := slots[i], slots[len(slots)-1-i]
+			if first < 0 || second < 0 {
+				continue
+			}
+			if (r+i)%2 == 1 {
+				first, second = second, first
+			}
+			pairs = append(pairs, [2]int{first, second})
+		}
+		rounds = append(rounds, pairs)
+
+		// Keep the first slot in place and rotate the others
+		slots = append([]int{slots[0], slots[len(slots)-1]}, slots[1:len(slots)-1]...)
+	}
+	return rounds
+}
+
+// seedOrder returns the seeds in bracket order for a bracket of the given size, so that
+// seed 1 meets the last seed first and the top two seeds can only meet in the final
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// resolveTournament schedules every match whose two entries are known and plays through byes:
+// matches where a slot stays empty because no unfinished match feeds it. Completes the
+// tournament once every match is decided.
+func resolveTournament(tx *gorm.DB, tournament *models.Tournament) error {
+	var event models.Event
+	if err := tx.First(&event, tournament.EventID).Error; err != nil {
+		return err
+	}
+
+	var matches []models.TournamentMatch
+	if err := tx.Where("tournament_id = ?", tournament.ID).Order("id ASC").Find(&matches).Error; err != nil {
+		return err
+	}
+	index := make(map[uint]int, len(matches))
+	lastRound := make(map[string]int)
+	for i, m := range matches {
+		index[m.ID] = i
+		if m.Round > lastRound[m.Bracket] {
+			lastRound[m.Bracket] = m.Round
+		}
+	}
+
+	for {
+		// Slots that an unfinished match will still send an entry to
+		awaited := make(map[[2]uint]bool)
+		for _, m := range matches {
+			if m.Status != string(types.TournamentMatchPending) && m.Status != string(types.TournamentMatchScheduled) {
+				continue
+			}
+			if m.NextMatchID != nil {
+				awaited[[2]uint{*m.NextMatchID, uint(m.NextSlot)}] = true
+			}
+			if m.LoserNextMatchID != nil {
+				awaited[[2]uint{*m.LoserNextMatchID, uint(m.LoserNextSlot)}] = true
+			}
+		}
+
+		byes := 0
+		for i := range matches {
+			m := &matches[i]
+			if m.Status != string(types.TournamentMatchPending) {
+				continue
+			}
+			if (m.Entry1ID == nil && awaited[[2]uint{m.ID, 1}]) || (m.Entry2ID == nil && awaited[[2]uint{m.ID, 2}]) {
+				continue
+			}
+
+			if m.Entry1ID != nil && m.Entry2ID != nil {
+				name := TournamentRoundName(tournament.Format, m.Bracket, m.Round, lastRound[m.Bracket])
+				if err := scheduleTournamentMatch(tx, tournament, &event, m, name); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// At most one entry will ever reach this match: it moves on without playing
+			m.WinnerEntryID = m.Entry1ID
+			if m.WinnerEntryID == nil {
+				m.WinnerEntryID = m.Entry2ID
+			}
+			m.Status = string(types.TournamentMatchBye)
+			if err := tx.Save(m).Error; err != nil {
+				return err
+			}
+			if m.WinnerEntryID != nil && m.NextMatchID != nil {
+				next := &matches[index[*m.NextMatchID]]
+				if m.NextSlot == 1 {
+					next.Entry1ID = m.WinnerEntryID
+				} else {
+					next.Entry2ID = m.WinnerEntryID
+				}
+				if err := tx.Save(next).Error; err != nil {
+					return err
+				}
+			}
+			byes++
+		}
+		if byes == 0 {
+			break
+		}
+	}
+
+	for _, m := range matches {
+		if m.Status == string(types.TournamentMatchPending) || m.Status == string(types.TournamentMatchScheduled) {
+			return nil
+		}
+	}
+
+	tournament.Status = string(types.TournamentStatusComplete)
+	if tournament.Format == string(types.TournamentRoundRobin) {
+		var entries []models.TournamentEntry
+		if err := tx.Where("tournament_id = ?", tournament.ID).Order("seed ASC").Find(&entries).Error; err != nil {
+			return err
+		}
+		if standings := TournamentStandings(entries, matches); len(standings) > 0 {
+			tournament.WinnerEntryID = &standings[0].EntryID
+		}
+	} else {
+		// The champion won the last final played: the grand final, or its reset when it was needed
+		for _, m := range matches {
+			switch {
+			case tournament.Format == string(types.TournamentDoubleElimination):
+				if m.Bracket == string(types.TournamentBracketFinal) && m.WinnerEntryID != nil {
+					tournament.WinnerEntryID = m.WinnerEntryID
+				}
+			case m.NextMatchID == nil && m.Bracket == string(types.TournamentBracketWinners):
+				tournament.WinnerEntryID = m.WinnerEntryID
+			}
+		}
+	}
+	return tx.Model(tournament).Updates(map[string]any{"status": tournament.Status, "winner_entry_id": tournament.WinnerEntryID}).Error
+}
+
+// scheduleTournamentMatch creates the game event a match is played in, with a side per entry
+func scheduleTournamentMatch(tx *gorm.DB, tournament *models.Tournament, parent *models.Event, match *models.TournamentMatch, roundName string) error {
+	var entries []models.TournamentEntry
+	if err := tx.Preload("Members").Where("id IN ?", []uint{*match.Entry1ID, *match.Entry2ID}).Find(&entries).Error; err != nil {
+		return err
+	}
+	if len(entries) != 2 {
+		return fmt.Errorf("%w: entries of match %d not found", ErrInvalidEntry, match.ID)
+	}
+	if entries[0].ID != *match.Entry1ID {
+		entries[0], entries[1] = entries[1], entries[0]
+	}
+
+	endAt := match.ScheduledAt.Add(time.Duration(tournament.MatchDurationMinutes) * time.Minute)
+	capacity := len(entries[0].Members) + len(entries[1].Members)
+	game := models.Event{
+		OrganizerID:   parent.OrganizerID,
+		Type:          string(types.EventTypeGame),
+		Title:         fmt.Sprintf("%s vs %s", entries[0].Name, entries[1].Name),
+		Description:   fmt.Sprintf("%s – %s", parent.Title, roundName),
+		Sport:         parent.Sport,
+		StartAt:       match.ScheduledAt,
+		EndAt:         &endAt,
+		Capacity:      &capacity,
+		LocationName:  parent.LocationName,
+		Latitude:      parent.Latitude,
+		Longitude:     parent.Longitude,
+		VenueID:       parent.VenueID,
+		Visibility:    parent.Visibility,
+		JoinMode:      string(types.JoinModeApproval),
+		ParentEventID: &parent.ID,
+	}
+	sides := make([]matchSide, 0, len(entries))
+	for _, entry := range entries {
+		side := matchSide{name: entry.Name}
+		for _, member := range entry.Members {
+			side.userIDs = append(side.userIDs, member.UserID)
+		}
+		sides = append(sides, side)
+	}
+	if err := createMatchEvent(tx, &game, sides); err != nil {
+		return err
+	}
+
+	match.EventID = &game.ID
+	match.Status = string(types.TournamentMatchScheduled)
+	return tx.Save(match).Error
+}
+
+// sameEntry reports whether both entry IDs are set and equal
+func sameEntry(a, b *uint) bool {
+	return a != nil && b != nil && *a == *b
+}
+
+// placeEntry puts an entry into a slot of a match
+func placeEntry(tx *gorm.DB, matchID *uint, slot int, entryID *uint) error {
+	if matchID == nil || entryID == nil {
+		return nil
+	}
+	column := "entry1_id"
+	if slot == 2 {
+		column = "entry2_id"
+	}
+	return tx.Model(&models.TournamentMatch{}).Where("id = ?", *matchID).Update(column, *entryID).Error
+}
+
+// tallyMatch adds a played match to an entry's standing
+func tallyMatch(row *types.TournamentStandingResponse, scored, conceded int) {
+	row.Played++
+	row.ScoreFor += scored
+	row.ScoreAgainst += conceded
+	row.ScoreDifference = row.ScoreFor - row.ScoreAgainst
+	switch {
+	case scored > conceded:
+		row.Wins++
+		row.Points += 3
+	case scored == conceded:
+		row.Draws++
+		row.Points++
+	default:
+		row.Losses++
+	}
+}
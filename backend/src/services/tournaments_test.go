@@ -0,0 +1,237 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSeedOrder(t *testing.T) {
+	tests := []struct {
+		size int
+		want []int
+	}{
+		{size: 1, want: []int{1}},
+		{size: 2, want: []int{1, 2}},
+		{size: 4, want: []int{1, 4, 2, 3}},
+		{size: 8, want: []int{1, 8, 4, 5, 2, 7, 3, 6}},
+		{size: 16, want: []int{1, 16, 8, 9, 4, 13, 5, 12, 2, 15, 7, 10, 3, 14, 6, 11}},
+	}
+
+	for _, tt := range tests {
+		if got := seedOrder(tt.size); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("seedOrder(%d) = %v, want %v", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestRoundRobinPairings(t *testing.T) {
+	tests := []struct {
+		n          int
+		wantRounds int
+		wantPairs  int
+	}{
+		{n: 2, wantRounds: 1, wantPairs: 1},
+		{n: 3, wantRounds: 3, wantPairs: 1},
+		{n: 4, wantRounds: 3, wantPairs: 2},
+		{n: 5, wantRounds: 5, wantPairs: 2},
+		{n: 6, wantRounds: 5, wantPairs: 3},
+		{n: 7, wantRounds: 7, wantPairs: 3},
+	}
+
+	for _, tt := range tests {
+		rounds := roundRobinPairings(tt.n)
+		if len(rounds) != tt.wantRounds {
+			t.Errorf("n=%d: got %d rounds, want %d", tt.n, len(rounds), tt.wantRounds)
+			continue
+		}
+
+		met := make(map[[2]int]int)
+		byes := make(map[int]int)
+		for r, pairs := range rounds {
+			if len(pairs) != tt.wantPairs {
+				t.Errorf("n=%d round %d: got %d pairs, want %d", tt.n, r+1, len(pairs), tt.wantPairs)
+			}
+			playing := make(map[int]bool)
+			for _, pair := range pairs {
+				for _, c := range pair {
+					if c < 0 || c >= tt.n {
+						t.Fatalf("n=%d round %d: competitor %d out of range", tt.n, r+1, c)
+					}
+					if playing[c] {
+						t.Errorf("n=%d round %d: competitor %d plays twice", tt.n, r+1, c)
+					}
+					playing[c] = true
+				}
+				a, b := min(pair[0], pair[1]), max(pair[0], pair[1])
+				met[[2]int{a, b}]++
+			}
+			for c := 0; c < tt.n; c++ {
+				if !playing[c] {
+					byes[c]++
+				}
+			}
+		}
+
+		for a := 0; a < tt.n; a++ {
+			for b := a + 1; b < tt.n; b++ {
+				if met[[2]int{a, b}] != 1 {
+					t.Errorf("n=%d: %d and %d meet %d times, want 1", tt.n, a, b, met[[2]int{a, b}])
+				}
+			}
+		}
+
+		// With an odd count everyone sits out exactly once, otherwise nobody does
+		wantByes := tt.n % 2
+		for c := 0; c < tt.n; c++ {
+			if byes[c] != wantByes {
+				t.Errorf("n=%d: competitor %d sits out %d times, want %d", tt.n, c, byes[c], wantByes)
+			}
+		}
+	}
+}
+
+func TestPlanElimination(t *testing.T) {
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(stage int) time.Time { return start.Add(time.Duration(stage) * time.Hour) }
+
+	tests := []struct {
+		name        string
+		entries     int
+		double      bool
+		wantMatches int
+		// wantFirstRound lists the seeds of each first round match in bracket order, 0 for a bye
+		wantFirstRound [][2]int
+	}{
+		{
+			name:           "two entries play the final straight away",
+			entries:        2,
+			wantMatches:    1,
+			wantFirstRound: [][2]int{{1, 2}},
+		},
+		{
+			name:           "three entries give the top seed a bye",
+			entries:        3,
+			wantMatches:    3,
+			wantFirstRound: [][2]int{{1, 0}, {2, 3}},
+		},
+		{
+			name:           "five entries give the top three seeds a bye",
+			entries:        5,
+			wantMatches:    7,
+			wantFirstRound: [][2]int{{1, 0}, {4, 5}, {2, 0}, {3, 0}},
+		},
+		{
+			name:           "eight entries keep the top two seeds apart until the final",
+			entries:        8,
+			wantMatches:    7,
+			wantFirstRound: [][2]int{{1, 8}, {4, 5}, {2, 7}, {3, 6}},
+		},
+		{
+			name:           "double elimination with four entries",
+			entries:        4,
+			double:         true,
+			wantMatches:    7,
+			wantFirstRound: [][2]int{{1, 4}, {2, 3}},
+		},
+		{
+			name:           "double elimination with an odd count",
+			entries:        7,
+			double:         true,
+			wantMatches:    15,
+			wantFirstRound: [][2]int{{1, 0}, {4, 5}, {2, 7}, {3, 6}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := make([]models.TournamentEntry, tt.entries)
+			seedOf := make(map[uint]int, tt.entries)
+			for i := range entries {
+				entries[i].ID = uint(100 + i)
+				seedOf[entries[i].ID] = i + 1
+			}
+
+			plan := planElimination(entries, tt.double, at)
+			if len(plan) != tt.wantMatches {
+				t.Fatalf("got %d matches, want %d", len(plan), tt.wantMatches)
+			}
+
+			seed := func(id *uint) int {
+				if id == nil {
+					return 0
+				}
+				return seedOf[*id]
+			}
+			var firstRound [][2]int
+			last := -1
+			for i, p := range plan {
+				if p.next >= len(plan) || p.loserNext >= len(plan) {
+					t.Fatalf("match %d links outside the plan", i)
+				}
+				if p.match.Bracket == string(types.TournamentBracketWinners) && p.match.Round == 1 {
+					firstRound = append(firstRound, [2]int{seed(p.match.Entry1ID), seed(p.match.Entry2ID)})
+				}
+				if p.next < 0 {
+					if last >= 0 {
+						t.Fatalf("matches %d and %d both end the bracket", last, i)
+					}
+					last = i
+				}
+				if !tt.double && p.loserNext >= 0 {
+					t.Errorf("match %d drops its loser in single elimination", i)
+				}
+			}
+			if !reflect.DeepEqual(firstRound, tt.wantFirstRound) {
+				t.Errorf("first round seeds = %v, want %v", firstRound, tt.wantFirstRound)
+			}
+			if last < 0 {
+				t.Fatal("no match ends the bracket")
+			}
+
+			if !tt.double {
+				if plan[last].match.Bracket != string(types.TournamentBracketWinners) {
+					t.Errorf("last match is in the %s bracket, want winners", plan[last].match.Bracket)
+				}
+				return
+			}
+
+			// The grand final feeds both its winner and its loser into the reset match, which
+			// is played last and ends the bracket
+			reset := plan[last]
+			if reset.match.Bracket != string(types.TournamentBracketFinal) || reset.match.Round != 2 {
+				t.Fatalf("last match is %s round %d, want final round 2", reset.match.Bracket, reset.match.Round)
+			}
+			final := -1
+			for i, p := range plan {
+				if p.match.Bracket == string(types.TournamentBracketFinal) && p.match.Round == 1 {
+					final = i
+				}
+			}
+			if final < 0 {
+				t.Fatal("no grand final")
+			}
+			if plan[final].next != last || plan[final].nextSlot != 1 || plan[final].loserNext != last || plan[final].loserSlot != 2 {
+				t.Errorf("grand final links to %d slot %d and drops to %d slot %d, want the reset in slots 1 and 2",
+					plan[final].next, plan[final].nextSlot, plan[final].loserNext, plan[final].loserSlot)
+			}
+			for i, p := range plan {
+				if i != final && i != last && !p.match.ScheduledAt.Before(plan[final].match.ScheduledAt) {
+					t.Errorf("match %d is scheduled at or after the grand final", i)
+				}
+			}
+			if !reset.match.ScheduledAt.After(plan[final].match.ScheduledAt) {
+				t.Error("reset match is not scheduled after the grand final")
+			}
+
+			// Every winners bracket match drops its loser into the losers bracket
+			for i, p := range plan {
+				if p.match.Bracket == string(types.TournamentBracketWinners) && p.loserNext < 0 {
+					t.Errorf("winners bracket match %d does not drop its loser", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"backend/src/models"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCannotBlockSelf = errors.New("cannot block or mute yourself")
+	ErrUserNotFound    = errors.New("user does not exist")
+	ErrUserBlocked     = errors.New("one of the users has blocked the other")
+)
+
+// BlockUser blocks the user and removes any follow or follow request between the two
+func BlockUser(db *gorm.DB, blockerID, blockedID uint) error {
+	if err := checkRelationTarget(db, blockerID, blockedID); err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.UserBlock{BlockerID: blockerID, BlockedID: blockedID}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(requester_id = ? AND target_id = ?) OR (requester_id = ? AND target_id = ?)", blockerID, blockedID, blockedID, blockerID).
+			Delete(&models.FollowRequest{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("(follower_id = ? AND followed_id = ?) OR (follower_id = ? AND followed_id = ?)", blockerID, blockedID, blockedID, blockerID).
+			Delete(&models.Follow{}).Error
+	})
+}
+
+// UnblockUser lifts the user's block. Removed follows are not restored.
+func UnblockUser(db *gorm.DB, blockerID, blockedID uint) error {
+	return db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&models.UserBlock{}).Error
+}
+
+// MuteUser hides the user's posts, events and notifications from the muter
+func MuteUser(db *gorm.DB, muterID, mutedID uint) error {
+	if err := checkRelationTarget(db, muterID, mutedID); err != nil {
+		return err
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.UserMute{MuterID: muterID, MutedID: mutedID}).Error
+}
+
+// UnmuteUser lifts the user's mute
+func UnmuteUser(db *gorm.DB, muterID, mutedID uint) error {
+	return db.Where("muter_id = ? AND muted_id = ?", muterID, mutedID).Delete(&models.UserMute{}).Error
+}
+
+// IsBlockedBetween reports whether either user has blocked the other
+func IsBlockedBetween(db *gorm.DB, userID, otherID uint) bool {
+	var count int64
+	db.Model(&models.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count)
+	return count > 0
+}
+
+// IsHiddenFrom reports whether the other user's content is hidden from the user, either
+// because of a block in any direction or because the user muted them
+func IsHiddenFrom(db *gorm.DB, userID, otherID uint) bool {
+	if IsBlockedBetween(db, userID, otherID) {
+		return true
+	}
+	var count int64
+	db.Model(&models.UserMute{}).Where("muter_id = ? AND muted_id = ?", userID, otherID).Count(&count)
+	return count > 0
+}
+
+// BlockedUsersScope leaves out rows whose column holds a user who blocked or was blocked by
+// the given user. Used for search.
+func BlockedUsersScope(userID uint, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(column+` NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id = ?)
+			AND `+column+` NOT IN (SELECT blocker_id FROM user_blocks WHERE blocked_id = ?)`,
+			userID,
+			userID,
+		)
+	}
+}
+
+// HiddenUsersScope leaves out rows whose column holds a user who is blocked either way or
+// muted by the given user. Used for feeds.
+func HiddenUsersScope(userID uint, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(BlockedUsersScope(userID, column)).
+			Where(column+" NOT IN (SELECT muted_id FROM user_mutes WHERE muter_id = ?)", userID)
+	}
+}
+
+// checkRelationTarget validates the user to block or mute
+func checkRelationTarget(db *gorm.DB, userID, targetID uint) error {
+	if userID == targetID {
+		return ErrCannotBlockSelf
+	}
+	var count int64
+	db.Model(&models.User{}).Where("id = ?", targetID).Count(&count)
+	if count == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrCannotDismissSelf = errors.New("cannot dismiss yourself from suggestions")
+
+// How much each signal adds to a suggestion's score
+const (
+	suggestionMutualFollowWeight = 3
+	suggestionSharedEventWeight  = 3
+	suggestionSharedSportWeight  = 2
+	suggestionSameCityWeight     = 2
+	suggestionSameCountryWeight  = 1
+)
+
+// UserSuggestion is a user the current user may know, with the signals that brought them up
+type UserSuggestion struct {
+	User          models.User
+	Score         int
+	MutualFollows int
+	SharedSports  []string
+	SharedEvents  int
+	SameCity      bool
+	SameCountry   bool
+	Reason        string
+}
+
+// SuggestUsers ranks the users the given user may know by mutual follows, shared sports, living
+// in the same city or country and past activities they both took part in. Users they already
+// follow or asked to follow, dismissed suggestions and blocked users are left out.
+func SuggestUsers(db *gorm.DB, userID uint, limit int) ([]UserSuggestion, error) {
+	var me models.User
+	if err := db.Select("id", "city", "country").First(&me, userID).Error; err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[uint]*UserSuggestion)
+	candidate := func(id uint) *UserSuggestion {
+		if s, ok := candidates[id]; ok {
+			return s
+		}
+		s := &UserSuggestion{}
+		candidates[id] = s
+		return s
+	}
+
+	type countRow struct {
+		UserID uint
+		Count  int
+	}
+
+	// People followed by the people the user follows
+	var mutualRows []countRow
+	if err := db.Table("follows AS mine").
+		Select("theirs.followed_id AS user_id, COUNT(*) AS count").
+		Joins("JOIN follows AS theirs ON theirs.follower_id = mine.followed_id").
+		Where("mine.follower_id = ?", userID).
+		Scopes(suggestionCandidatesScope(userID, "theirs.followed_id")).
+		Group("theirs.followed_id").
+		Scan(&mutualRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range mutualRows {
+		candidate(row.UserID).MutualFollows = row.Count
+	}
+
+	// People the user played with in past activities
+	var eventRows []countRow
+	if err := db.Table("event_participants AS mine").
+		Select("theirs.user_id AS user_id, COUNT(DISTINCT mine.event_id) AS count").
+		Joins("JOIN event_participants AS theirs ON theirs.event_id = mine.event_id").
+		Joins("JOIN events ON events.id = mine.event_id").
+		Where("mine.user_id = ? AND events.start_at < ? AND events.status <> ? AND events.deleted_at IS NULL",
+			userID, time.Now(), types.EventStatusCancelled).
+		Scopes(suggestionCandidatesScope(userID, "theirs.user_id")).
+		Group("theirs.user_id").
+		Scan(&eventRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range eventRows {
+		candidate(row.UserID).SharedEvents = row.Count
+	}
+
+	// People who play the same sports
+	var sportRows []struct {
+		UserID uint
+		Name   string
+	}
+	if err := db.Table("user_sports AS mine").
+		Select("theirs.user_id AS user_id, sports.name AS name").
+		Joins("JOIN user_sports AS theirs ON theirs.sport_id = mine.sport_id").
+		Joins("JOIN sports ON sports.id = mine.sport_id").
+		Where("mine.user_id = ?", userID).
+		Scopes(suggestionCandidatesScope(userID, "theirs.user_id")).
+		Order("sports.name").
+		Scan(&sportRows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range sportRows {
+		s := candidate(row.UserID)
+		s.SharedSports = append(s.SharedSports, row.Name)
+	}
+
+	// People living nearby
+	if me.City != "" || me.Country != "" {
+		var nearby []models.User
+		if err := db.Model(&models.User{}).Select("id", "city", "country").
+			Where("(city <> '' AND LOWER(city) = LOWER(?)) OR (country <> '' AND LOWER(country) = LOWER(?))", me.City, me.Country).
+			Scopes(suggestionCandidatesScope(userID, "users.id")).
+			Find(&nearby).Error; err != nil {
+			return nil, err
+		}
+		for _, u := range nearby {
+			sameCountry := me.Country != "" && strings.EqualFold(u.Country, me.Country)
+			sameCity := me.City != "" && strings.EqualFold(u.City, me.City)
+			s := candidate(u.ID)
+			s.SameCity = sameCity
+			s.SameCountry = sameCountry
+		}
+	}
+
+	ids := make([]uint, 0, len(candidates))
+	for id, s := range candidates {
+		s.User.ID = id
+		s.Score = s.MutualFollows*suggestionMutualFollowWeight +
+			s.SharedEvents*suggestionSharedEventWeight +
+			len(s.SharedSports)*suggestionSharedSportWeight
+		if s.SameCity {
+			s.Score += suggestionSameCityWeight
+		} else if s.SameCountry {
+			s.Score += suggestionSameCountryWeight
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := candidates[ids[i]], candidates[ids[j]]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.MutualFollows != b.MutualFollows {
+			return a.MutualFollows > b.MutualFollows
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	if len(ids) == 0 {
+		return []UserSuggestion{}, nil
+	}
+
+	var users []models.User
+	if err := db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		candidates[u.ID].User = u
+	}
+
+	suggestions := make([]UserSuggestion, 0, len(ids))
+	for _, id := range ids {
+		s := candidates[id]
+		s.Reason = suggestionReason(s)
+		suggestions = append(suggestions, *s)
+	}
+	return suggestions, nil
+}
+
+// DismissSuggestion stops suggesting the other user to the user
+func DismissSuggestion(db *gorm.DB, userID, dismissedID uint) error {
+	if userID == dismissedID {
+		return ErrCannotDismissSelf
+	}
+	var count int64
+	db.Model(&models.User{}).Where("id = ?", dismissedID).Count(&count)
+	if count == 0 {
+		return ErrUserNotFound
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.SuggestionDismissal{UserID: userID, DismissedID: dismissedID}).Error
+}
+
+// suggestionCandidatesScope leaves out rows whose column holds the user themselves, someone they
+// follow or asked to follow, a dismissed suggestion, a user blocked either way or a deleted account
+func suggestionCandidatesScope(userID uint, column string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(BlockedUsersScope(userID, column)).Where(column+` <> ?
+			AND `+column+` NOT IN (SELECT followed_id FROM follows WHERE follower_id = ?)
+			AND `+column+` NOT IN (SELECT target_id FROM follow_requests WHERE requester_id = ?)
+			AND `+column+` NOT IN (SELECT dismissed_id FROM suggestion_dismissals WHERE user_id = ?)
+			AND `+column+` IN (SELECT id FROM users WHERE deleted_at IS NULL)`,
+			userID, userID, userID, userID,
+		)
+	}
+}
+
+// suggestionReason explains a suggestion, e.g. "5 mutual follows, plays Tennis"
+func suggestionReason(s *UserSuggestion) string {
+	var parts []string
+	if s.MutualFollows > 0 {
+		parts = append(parts, pluralize(s.MutualFollows, "mutual follow", "mutual follows"))
+	}
+	if s.SharedEvents > 0 {
+		parts = append(parts, "joined "+pluralize(s.SharedEvents, "activity", "activities")+" with you")
+	}
+	if len(s.SharedSports) > 0 {
+		parts = append(parts, "plays "+joinNames(s.SharedSports))
+	}
+	if s.SameCity {
+		parts = append(parts, "also in "+s.User.City)
+	} else if s.SameCountry {
+		parts = append(parts, "also in "+s.User.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// pluralize formats a count with the singular or plural noun
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// joinNames lists names as "A", "A and B" or "A, B and C"
+func joinNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrResourceNotFound = errors.New("venue resource not found")
+	ErrResourceBooked   = errors.New("venue resource is not available at this time")
+)
+
+// DefaultBookingDuration is how long an event without an end time holds its resource
+const DefaultBookingDuration = time.Hour
+
+// bookingEndSQL is the end of an event's booking, falling back to DefaultBookingDuration
+var bookingEndSQL = fmt.Sprintf("COALESCE(end_at, start_at + INTERVAL '%d minutes')", int(DefaultBookingDuration/time.Minute))
+
+// Interval is a time window [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ResourceConflict is something a booking overlaps: another event, or closing time when Event is nil
+type ResourceConflict struct {
+	Reason types.BookingConflictReason
+	Event  *models.Event
+}
+
+// BookingWindow returns the interval an event occupies its resource
+func BookingWindow(startAt time.Time, endAt *time.Time) Interval {
+	if endAt != nil && endAt.After(startAt) {
+		return Interval{Start: startAt, End: *endAt}
+	}
+	return Interval{Start: startAt, End: startAt.Add(DefaultBookingDuration)}
+}
+
+// GetVenueResource loads a resource, returning ErrResourceNotFound when it doesn't exist
+func GetVenueResource(db *gorm.DB, resourceID uint) (*models.VenueResource, error) {
+	var resource models.VenueResource
+	if err := db.First(&resource, resourceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// FindResourceConflicts returns the events booking the resource during the window (ignoring
+// excludeEventID and cancelled events) and whether the window falls outside opening hours.
+// The resource row is locked, so run it in the transaction that saves the booking to keep
+// concurrent bookings of the same resource from both succeeding.
+func FindResourceConflicts(tx *gorm.DB, resourceID uint, window Interval, excludeEventID uint) ([]ResourceConflict, error) {
+	var resource models.VenueResource
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&resource, resourceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+
+	var venue models.Venue
+	if err := tx.First(&venue, resource.VenueID).Error; err != nil {
+		return nil, err
+	}
+
+	conflicts := make([]ResourceConflict, 0)
+	open, err := VenueOpenIntervals(tx, &venue, window.Start, window.End)
+	if err != nil {
+		return nil, err
+	}
+	if !coversInterval(open, window) {
+		conflicts = append(conflicts, ResourceConflict{Reason: types.BookingConflictClosed})
+	}
+
+	var events []models.Event
+	if err := tx.Where("resource_id = ? AND id <> ? AND status <> ?", resourceID, excludeEventID, types.EventStatusCancelled).
+		Where("start_at < ? AND "+bookingEndSQL+" > ?", window.End, window.Start).
+		Order("start_at ASC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	for i := range events {
+		conflicts = append(conflicts, ResourceConflict{Reason: types.BookingConflictBooked, Event: &events[i]})
+	}
+
+	return conflicts, nil
+}
+
+// VenueOpenIntervals returns when the venue is open between from and to, clipped to that range.
+// Intervals of consecutive days that touch (e.g. open until midnight and from midnight) are merged.
+// A venue without opening hours is always open.
+func VenueOpenIntervals(db *gorm.DB, venue *models.Venue, from, to time.Time) ([]Interval, error) {
+	var hours []models.VenueOpeningHours
+	if err := db.Where("venue_id = ?", venue.ID).Find(&hours).Error; err != nil {
+		return nil, err
+	}
+	if len(hours) == 0 {
+		return []Interval{{Start: from, End: to}}, nil
+	}
+
+	loc, err := time.LoadLocation(venue.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	byWeekday := make(map[time.Weekday][]models.VenueOpeningHours)
+	for _, h := range hours {
+		byWeekday[time.Weekday(h.Weekday)] = append(byWeekday[time.Weekday(h.Weekday)], h)
+	}
+
+	var intervals []Interval
+	local := from.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, h := range byWeekday[day.Weekday()] {
+			start := dayMinute(day, h.OpensAt)
+			end := dayMinute(day, h.ClosesAt)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if start.Before(end) {
+				intervals = append(intervals, Interval{Start: start, End: end})
+			}
+		}
+	}
+
+	return mergeIntervals(intervals), nil
+}
+
+// SubtractIntervals removes the taken intervals from the open ones, dropping what's left if
+// it is shorter than minLength
+func SubtractIntervals(open, taken []Interval, minLength time.Duration) []Interval {
+	taken = mergeIntervals(taken)
+	free := make([]Interval, 0)
+	for _, o := range open {
+		cursor := o.Start
+		for _, t := range taken {
+			if !t.End.After(cursor) || !t.Start.Before(o.End) {
+				continue
+			}
+			if t.Start.After(cursor) && t.Start.Sub(cursor) >= minLength {
+				free = append(free, Interval{Start: cursor, End: t.Start})
+			}
+			if t.End.After(cursor) {
+				cursor = t.End
+			}
+		}
+		if o.End.After(cursor) && o.End.Sub(cursor) >= minLength {
+			free = append(free, Interval{Start: cursor, End: o.End})
+		}
+	}
+	return free
+}
+
+// ResourceBookings returns the booking windows of the given resources overlapping [from, to), by resource
+func ResourceBookings(db *gorm.DB, resourceIDs []uint, from, to time.Time) (map[uint][]Interval, error) {
+	bookings := make(map[uint][]Interval)
+	if len(resourceIDs) == 0 {
+		return bookings, nil
+	}
+
+	var events []models.Event
+	if err := db.Select("id, resource_id, start_at, end_at").
+		Where("resource_id IN ? AND status <> ?", resourceIDs, types.EventStatusCancelled).
+		Where("start_at < ? AND "+bookingEndSQL+" > ?", to, from).
+		Order("start_at ASC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		window := BookingWindow(event.StartAt, event.EndAt)
+		if window.Start.Before(from) {
+			window.Start = from
+		}
+		if window.End.After(to) {
+			window.End = to
+		}
+		bookings[*event.ResourceID] = append(bookings[*event.ResourceID], window)
+	}
+	return bookings, nil
+}
+
+// dayMinute returns the time the given number of minutes after local midnight. Adding the
+// hours and minutes to the date (rather than a duration) keeps wall-clock times across DST changes.
+func dayMinute(day time.Time, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), minute/60, minute%60, 0, 0, day.Location())
+}
+
+// mergeIntervals sorts intervals and merges the ones that overlap or touch
+func mergeIntervals(intervals []Interval) []Interval {
+	if len(intervals) == 0 {
+		return intervals
+	}
+	sorted := append([]Interval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	merged := []Interval{sorted[0]}
+	for _, interval := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if !interval.Start.After(last.End) {
+			if interval.End.After(last.End) {
+				last.End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+// coversInterval reports whether a single merged interval contains the window
+func coversInterval(intervals []Interval, window Interval) bool {
+	for _, interval := range intervals {
+		if !interval.Start.After(window.Start) && !interval.End.Before(window.End) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// hours builds an interval between two hours of the same test day
+func hours(from, to int) Interval {
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	return Interval{Start: day.Add(time.Duration(from) * time.Hour), End: day.Add(time.Duration(to) * time.Hour)}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []Interval
+		want      []Interval
+	}{
+		{
+			name:      "empty",
+			intervals: []Interval{},
+			want:      []Interval{},
+		},
+		{
+			name:      "disjoint intervals are sorted",
+			intervals: []Interval{hours(14, 15), hours(9, 10)},
+			want:      []Interval{hours(9, 10), hours(14, 15)},
+		},
+		{
+			name:      "touching intervals merge",
+			intervals: []Interval{hours(10, 11), hours(9, 10), hours(11, 12)},
+			want:      []Interval{hours(9, 12)},
+		},
+		{
+			name:      "overlapping intervals merge",
+			intervals: []Interval{hours(9, 11), hours(10, 12), hours(15, 17), hours(16, 18)},
+			want:      []Interval{hours(9, 12), hours(15, 18)},
+		},
+		{
+			name:      "contained interval is absorbed",
+			intervals: []Interval{hours(9, 17), hours(10, 11), hours(12, 13)},
+			want:      []Interval{hours(9, 17)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeIntervals(tt.intervals); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeIntervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubtractIntervals(t *testing.T) {
+	tests := []struct {
+		name      string
+		open      []Interval
+		taken     []Interval
+		minLength time.Duration
+		want      []Interval
+	}{
+		{
+			name: "nothing taken",
+			open: []Interval{hours(9, 17)},
+			want: []Interval{hours(9, 17)},
+		},
+		{
+			name:  "booking in the middle splits the window",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(12, 13)},
+			want:  []Interval{hours(9, 12), hours(13, 17)},
+		},
+		{
+			name:  "bookings touching the opening hours leave the middle",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(9, 10), hours(16, 17)},
+			want:  []Interval{hours(10, 16)},
+		},
+		{
+			name:  "touching bookings leave no gap between them",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(11, 12), hours(10, 11)},
+			want:  []Interval{hours(9, 10), hours(12, 17)},
+		},
+		{
+			name:  "overlapping bookings are taken together",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(13, 15), hours(10, 12), hours(11, 14)},
+			want:  []Interval{hours(9, 10), hours(15, 17)},
+		},
+		{
+			name:  "bookings reaching past the opening hours are clipped",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(7, 10), hours(16, 20)},
+			want:  []Interval{hours(10, 16)},
+		},
+		{
+			name:  "bookings outside the opening hours are ignored",
+			open:  []Interval{hours(9, 12)},
+			taken: []Interval{hours(6, 9), hours(12, 14)},
+			want:  []Interval{hours(9, 12)},
+		},
+		{
+			name:  "fully booked",
+			open:  []Interval{hours(9, 17)},
+			taken: []Interval{hours(8, 18)},
+			want:  []Interval{},
+		},
+		{
+			name:  "booking spanning the gap between two windows",
+			open:  []Interval{hours(8, 12), hours(14, 20)},
+			taken: []Interval{hours(11, 15)},
+			want:  []Interval{hours(8, 11), hours(15, 20)},
+		},
+		{
+			name:      "gaps shorter than the minimum length are dropped",
+			open:      []Interval{hours(9, 17)},
+			taken:     []Interval{hours(10, 12), hours(13, 16)},
+			minLength: 90 * time.Minute,
+			want:      []Interval{},
+		},
+		{
+			name:      "gaps of exactly the minimum length are kept",
+			open:      []Interval{hours(9, 17)},
+			taken:     []Interval{hours(10, 12), hours(13, 16)},
+			minLength: time.Hour,
+			want:      []Interval{hours(9, 10), hours(12, 13), hours(16, 17)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubtractIntervals(tt.open, tt.taken, tt.minLength); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubtractIntervals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/utils"
+	"math"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// LocationCluster is a group of events without a venue whose locations look like the same place
+type LocationCluster struct {
+	Name      string
+	Spellings []string
+	Latitude  float64
+	Longitude float64
+	Sports    []string
+	EventIDs  []uint
+	// Venue is the existing venue the cluster matches, nil when a new one would be created
+	Venue *models.Venue
+}
+
+// FindDuplicateLocations groups events that have no venue yet by location: events within radiusKm
+// of each other with similar location names (see SimilarLocationNames) end up in the same cluster.
+// Clusters with fewer than minEvents events are dropped unless they match an existing venue.
+// The largest clusters come first.
+func FindDuplicateLocations(db *gorm.DB, radiusKm float64, minEvents int) ([]LocationCluster, error) {
+	var events []models.Event
+	if err := db.Select("id, location_name, latitude, longitude, sport").
+		Where("venue_id IS NULL").Order("latitude ASC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = NormalizeLocationName(event.LocationName)
+	}
+
+	// Union nearby events with similar names. Events are sorted by latitude, so only the
+	// following events within the radius (in degrees of latitude) need to be compared.
+	parent := make([]int, len(events))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	radiusDeg := radiusKm / utils.EarthRadiusKm * 180 / math.Pi
+	for i := range events {
+		if names[i] == "" {
+			continue
+		}
+		for j := i + 1; j < len(events) && *events[j].Latitude-*events[i].Latitude <= radiusDeg; j++ {
+			if names[j] == "" || find(i) == find(j) {
+				continue
+			}
+			distance := utils.HaversineKm(*events[i].Latitude, *events[i].Longitude, *events[j].Latitude, *events[j].Longitude)
+			if distance <= radiusKm && SimilarLocationNames(names[i], names[j]) {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range events {
+		if names[i] != "" {
+			root := find(i)
+			groups[root] = append(groups[root], i)
+		}
+	}
+
+	clusters := make([]LocationCluster, 0)
+	for _, members := range groups {
+		cluster := buildLocationCluster(events, members)
+		venue, err := FindSimilarVenue(db, cluster.Name, cluster.Latitude, cluster.Longitude, radiusKm, 0)
+		if err != nil {
+			return nil, err
+		}
+		if venue == nil && len(members) < minEvents {
+			continue
+		}
+		cluster.Venue = venue
+		clusters = append(clusters, cluster)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].EventIDs) != len(clusters[j].EventIDs) {
+			return len(clusters[i].EventIDs) > len(clusters[j].EventIDs)
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
+
+// MergeLocationCluster links the cluster's events to its venue, creating the venue first when the
+// cluster doesn't match an existing one. Events take over the venue's name and coordinates.
+// It returns the venue and the number of events linked.
+func MergeLocationCluster(db *gorm.DB, cluster *LocationCluster) (*models.Venue, int64, error) {
+	var venue models.Venue
+	var linked int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		sports, err := FindOrCreateSports(tx, cluster.Sports)
+		if err != nil {
+			return err
+		}
+
+		if cluster.Venue != nil {
+			venue = *cluster.Venue
+			if len(sports) > 0 {
+				if err := tx.Model(&venue).Association("Sports").Append(&sports); err != nil {
+					return err
+				}
+			}
+		} else {
+			lat, lng := cluster.Latitude, cluster.Longitude
+			venue = models.Venue{Name: cluster.Name, Latitude: &lat, Longitude: &lng, Sports: sports}
+			if err := tx.Create(&venue).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&models.Event{}).
+			Where("id IN ? AND venue_id IS NULL", cluster.EventIDs).
+			Updates(map[string]any{
+				"venue_id":      venue.ID,
+				"location_name": venue.Name,
+				"latitude":      venue.Latitude,
+				"longitude":     venue.Longitude,
+			})
+		linked = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return &venue, linked, nil
+}
+
+// buildLocationCluster summarizes a group of events: the most common spelling becomes the name
+// and the centroid the location
+func buildLocationCluster(events []models.Event, members []int) LocationCluster {
+	cluster := LocationCluster{EventIDs: make([]uint, 0, len(members))}
+	spellings := make(map[string]int)
+	sports := make(map[string]bool)
+	// Longitudes are summed relative to the first event, so a cluster straddling the ±180° meridian stays in place
+	refLng := *events[members[0]].Longitude
+	var latSum, lngSum float64
+	for _, i := range members {
+		event := events[i]
+		cluster.EventIDs = append(cluster.EventIDs, event.ID)
+		spellings[strings.TrimSpace(event.LocationName)]++
+		if event.Sport != "" && !sports[strings.ToLower(event.Sport)] {
+			sports[strings.ToLower(event.Sport)] = true
+			cluster.Sports = append(cluster.Sports, event.Sport)
+		}
+		latSum += *event.Latitude
+		lngSum += utils.NormalizeLongitude(*event.Longitude - refLng)
+	}
+
+	for spelling := range spellings {
+		cluster.Spellings = append(cluster.Spellings, spelling)
+	}
+	sort.Slice(cluster.Spellings, func(i, j int) bool {
+		a, b := cluster.Spellings[i], cluster.Spellings[j]
+		if spellings[a] != spellings[b] {
+			return spellings[a] > spellings[b]
+		}
+		return a < b
+	})
+	cluster.Name = cluster.Spellings[0]
+
+	cluster.Latitude = latSum / float64(len(members))
+	cluster.Longitude = utils.NormalizeLongitude(refLng + lngSum/float64(len(members)))
+	sort.Slice(cluster.EventIDs, func(i, j int) bool { return cluster.EventIDs[i] < cluster.EventIDs[j] })
+	return cluster
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"backend/src/models"
+	"backend/src/types"
+	"backend/src/utils"
+	"errors"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrVenueNotFound = errors.New("venue not found")
+	ErrVenueExists   = errors.New("a venue with a similar name already exists nearby")
+)
+
+// VenueMatchRadiusKm is how close two places with similar names must be to count as the same venue
+const VenueMatchRadiusKm = 0.1
+
+// locationAbbreviations expands common abbreviations so "Central Pk Ct 3" matches "Central Park Court 3"
+var locationAbbreviations = map[string]string{
+	"ct":     "court",
+	"crt":    "court",
+	"cts":    "courts",
+	"pk":     "park",
+	"fld":    "field",
+	"flds":   "fields",
+	"st":     "street",
+	"str":    "street",
+	"rd":     "road",
+	"ave":    "avenue",
+	"av":     "avenue",
+	"blvd":   "boulevard",
+	"sq":     "square",
+	"ctr":    "center",
+	"centre": "center",
+	"sch":    "school",
+	"hs":     "high school",
+	"no":     "",
+	"nr":     "",
+}
+
+// locationStopWords are ignored when comparing location names
+var locationStopWords = map[string]bool{"the": true, "at": true, "of": true, "and": true, "in": true}
+
+// NormalizeLocationName lowercases a location name, strips punctuation and stop words and
+// expands common abbreviations, e.g. "The Central Pk. Ct. #3" becomes "central park court 3".
+func NormalizeLocationName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if expanded, ok := locationAbbreviations[field]; ok {
+			field = expanded
+		}
+		for _, word := range strings.Fields(field) {
+			if !locationStopWords[word] {
+				words = append(words, word)
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// SimilarLocationNames reports whether two normalized location names likely refer to the same place.
+// Numbers must match exactly ("Court 3" is not "Court 4"); the remaining words must mostly overlap
+// or one name must contain all the words of the other.
+func SimilarLocationNames(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if a == b {
+		return true
+	}
+
+	wordsA, numbersA := splitLocationWords(a)
+	wordsB, numbersB := splitLocationWords(b)
+	if !sameWordSet(numbersA, numbersB) || len(wordsA) == 0 || len(wordsB) == 0 {
+		return false
+	}
+
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	union := len(wordsA) + len(wordsB) - shared
+	if float64(shared)/float64(union) >= 0.6 {
+		return true
+	}
+	return shared >= 2 && shared == min(len(wordsA), len(wordsB))
+}
+
+// FindSimilarVenue returns a venue within radiusKm of the point whose name is similar to the
+// given one, or nil. excludeID skips a venue (e.g. the one being updated).
+func FindSimilarVenue(db *gorm.DB, name string, lat, lng, radiusKm float64, excludeID uint) (*models.Venue, error) {
+	normalized := NormalizeLocationName(name)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	var venues []models.Venue
+	if err := db.Scopes(venueBoundsScope(utils.BoundingBox(lat, lng, radiusKm))).
+		Where("id <> ?", excludeID).Find(&venues).Error; err != nil {
+		return nil, err
+	}
+
+	var closest *models.Venue
+	closestDistance := radiusKm
+	for i := range venues {
+		distance := utils.HaversineKm(lat, lng, *venues[i].Latitude, *venues[i].Longitude)
+		if distance <= closestDistance && SimilarLocationNames(normalized, NormalizeLocationName(venues[i].Name)) {
+			closest = &venues[i]
+			closestDistance = distance
+		}
+	}
+	return closest, nil
+}
+
+// FindOrCreateSports returns the sports with the given names, creating the ones that don't exist yet.
+// Names are trimmed and duplicates are dropped.
+func FindOrCreateSports(db *gorm.DB, names []string) ([]models.Sport, error) {
+	seen := make(map[string]bool, len(names))
+	sports := make([]models.Sport, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+
+		var sport models.Sport
+		if err := db.Where("LOWER(name) = LOWER(?)", name).FirstOrCreate(&sport, models.Sport{Name: name}).Error; err != nil {
+			return nil, err
+		}
+		sports = append(sports, sport)
+	}
+	return sports, nil
+}
+
+// GetVenue loads a venue, returning ErrVenueNotFound when it doesn't exist
+func GetVenue(db *gorm.DB, venueID uint) (*models.Venue, error) {
+	var venue models.Venue
+	if err := db.First(&venue, venueID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVenueNotFound
+		}
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// LinkEventToVenue points the event at a venue and copies the venue's name and coordinates.
+// The event is not saved.
+func LinkEventToVenue(db *gorm.DB, event *models.Event, venueID uint) error {
+	venue, err := GetVenue(db, venueID)
+	if err != nil {
+		return err
+	}
+
+	event.VenueID = &venue.ID
+	event.LocationName = venue.Name
+	event.Latitude = venue.Latitude
+	event.Longitude = venue.Longitude
+	return nil
+}
+
+// SyncVenueEvents copies a venue's name and coordinates to its upcoming events.
+// Past events keep the location they took place at.
+func SyncVenueEvents(db *gorm.DB, venue *models.Venue) error {
+	return db.Model(&models.Event{}).
+		Where("venue_id = ? AND status = ?", venue.ID, types.EventStatusUpcoming).
+		Updates(map[string]any{
+			"location_name": venue.Name,
+			"latitude":      venue.Latitude,
+			"longitude":     venue.Longitude,
+		}).Error
+}
+
+// venueBoundsScope limits venues to a bounding box, wrapping across the ±180° meridian
+func venueBoundsScope(bounds utils.GeoBounds) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("latitude BETWEEN ? AND ?", bounds.MinLat, bounds.MaxLat)
+		if bounds.CrossesAntimeridian() {
+			return db.Where("(longitude >= ? OR longitude <= ?)", bounds.MinLng, bounds.MaxLng)
+		}
+		return db.Where("longitude BETWEEN ? AND ?", bounds.MinLng, bounds.MaxLng)
+	}
+}
+
+// splitLocationWords separates the words of a normalized name from its numbers
+func splitLocationWords(name string) (words, numbers map[string]bool) {
+	words = make(map[string]bool)
+	numbers = make(map[string]bool)
+	for _, word := range strings.Fields(name) {
+		if strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			numbers[strings.TrimLeft(word, "0")] = true
+		} else {
+			words[word] = true
+		}
+	}
+	return words, numbers
+}
+
+func sameWordSet(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for word := range a {
+		if !b[word] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+// BlockResponse represents the response after a block/mute action
+// @Description Block or mute action response payload
+type BlockResponse struct {
+	Success   bool   `json:"success" example:"true" description:"Whether the action was successful"`
+	Message   string `json:"message" example:"Successfully blocked johndoe" description:"Response message"`
+	IsBlocked bool   `json:"is_blocked" example:"true" description:"Whether the current user blocks this user"`
+	IsMuted   bool   `json:"is_muted" example:"false" description:"Whether the current user mutes this user"`
+}
+
+// BlockedUserResponse represents a user in the blocked or muted list
+// @Description Blocked or muted user
+type BlockedUserResponse struct {
+	ID          uint      `json:"id" example:"12345" description:"User's unique identifier"`
+	Username    string    `json:"username" example:"johndoe" description:"User's username"`
+	DisplayName string    `json:"display_name" example:"John Doe" description:"User's display name"`
+	AvatarURL   string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"URL to user's avatar"`
+	HasAvatar   bool      `json:"has_avatar" example:"true" description:"Whether user has an avatar"`
+	Since       time.Time `json:"since" example:"2024-01-15T10:30:00Z" description:"When the user was blocked or muted"`
+}
@@ -0,0 +1,11 @@
+package types
+
+import "time"
+
+// CalendarFeedResponse represents a user's calendar feed subscription
+// @Description Calendar feed response payload
+type CalendarFeedResponse struct {
+	Token     string    `json:"token" example:"k3J9x...Qw" description:"Secret feed token (only shown once)"`
+	FeedURL   string    `json:"feed_url" example:"/api/calendar/feed/k3J9x...Qw.ics" description:"URL to subscribe to from a calendar app"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the token was created"`
+}
@@ -15,6 +15,10 @@ const (
 	NotificationTypeFollow  NotificationType = "follow"
 	NotificationTypeMessage NotificationType = "message"
 	NotificationTypeSystem  NotificationType = "system"
+	// Live-only stream events for direct messages and event chats; they aren't stored as notifications
+	NotificationTypeDirectMessage NotificationType = "direct_message"
+	NotificationTypeMessageRead   NotificationType = "message_read"
+	NotificationTypeEventMessage  NotificationType = "event_message"
 )
 
 func (ps PostStatus) IsValid() bool {
@@ -27,7 +31,8 @@ func (ps PostStatus) IsValid() bool {
 
 func (nt NotificationType) IsValid() bool {
 	switch nt {
-	case NotificationTypeInvite, NotificationTypeFollow, NotificationTypeMessage, NotificationTypeSystem:
+	case NotificationTypeInvite, NotificationTypeFollow, NotificationTypeMessage, NotificationTypeSystem,
+		NotificationTypeDirectMessage, NotificationTypeMessageRead, NotificationTypeEventMessage:
 		return true
 	}
 	return false
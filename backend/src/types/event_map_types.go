@@ -0,0 +1,39 @@
+package types
+
+import "time"
+
+// EventMapResponse represents the events inside a map viewport, clustered when zoomed out
+// @Description Event map response payload
+type EventMapResponse struct {
+	Zoom      int                  `json:"zoom" example:"11" description:"Zoom level the response was computed for"`
+	Clustered bool                 `json:"clustered" example:"true" description:"Whether events were grouped into clusters"`
+	Clusters  []MapClusterResponse `json:"clusters" description:"Groups of nearby events (empty when not clustered)"`
+	Events    []MapEventResponse   `json:"events" description:"Individual event pins: every event when zoomed in, otherwise events alone in their cell"`
+	Truncated bool                 `json:"truncated" example:"false" description:"Whether individual events were cut off at the maximum number of pins"`
+}
+
+// MapClusterResponse represents a group of nearby events on the map
+// @Description Map cluster response payload
+type MapClusterResponse struct {
+	Count         int     `json:"count" example:"14" description:"Number of events in the cluster"`
+	Latitude      float64 `json:"latitude" example:"52.3702" description:"Latitude of the cluster centroid"`
+	Longitude     float64 `json:"longitude" example:"4.8952" description:"Longitude of the cluster centroid"`
+	DominantSport string  `json:"dominant_sport" example:"Football" description:"Most common sport in the cluster"`
+	MinLatitude   float64 `json:"min_latitude" example:"52.3501" description:"Southern edge of the cluster's events"`
+	MinLongitude  float64 `json:"min_longitude" example:"4.8712" description:"Western edge of the cluster's events"`
+	MaxLatitude   float64 `json:"max_latitude" example:"52.3911" description:"Northern edge of the cluster's events"`
+	MaxLongitude  float64 `json:"max_longitude" example:"4.9233" description:"Eastern edge of the cluster's events"`
+}
+
+// MapEventResponse represents a single event pin on the map
+// @Description Map event pin response payload
+type MapEventResponse struct {
+	ID        uint        `json:"id" example:"1" description:"Event unique identifier"`
+	Title     string      `json:"title" example:"Friday Basketball Game" description:"Event title"`
+	Type      EventType   `json:"type" example:"game" description:"Type of event"`
+	Sport     string      `json:"sport" example:"Basketball" description:"Sport name"`
+	Status    EventStatus `json:"status" example:"upcoming" description:"Event status"`
+	StartAt   time.Time   `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Event start time"`
+	Latitude  float64     `json:"latitude" example:"40.7829" description:"Location latitude"`
+	Longitude float64     `json:"longitude" example:"-73.9654" description:"Location longitude"`
+}
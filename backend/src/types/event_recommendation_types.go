@@ -0,0 +1,25 @@
+package types
+
+// EventRecommendationResponse represents an upcoming event in the "for you" feed
+// @Description Recommended event with its ranking score
+type EventRecommendationResponse struct {
+	EventWithOrganizerResponse
+	Score          float64                     `json:"score" example:"6.35" description:"Total ranking score, the sum of the breakdown's points"`
+	ScoreBreakdown EventScoreBreakdownResponse `json:"score_breakdown" description:"How each signal contributed to the score"`
+}
+
+// EventScoreBreakdownResponse explains an event's "for you" score
+// @Description Points per ranking signal and the inputs behind them
+type EventScoreBreakdownResponse struct {
+	Sport                float64  `json:"sport" example:"3" description:"Points for being one of the user's sports (max 3)"`
+	Distance             float64  `json:"distance" example:"1.62" description:"Points for being close to the user's home location, 0 from 50 km (max 2)"`
+	Friends              float64  `json:"friends" example:"0.67" description:"Points for people the user follows taking part, full from 3 (max 2)"`
+	OrganizerReliability float64  `json:"organizer_reliability" example:"0.92" description:"Points for the organizer's attendance reliability, 0.5 while untracked (max 1)"`
+	TimeOfWeek           float64  `json:"time_of_week" example:"0.5" description:"Points for the time of week relative to the user's busiest slot in past joins (max 1)"`
+	SportMatch           bool     `json:"sport_match" example:"true" description:"Whether the event's sport is one of the user's sports"`
+	DistanceKm           *float64 `json:"distance_km,omitempty" example:"9.5" description:"Distance from the user's home location (absent without one)"`
+	FriendsAttending     int      `json:"friends_attending" example:"1" description:"Number of people the user follows taking part"`
+	ReliabilityPercent   *int     `json:"reliability_percent,omitempty" example:"92" description:"Organizer's attendance reliability (absent until tracked)"`
+	TimeSlot             string   `json:"time_slot" example:"Saturday morning" description:"Part of the week the event starts in"`
+	TimeSlotJoins        int      `json:"time_slot_joins" example:"4" description:"Number of past events the user joined in the same slot"`
+}
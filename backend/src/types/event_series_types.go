@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency represents how often a series repeats
+type RecurrenceFrequency string
+
+const (
+	RecurrenceWeekly   RecurrenceFrequency = "weekly"
+	RecurrenceBiweekly RecurrenceFrequency = "biweekly"
+	RecurrenceMonthly  RecurrenceFrequency = "monthly"
+)
+
+func (rf RecurrenceFrequency) IsValid() bool {
+	switch rf {
+	case RecurrenceWeekly, RecurrenceBiweekly, RecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+// SeriesEditScope selects which occurrences of a series an edit or cancellation applies to
+type SeriesEditScope string
+
+const (
+	SeriesScopeThis      SeriesEditScope = "this"
+	SeriesScopeFollowing SeriesEditScope = "following"
+	SeriesScopeAll       SeriesEditScope = "all"
+)
+
+func (s SeriesEditScope) IsValid() bool {
+	switch s {
+	case SeriesScopeThis, SeriesScopeFollowing, SeriesScopeAll:
+		return true
+	}
+	return false
+}
+
+// SeriesStatus represents the status of a recurring series
+type SeriesStatus string
+
+const (
+	SeriesStatusActive    SeriesStatus = "active"
+	SeriesStatusCancelled SeriesStatus = "cancelled"
+)
+
+// CreateEventSeriesRequest represents the request for creating a recurring event series
+// @Description Recurring event series creation request payload
+type CreateEventSeriesRequest struct {
+	CreateEventRequest
+	Frequency RecurrenceFrequency `json:"frequency" validate:"required,oneof=weekly biweekly monthly" example:"weekly" description:"How often the event repeats"`
+	Timezone  string              `json:"timezone,omitempty" example:"Europe/Amsterdam" description:"IANA time zone the recurrence is anchored to (defaults to UTC)"`
+	Until     *time.Time          `json:"until,omitempty" example:"2025-06-30T23:59:59Z" description:"Last possible start time of an occurrence (mutually exclusive with count)"`
+	Count     *int                `json:"count,omitempty" validate:"omitempty,min=1,max=520" example:"10" description:"Total number of occurrences (mutually exclusive with until)"`
+}
+
+// EventSeriesResponse represents a recurring event series with its upcoming occurrences
+// @Description Recurring event series response payload
+type EventSeriesResponse struct {
+	ID              uint                `json:"id" example:"1" description:"Series unique identifier"`
+	OrganizerID     uint                `json:"organizer_id" example:"12345" description:"Organizer's user ID"`
+	Type            EventType           `json:"type" example:"training" description:"Type of the recurring event"`
+	Title           string              `json:"title" example:"Tuesday Training" description:"Series title"`
+	Description     string              `json:"description" example:"Weekly club training" description:"Series description"`
+	Sport           string              `json:"sport" example:"Football" description:"Sport name"`
+	LocationName    string              `json:"location_name" example:"Central Park Field 2" description:"Location name"`
+	Latitude        float64             `json:"latitude" example:"40.7829" description:"Location latitude"`
+	Longitude       float64             `json:"longitude" example:"-73.9654" description:"Location longitude"`
+	VenueID         *uint               `json:"venue_id,omitempty" example:"4" description:"Venue the occurrences take place at"`
+	Capacity        *int                `json:"capacity,omitempty" example:"22" description:"Maximum participants per occurrence"`
+	Visibility      EventVisibility     `json:"visibility" example:"public" description:"Who can see the occurrences"`
+	Frequency       RecurrenceFrequency `json:"frequency" example:"weekly" description:"How often the event repeats"`
+	Timezone        string              `json:"timezone" example:"Europe/Amsterdam" description:"Time zone the recurrence is anchored to"`
+	StartAt         time.Time           `json:"start_at" example:"2024-12-17T19:00:00Z" description:"Start of the first occurrence"`
+	DurationMinutes *int                `json:"duration_minutes,omitempty" example:"90" description:"Length of each occurrence"`
+	Until           *time.Time          `json:"until,omitempty" example:"2025-06-30T23:59:59Z" description:"Last possible start time of an occurrence"`
+	Count           *int                `json:"count,omitempty" example:"10" description:"Total number of occurrences"`
+	RRule           string              `json:"rrule" example:"FREQ=WEEKLY;COUNT=10" description:"RFC 5545 recurrence rule"`
+	Status          SeriesStatus        `json:"status" example:"active" description:"Series status"`
+	Occurrences     []EventResponse     `json:"occurrences" description:"Upcoming materialized occurrences"`
+	CreatedAt       time.Time           `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt       time.Time           `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
+
+// BuildRRule renders the recurrence as an RFC 5545 RRULE value (without the "RRULE:" prefix)
+func BuildRRule(frequency RecurrenceFrequency, until *time.Time, count *int) string {
+	parts := []string{}
+	switch frequency {
+	case RecurrenceWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case RecurrenceBiweekly:
+		parts = append(parts, "FREQ=WEEKLY", "INTERVAL=2")
+	case RecurrenceMonthly:
+		parts = append(parts, "FREQ=MONTHLY")
+	}
+	if count != nil {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", *count))
+	} else if until != nil {
+		parts = append(parts, "UNTIL="+until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
@@ -21,66 +21,187 @@ const (
 	EventStatusCancelled EventStatus = "cancelled"
 )
 
+// EventVisibility controls who can see and join an event
+type EventVisibility string
+
+const (
+	EventVisibilityPublic    EventVisibility = "public"
+	EventVisibilityFollowers EventVisibility = "followers"
+	EventVisibilityInvite    EventVisibility = "invite"
+)
+
+func (ev EventVisibility) IsValid() bool {
+	switch ev {
+	case EventVisibilityPublic, EventVisibilityFollowers, EventVisibilityInvite:
+		return true
+	}
+	return false
+}
+
+// InvitationStatus represents the state of an event invitation
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+)
+
+// JoinMode controls whether users join an event directly or ask the organizer first
+type JoinMode string
+
+const (
+	JoinModeOpen     JoinMode = "open"
+	JoinModeApproval JoinMode = "approval"
+)
+
+func (jm JoinMode) IsValid() bool {
+	switch jm {
+	case JoinModeOpen, JoinModeApproval:
+		return true
+	}
+	return false
+}
+
+// ParticipationStatus represents the state of a user's participation or request to join
+type ParticipationStatus string
+
+const (
+	ParticipationStatusPending  ParticipationStatus = "pending"
+	ParticipationStatusAccepted ParticipationStatus = "accepted"
+	ParticipationStatusRejected ParticipationStatus = "rejected"
+)
+
+// ParticipantRole is the role of a user in an event
+type ParticipantRole string
+
+const (
+	// ParticipantRoleOrganizer is never stored on a participation; it is reported for the event owner
+	ParticipantRoleOrganizer   ParticipantRole = "organizer"
+	ParticipantRoleCoOrganizer ParticipantRole = "co_organizer"
+	ParticipantRoleCaptain     ParticipantRole = "captain"
+	ParticipantRoleReferee     ParticipantRole = "referee"
+	ParticipantRoleParticipant ParticipantRole = "participant"
+)
+
+// IsAssignable reports whether the role can be given to a participant
+func (pr ParticipantRole) IsAssignable() bool {
+	switch pr {
+	case ParticipantRoleCoOrganizer, ParticipantRoleCaptain, ParticipantRoleReferee, ParticipantRoleParticipant:
+		return true
+	}
+	return false
+}
+
+// Label returns a human readable form of the role for notifications
+func (pr ParticipantRole) Label() string {
+	switch pr {
+	case ParticipantRoleOrganizer:
+		return "the organizer"
+	case ParticipantRoleCoOrganizer:
+		return "a co-organizer"
+	case ParticipantRoleCaptain:
+		return "a captain"
+	case ParticipantRoleReferee:
+		return "a referee"
+	}
+	return "a participant"
+}
+
 // CreateEventRequest represents the request for creating an event
 // @Description Event creation request payload
 type CreateEventRequest struct {
-	Type         EventType  `json:"type" validate:"required,oneof=game event training" example:"game" description:"Type of event"`
-	Title        string     `json:"title" validate:"required,min=3,max=255" example:"Friday Basketball Game" description:"Event title"`
-	Description  string     `json:"description" validate:"max=1000" example:"Friendly basketball match at the local court" description:"Event description"`
-	Sport        string     `json:"sport" validate:"required,min=2,max=100" example:"Basketball" description:"Sport name"`
-	StartAt      time.Time  `json:"start_at" validate:"required" example:"2024-12-20T18:00:00Z" description:"Event start date and time"`
-	EndAt        *time.Time `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Optional event end date and time"`
-	LocationName string     `json:"location_name" validate:"required,min=3,max=255" example:"Central Park Basketball Court" description:"Event location name"`
-	Latitude     float64    `json:"latitude" validate:"required" example:"40.7829" description:"Location latitude"`
-	Longitude    float64    `json:"longitude" validate:"required" example:"-73.9654" description:"Location longitude"`
-	Capacity     *int       `json:"capacity,omitempty" validate:"omitempty,min=2,max=1000" example:"10" description:"Maximum number of participants"`
+	Type           EventType       `json:"type" validate:"required,oneof=game event training" example:"game" description:"Type of event"`
+	Title          string          `json:"title" validate:"required,min=3,max=255" example:"Friday Basketball Game" description:"Event title"`
+	Description    string          `json:"description" validate:"max=1000" example:"Friendly basketball match at the local court" description:"Event description"`
+	Sport          string          `json:"sport" validate:"required,min=2,max=100" example:"Basketball" description:"Sport name"`
+	StartAt        time.Time       `json:"start_at" validate:"required" example:"2024-12-20T18:00:00Z" description:"Event start date and time"`
+	EndAt          *time.Time      `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Optional event end date and time"`
+	LocationName   string          `json:"location_name" validate:"required,min=3,max=255" example:"Central Park Basketball Court" description:"Event location name"`
+	Latitude       float64         `json:"latitude" validate:"required" example:"40.7829" description:"Location latitude"`
+	Longitude      float64         `json:"longitude" validate:"required" example:"-73.9654" description:"Location longitude"`
+	VenueID        *uint           `json:"venue_id,omitempty" example:"4" description:"Venue of the event; its name and coordinates replace location_name, latitude and longitude"`
+	ResourceID     *uint           `json:"resource_id,omitempty" example:"2" description:"Court or field of a venue to book; implies its venue"`
+	GroupID        *uint           `json:"group_id,omitempty" example:"5" description:"Group the event belongs to; events of private groups are only visible to members"`
+	AllowConflicts bool            `json:"allow_conflicts,omitempty" example:"false" description:"Book the resource even when it is taken or the venue is closed; conflicts are returned as warnings"`
+	Capacity       *int            `json:"capacity,omitempty" validate:"omitempty,min=2,max=1000" example:"10" description:"Maximum number of participants"`
+	Visibility     EventVisibility `json:"visibility,omitempty" validate:"omitempty,oneof=public followers invite" example:"public" description:"Who can see the event: public, followers or invite (defaults to public)"`
+	MinReliability *int            `json:"min_reliability,omitempty" validate:"omitempty,min=0,max=100" example:"80" description:"Minimum attendance reliability (percent) required to join"`
+	JoinMode       JoinMode        `json:"join_mode,omitempty" validate:"omitempty,oneof=open approval" example:"approval" description:"How users join: open or approval (defaults to open)"`
 }
 
 // UpdateEventRequest represents the request for updating an event
 // @Description Event update request payload
 type UpdateEventRequest struct {
-	Type         *EventType `json:"type,omitempty" validate:"omitempty,oneof=game event training" example:"game" description:"Updated event type"`
-	Title        *string    `json:"title,omitempty" validate:"omitempty,min=3,max=255" example:"Friday Basketball Game" description:"Updated event title"`
-	Description  *string    `json:"description,omitempty" validate:"omitempty,max=1000" example:"Friendly basketball match" description:"Updated event description"`
-	Sport        *string    `json:"sport,omitempty" validate:"omitempty,min=2,max=100" example:"Basketball" description:"Updated sport name"`
-	StartAt      *time.Time `json:"start_at,omitempty" example:"2024-12-20T18:00:00Z" description:"Updated event start time"`
-	EndAt        *time.Time `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Updated event end time"`
-	LocationName *string    `json:"location_name,omitempty" validate:"omitempty,min=3,max=255" example:"Central Park" description:"Updated location name"`
-	Latitude     *float64   `json:"latitude,omitempty" example:"40.7829" description:"Updated latitude"`
-	Longitude    *float64   `json:"longitude,omitempty" example:"-73.9654" description:"Updated longitude"`
-	Capacity     *int       `json:"capacity,omitempty" validate:"omitempty,min=2,max=1000" example:"10" description:"Updated capacity"`
+	Type           *EventType       `json:"type,omitempty" validate:"omitempty,oneof=game event training" example:"game" description:"Updated event type"`
+	Title          *string          `json:"title,omitempty" validate:"omitempty,min=3,max=255" example:"Friday Basketball Game" description:"Updated event title"`
+	Description    *string          `json:"description,omitempty" validate:"omitempty,max=1000" example:"Friendly basketball match" description:"Updated event description"`
+	Sport          *string          `json:"sport,omitempty" validate:"omitempty,min=2,max=100" example:"Basketball" description:"Updated sport name"`
+	StartAt        *time.Time       `json:"start_at,omitempty" example:"2024-12-20T18:00:00Z" description:"Updated event start time"`
+	EndAt          *time.Time       `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Updated event end time"`
+	LocationName   *string          `json:"location_name,omitempty" validate:"omitempty,min=3,max=255" example:"Central Park" description:"Updated location name"`
+	Latitude       *float64         `json:"latitude,omitempty" example:"40.7829" description:"Updated latitude"`
+	Longitude      *float64         `json:"longitude,omitempty" example:"-73.9654" description:"Updated longitude"`
+	VenueID        *uint            `json:"venue_id,omitempty" example:"4" description:"Updated venue (0 removes it); changing the location without a venue also removes it"`
+	ResourceID     *uint            `json:"resource_id,omitempty" example:"2" description:"Updated booked resource (0 removes it); implies its venue"`
+	AllowConflicts bool             `json:"allow_conflicts,omitempty" example:"false" description:"Keep the booking even when it conflicts; conflicts are returned as warnings"`
+	Capacity       *int             `json:"capacity,omitempty" validate:"omitempty,min=2,max=1000" example:"10" description:"Updated capacity"`
+	Visibility     *EventVisibility `json:"visibility,omitempty" validate:"omitempty,oneof=public followers invite" example:"invite" description:"Updated visibility"`
+	MinReliability *int             `json:"min_reliability,omitempty" validate:"omitempty,min=0,max=100" example:"80" description:"Updated minimum reliability (0 removes the restriction)"`
+	JoinMode       *JoinMode        `json:"join_mode,omitempty" validate:"omitempty,oneof=open approval" example:"approval" description:"Updated join mode"`
 }
 
 // EventResponse represents the response for event operations
 // @Description Event response payload
 type EventResponse struct {
-	ID           uint        `json:"id" example:"1" description:"Event unique identifier"`
-	OrganizerID  uint        `json:"organizer_id" example:"12345" description:"Organizer's user ID"`
-	Type         EventType   `json:"type" example:"game" description:"Type of event"`
-	Title        string      `json:"title" example:"Friday Basketball Game" description:"Event title"`
-	Description  string      `json:"description" example:"Friendly basketball match" description:"Event description"`
-	Sport        string      `json:"sport" example:"Basketball" description:"Sport name"`
-	StartAt      time.Time   `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Event start time"`
-	EndAt        *time.Time  `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Event end time"`
-	LocationName string      `json:"location_name" example:"Central Park Basketball Court" description:"Location name"`
-	Latitude     float64     `json:"latitude" example:"40.7829" description:"Location latitude"`
-	Longitude    float64     `json:"longitude" example:"-73.9654" description:"Location longitude"`
-	Capacity     *int        `json:"capacity,omitempty" example:"10" description:"Maximum participants"`
-	Participants int         `json:"participants" example:"5" description:"Current number of participants"`
-	Status       EventStatus `json:"status" example:"upcoming" description:"Event status"`
-	CreatedAt    time.Time   `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
-	UpdatedAt    time.Time   `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+	ID             uint                      `json:"id" example:"1" description:"Event unique identifier"`
+	OrganizerID    uint                      `json:"organizer_id" example:"12345" description:"Organizer's user ID"`
+	Type           EventType                 `json:"type" example:"game" description:"Type of event"`
+	Title          string                    `json:"title" example:"Friday Basketball Game" description:"Event title"`
+	Description    string                    `json:"description" example:"Friendly basketball match" description:"Event description"`
+	Sport          string                    `json:"sport" example:"Basketball" description:"Sport name"`
+	StartAt        time.Time                 `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Event start time"`
+	EndAt          *time.Time                `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Event end time"`
+	LocationName   string                    `json:"location_name" example:"Central Park Basketball Court" description:"Location name"`
+	Latitude       float64                   `json:"latitude" example:"40.7829" description:"Location latitude"`
+	Longitude      float64                   `json:"longitude" example:"-73.9654" description:"Location longitude"`
+	VenueID        *uint                     `json:"venue_id,omitempty" example:"4" description:"Venue the event takes place at"`
+	ResourceID     *uint                     `json:"resource_id,omitempty" example:"2" description:"Court or field of the venue booked by the event"`
+	Capacity       *int                      `json:"capacity,omitempty" example:"10" description:"Maximum participants"`
+	Participants   int                       `json:"participants" example:"5" description:"Current number of participants"`
+	WaitlistCount  int                       `json:"waitlist_count" example:"2" description:"Number of users waiting for a spot"`
+	Status         EventStatus               `json:"status" example:"upcoming" description:"Event status"`
+	Visibility     EventVisibility           `json:"visibility" example:"public" description:"Who can see the event"`
+	MinReliability *int                      `json:"min_reliability,omitempty" example:"80" description:"Minimum attendance reliability (percent) required to join"`
+	JoinMode       JoinMode                  `json:"join_mode" example:"open" description:"How users join: open or approval"`
+	CheckInOpen    bool                      `json:"check_in_open" example:"false" description:"Whether a check-in code is currently valid"`
+	SeriesID       *uint                     `json:"series_id,omitempty" example:"3" description:"Recurring series this event is an occurrence of"`
+	ParentEventID  *uint                     `json:"parent_event_id,omitempty" example:"1" description:"Tournament event this game is a match of"`
+	GroupID        *uint                     `json:"group_id,omitempty" example:"5" description:"Group the event belongs to"`
+	CreatedAt      time.Time                 `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt      time.Time                 `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+	Conflicts      []BookingConflictResponse `json:"conflicts,omitempty" description:"Booking conflicts accepted with allow_conflicts"`
 }
 
 // EventWithOrganizerResponse represents an event with organizer information
 // @Description Event with organizer information response payload
 type EventWithOrganizerResponse struct {
 	EventResponse
-	OrganizerName     string  `json:"organizer_name" example:"John Doe" description:"Organizer's display name"`
-	OrganizerUsername string  `json:"organizer_username" example:"johndoe" description:"Organizer's username"`
-	OrganizerAvatar   *string `json:"organizer_avatar,omitempty" example:"/api/user/12345/avatar" description:"Organizer's avatar URL"`
-	IsOrganizer       bool    `json:"is_organizer" example:"false" description:"Whether current user is the organizer"`
-	IsParticipant     bool    `json:"is_participant" example:"true" description:"Whether current user is participating"`
+	OrganizerName     string               `json:"organizer_name" example:"John Doe" description:"Organizer's display name"`
+	OrganizerUsername string               `json:"organizer_username" example:"johndoe" description:"Organizer's username"`
+	OrganizerAvatar   *string              `json:"organizer_avatar,omitempty" example:"/api/user/12345/avatar" description:"Organizer's avatar URL"`
+	IsOrganizer       bool                 `json:"is_organizer" example:"false" description:"Whether current user is the organizer"`
+	IsParticipant     bool                 `json:"is_participant" example:"true" description:"Whether current user is participating"`
+	IsWaitlisted      bool                 `json:"is_waitlisted" example:"false" description:"Whether current user is on the waitlist"`
+	IsCheckedIn       bool                 `json:"is_checked_in" example:"false" description:"Whether current user has checked in"`
+	WaitlistPosition  *int                 `json:"waitlist_position,omitempty" example:"3" description:"Current user's position on the waitlist (1 = next in line)"`
+	InvitationStatus  *InvitationStatus    `json:"invitation_status,omitempty" example:"pending" description:"Current user's invitation status, if invited"`
+	JoinRequestStatus *ParticipationStatus `json:"join_request_status,omitempty" example:"pending" description:"Current user's request to join an approval-required event, if not accepted yet"`
+	Role              *ParticipantRole     `json:"role,omitempty" example:"co_organizer" description:"Current user's role in the event, if taking part"`
+	CanManage         bool                 `json:"can_manage" example:"false" description:"Whether current user can edit the event and manage its roster"`
+	DistanceKm        *float64             `json:"distance_km,omitempty" example:"3.42" description:"Great-circle distance from the lat/lng given in the query, in kilometers"`
+	Sides             []EventSideResponse  `json:"sides,omitempty" description:"Sides/teams of a game (only on event details)"`
+	Result            *GameResultResponse  `json:"result,omitempty" description:"Recorded result of a game (only on event details)"`
 }
 
 // CalculateEventStatus determines the appropriate status based on current time and event times
@@ -114,3 +235,103 @@ func CalculateEventStatus(startAt time.Time, endAt *time.Time) EventStatus {
 type JoinEventRequest struct {
 	EventID uint `json:"event_id" validate:"required" example:"1" description:"Event ID to join"`
 }
+
+// WaitlistEntryResponse represents a user waiting for a spot in a full event
+// @Description Waitlist entry response payload
+type WaitlistEntryResponse struct {
+	UserID       uint      `json:"user_id" example:"12345" description:"Waiting user's ID"`
+	Username     string    `json:"username" example:"johndoe" description:"Waiting user's username"`
+	DisplayName  string    `json:"display_name" example:"John Doe" description:"Waiting user's display name"`
+	AvatarURL    string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"Waiting user's avatar URL"`
+	Position     int       `json:"position" example:"1" description:"Position in the queue (1 = next in line)"`
+	WaitingSince time.Time `json:"waiting_since" example:"2024-12-18T09:00:00Z" description:"When the user joined the waitlist"`
+}
+
+// InviteUsersRequest represents the request to invite users to an event
+// @Description Event invitation request payload
+type InviteUsersRequest struct {
+	UserIDs []uint `json:"user_ids" validate:"required,min=1,max=100" example:"12,34" description:"IDs of the users to invite"`
+}
+
+// EventInvitationResponse represents an invitation to an event
+// @Description Event invitation response payload
+type EventInvitationResponse struct {
+	ID          uint             `json:"id" example:"1" description:"Invitation unique identifier"`
+	EventID     uint             `json:"event_id" example:"1" description:"Invited-to event ID"`
+	EventTitle  string           `json:"event_title" example:"Friday Basketball Game" description:"Invited-to event title"`
+	StartAt     time.Time        `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Event start time"`
+	InviterID   uint             `json:"inviter_id" example:"12345" description:"Inviting user's ID"`
+	InviteeID   uint             `json:"invitee_id" example:"67890" description:"Invited user's ID"`
+	Username    string           `json:"username" example:"janedoe" description:"Invited user's username"`
+	DisplayName string           `json:"display_name" example:"Jane Doe" description:"Invited user's display name"`
+	Status      InvitationStatus `json:"status" example:"pending" description:"Invitation status"`
+	RespondedAt *time.Time       `json:"responded_at,omitempty" example:"2024-12-18T09:00:00Z" description:"When the invitee accepted or declined"`
+	CreatedAt   time.Time        `json:"created_at" example:"2024-12-17T09:00:00Z" description:"When the invitation was sent"`
+}
+
+// CheckInCodeResponse represents a short-lived check-in code for an event
+// @Description Check-in code response payload
+type CheckInCodeResponse struct {
+	Code      string    `json:"code" example:"482913" description:"Code participants enter to check in"`
+	QRPayload string    `json:"qr_payload" example:"link2sport://check-in?event=1&code=482913" description:"Payload to render as a QR code"`
+	ExpiresAt time.Time `json:"expires_at" example:"2024-12-20T18:15:00Z" description:"When the code stops working"`
+}
+
+// CheckInRequest represents a participant checking in with a code
+// @Description Check-in request payload
+type CheckInRequest struct {
+	Code string `json:"code" validate:"required" example:"482913" description:"Check-in code shown by the organizer"`
+}
+
+// SetCheckInRequest represents the organizer toggling a participant's attendance
+// @Description Manual check-in request payload
+type SetCheckInRequest struct {
+	CheckedIn bool `json:"checked_in" example:"true" description:"Whether the participant attended"`
+}
+
+// SetParticipantRoleRequest represents the organizer changing a participant's role
+// @Description Participant role request payload
+type SetParticipantRoleRequest struct {
+	Role ParticipantRole `json:"role" validate:"required,oneof=co_organizer captain referee participant" example:"co_organizer" description:"New role: co_organizer, captain, referee or participant"`
+}
+
+// TransferOwnershipRequest represents the organizer handing an event over to a participant
+// @Description Ownership transfer request payload
+type TransferOwnershipRequest struct {
+	UserID uint `json:"user_id" validate:"required" example:"67890" description:"Participant who becomes the new organizer"`
+}
+
+// EventParticipantResponse represents a participant of an event or a user asking to join it
+// @Description Event participant response payload
+type EventParticipantResponse struct {
+	ID          uint                `json:"id" example:"1" description:"Participation or join request identifier"`
+	EventID     uint                `json:"event_id" example:"1" description:"Event ID"`
+	UserID      uint                `json:"user_id" example:"12345" description:"User ID"`
+	Username    string              `json:"username" example:"johndoe" description:"User's username"`
+	DisplayName string              `json:"display_name" example:"John Doe" description:"User's display name"`
+	AvatarURL   string              `json:"avatar_url" example:"/api/user/12345/avatar" description:"User's avatar URL"`
+	Role        ParticipantRole     `json:"role,omitempty" example:"participant" description:"Role in the event (accepted participants only)"`
+	TeamID      *uint               `json:"team_id,omitempty" example:"7" description:"Team the participant was signed up with"`
+	Status      ParticipationStatus `json:"status" example:"accepted" description:"accepted participants, or pending/rejected join requests"`
+	CheckedInAt *time.Time          `json:"checked_in_at,omitempty" example:"2024-12-20T18:05:00Z" description:"When the participant checked in"`
+	JoinedAt    time.Time           `json:"joined_at" example:"2024-12-18T09:00:00Z" description:"When the user joined, or asked to join"`
+	RespondedAt *time.Time          `json:"responded_at,omitempty" example:"2024-12-18T12:00:00Z" description:"When a join request was answered"`
+}
+
+// RemoveParticipantRequest represents a manager removing a participant, optionally banning them
+// @Description Participant removal request payload
+type RemoveParticipantRequest struct {
+	Reason string `json:"reason,omitempty" validate:"max=500" example:"Repeatedly late" description:"Optional reason shared with the removed user"`
+	Ban    bool   `json:"ban,omitempty" example:"false" description:"Also prevent the user from joining this event again"`
+}
+
+// EventBanResponse represents a user banned from an event
+// @Description Event ban response payload
+type EventBanResponse struct {
+	UserID      uint      `json:"user_id" example:"67890" description:"Banned user's ID"`
+	Username    string    `json:"username" example:"janedoe" description:"Banned user's username"`
+	DisplayName string    `json:"display_name" example:"Jane Doe" description:"Banned user's display name"`
+	Reason      string    `json:"reason,omitempty" example:"Repeatedly late" description:"Reason given when banning"`
+	BannedByID  uint      `json:"banned_by_id" example:"12345" description:"Who banned the user"`
+	CreatedAt   time.Time `json:"created_at" example:"2024-12-18T09:00:00Z" description:"When the user was banned"`
+}
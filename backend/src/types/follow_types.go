@@ -8,6 +8,7 @@ type FollowResponse struct {
 	Success     bool   `json:"success" example:"true" description:"Whether the action was successful"`
 	Message     string `json:"message" example:"Successfully followed user" description:"Response message"`
 	IsFollowing bool   `json:"is_following" example:"true" description:"Current following status"`
+	IsRequested bool   `json:"is_requested" example:"false" description:"Whether a follow request to this private account is waiting for approval"`
 }
 
 // FollowStatsResponse represents follow statistics for a user
@@ -50,3 +51,14 @@ type FollowListResponse struct {
 	PerPage    int         `json:"per_page" example:"20" description:"Items per page"`
 	HasNext    bool        `json:"has_next" example:"true" description:"Whether there are more pages"`
 }
+
+// FollowRequestResponse represents a pending request to follow the current user
+// @Description Pending follow request
+type FollowRequestResponse struct {
+	ID          uint      `json:"id" example:"12345" description:"Requesting user's unique identifier"`
+	Username    string    `json:"username" example:"johndoe" description:"Requesting user's username"`
+	DisplayName string    `json:"display_name" example:"John Doe" description:"Requesting user's display name"`
+	AvatarURL   string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"URL to requesting user's avatar"`
+	HasAvatar   bool      `json:"has_avatar" example:"true" description:"Whether requesting user has an avatar"`
+	RequestedAt time.Time `json:"requested_at" example:"2024-01-15T10:30:00Z" description:"When the request was made"`
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// ScoreFormat describes how a sport's final score is recorded
+type ScoreFormat string
+
+const (
+	ScoreFormatSets   ScoreFormat = "sets"
+	ScoreFormatGoals  ScoreFormat = "goals"
+	ScoreFormatPoints ScoreFormat = "points"
+)
+
+// ScoreFormatForSport returns the score format used for a sport; unknown sports use points
+func ScoreFormatForSport(sport string) ScoreFormat {
+	switch strings.ToLower(strings.TrimSpace(sport)) {
+	case "tennis", "table tennis", "badminton", "volleyball":
+		return ScoreFormatSets
+	case "football", "soccer", "hockey", "ultimate frisbee":
+		return ScoreFormatGoals
+	}
+	return ScoreFormatPoints
+}
+
+// ResultStatus represents the confirmation state of a game result
+type ResultStatus string
+
+const (
+	ResultStatusPending   ResultStatus = "pending"
+	ResultStatusConfirmed ResultStatus = "confirmed"
+	ResultStatusDisputed  ResultStatus = "disputed"
+)
+
+// GameOutcome is a single user's outcome of a game
+type GameOutcome string
+
+const (
+	GameOutcomeWin  GameOutcome = "win"
+	GameOutcomeLoss GameOutcome = "loss"
+	GameOutcomeDraw GameOutcome = "draw"
+)
+
+// SideRequest describes one side/team of a game and its players
+type SideRequest struct {
+	Name    string `json:"name" validate:"required,min=1,max=100" example:"Red team" description:"Side name"`
+	UserIDs []uint `json:"user_ids" validate:"required,min=1" example:"12,34" description:"Participants playing on this side"`
+}
+
+// SetEventSidesRequest represents the request for splitting participants into sides
+// @Description Event sides request payload
+type SetEventSidesRequest struct {
+	Sides []SideRequest `json:"sides" validate:"required,min=2,dive" description:"Sides of the game (at least two)"`
+}
+
+// SideScoreRequest is the final score of one side
+type SideScoreRequest struct {
+	SideID uint  `json:"side_id" validate:"required" example:"1" description:"Side ID"`
+	Score  *int  `json:"score,omitempty" validate:"omitempty,min=0" example:"3" description:"Goals or points (goals/points formats)"`
+	Sets   []int `json:"sets,omitempty" example:"6,3,7" description:"Games won in each set (sets format)"`
+}
+
+// MatchSideScore is the score of one side of a two-sided match
+type MatchSideScore struct {
+	Score *int  `json:"score,omitempty" binding:"omitempty,min=0" example:"21" description:"Goals or points (goals/points formats)"`
+	Sets  []int `json:"sets,omitempty" example:"6,4" description:"Games won in each set (sets format)"`
+}
+
+// RecordResultRequest represents the request for recording a game's final score
+// @Description Game result request payload
+type RecordResultRequest struct {
+	Scores []SideScoreRequest `json:"scores" validate:"required,min=2,dive" description:"Score of every side"`
+}
+
+// ResultResponseRequest represents a participant confirming or disputing a result
+// @Description Result confirmation/dispute request payload
+type ResultResponseRequest struct {
+	Comment string `json:"comment,omitempty" validate:"max=500" example:"The second set was 6-4, not 6-3" description:"Optional comment, e.g. why the result is disputed"`
+}
+
+// EventSideResponse represents a side/team of a game
+// @Description Event side response payload
+type EventSideResponse struct {
+	ID      uint                 `json:"id" example:"1" description:"Side ID"`
+	Name    string               `json:"name" example:"Red team" description:"Side name"`
+	Players []SidePlayerResponse `json:"players" description:"Participants playing on this side"`
+}
+
+// SidePlayerResponse is a participant assigned to a side
+type SidePlayerResponse struct {
+	UserID      uint   `json:"user_id" example:"12345" description:"Player's user ID"`
+	Username    string `json:"username" example:"johndoe" description:"Player's username"`
+	DisplayName string `json:"display_name" example:"John Doe" description:"Player's display name"`
+}
+
+// SideScoreResponse is the final score of one side
+type SideScoreResponse struct {
+	SideID uint   `json:"side_id" example:"1" description:"Side ID"`
+	Name   string `json:"name" example:"Red team" description:"Side name"`
+	Score  int    `json:"score" example:"2" description:"Goals, points or sets won"`
+	Sets   []int  `json:"sets,omitempty" example:"6,3,7" description:"Games won in each set (sets format)"`
+}
+
+// ResultConfirmationResponse is one participant's answer to a result
+type ResultConfirmationResponse struct {
+	UserID    uint         `json:"user_id" example:"12345" description:"Responding participant"`
+	Response  ResultStatus `json:"response" example:"confirmed" description:"confirmed or disputed"`
+	Comment   string       `json:"comment,omitempty" example:"Great game!" description:"Optional comment"`
+	CreatedAt time.Time    `json:"created_at" example:"2024-12-20T21:00:00Z" description:"When the participant responded"`
+}
+
+// GameResultResponse represents the recorded outcome of a game
+// @Description Game result response payload
+type GameResultResponse struct {
+	ID            uint                         `json:"id" example:"1" description:"Result ID"`
+	EventID       uint                         `json:"event_id" example:"1" description:"Game event ID"`
+	Format        ScoreFormat                  `json:"format" example:"sets" description:"Score format"`
+	Status        ResultStatus                 `json:"status" example:"pending" description:"Confirmation status"`
+	WinnerSideID  *uint                        `json:"winner_side_id,omitempty" example:"1" description:"Winning side (empty for a draw)"`
+	Scores        []SideScoreResponse          `json:"scores" description:"Score per side"`
+	Confirmations []ResultConfirmationResponse `json:"confirmations" description:"Participants' confirmations and disputes"`
+	RecordedByID  uint                         `json:"recorded_by_id" example:"12345" description:"User who recorded the result"`
+	ConfirmedAt   *time.Time                   `json:"confirmed_at,omitempty" example:"2024-12-21T10:00:00Z" description:"When the result was confirmed"`
+	CreatedAt     time.Time                    `json:"created_at" example:"2024-12-20T20:30:00Z" description:"When the result was recorded"`
+	UpdatedAt     time.Time                    `json:"updated_at" example:"2024-12-20T20:30:00Z" description:"Last update timestamp"`
+}
+
+// GameRecordResponse summarizes a user's confirmed game results
+type GameRecordResponse struct {
+	Played int `json:"played" example:"12" description:"Games with a confirmed result"`
+	Wins   int `json:"wins" example:"7" description:"Games won"`
+	Losses int `json:"losses" example:"4" description:"Games lost"`
+	Draws  int `json:"draws" example:"1" description:"Games drawn"`
+}
+
+// GameHistoryEntryResponse is one game in a user's result history
+// @Description Game history entry response payload
+type GameHistoryEntryResponse struct {
+	EventID  uint                `json:"event_id" example:"1" description:"Game event ID"`
+	Title    string              `json:"title" example:"Friday Tennis" description:"Game title"`
+	Sport    string              `json:"sport" example:"Tennis" description:"Sport name"`
+	StartAt  time.Time           `json:"start_at" example:"2024-12-20T18:00:00Z" description:"When the game was played"`
+	SideID   uint                `json:"side_id" example:"1" description:"The user's side"`
+	Outcome  GameOutcome         `json:"outcome" example:"win" description:"win, loss or draw"`
+	Format   ScoreFormat         `json:"format" example:"sets" description:"Score format"`
+	Scores   []SideScoreResponse `json:"scores" description:"Score per side"`
+	Recorded time.Time           `json:"recorded_at" example:"2024-12-20T20:30:00Z" description:"When the result was recorded"`
+}
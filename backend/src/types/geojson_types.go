@@ -0,0 +1,52 @@
+package types
+
+import "time"
+
+// GeoJSONFeatureCollection is an RFC 7946 FeatureCollection
+// @Description GeoJSON FeatureCollection
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type" example:"FeatureCollection" description:"Always FeatureCollection"`
+	Features []GeoJSONFeature `json:"features" description:"The features of the collection"`
+}
+
+// GeoJSONFeature is an RFC 7946 Feature with a point geometry
+// @Description GeoJSON Feature
+type GeoJSONFeature struct {
+	Type       string       `json:"type" example:"Feature" description:"Always Feature"`
+	ID         uint         `json:"id" example:"1" description:"Identifier of the feature"`
+	Geometry   GeoJSONPoint `json:"geometry" description:"Location of the feature"`
+	Properties any          `json:"properties" description:"Attributes of the feature"`
+}
+
+// GeoJSONPoint is an RFC 7946 Point geometry. Coordinates are [longitude, latitude].
+// @Description GeoJSON Point
+type GeoJSONPoint struct {
+	Type        string     `json:"type" example:"Point" description:"Always Point"`
+	Coordinates [2]float64 `json:"coordinates" example:"-73.9654,40.7829" description:"Longitude and latitude"`
+}
+
+// NewGeoJSONPoint creates a point geometry from a latitude and longitude
+func NewGeoJSONPoint(latitude, longitude float64) GeoJSONPoint {
+	return GeoJSONPoint{Type: "Point", Coordinates: [2]float64{longitude, latitude}}
+}
+
+// EventFeatureProperties are the properties of an event exported as a GeoJSON feature
+// @Description Event GeoJSON feature properties
+type EventFeatureProperties struct {
+	ID           uint            `json:"id" example:"1" description:"Event unique identifier"`
+	OrganizerID  uint            `json:"organizer_id" example:"12345" description:"Organizer's user ID"`
+	Type         EventType       `json:"type" example:"game" description:"Type of event"`
+	Title        string          `json:"title" example:"Friday Basketball Game" description:"Event title"`
+	Description  string          `json:"description" example:"Friendly basketball match" description:"Event description"`
+	Sport        string          `json:"sport" example:"Basketball" description:"Sport name"`
+	StartAt      time.Time       `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Event start time"`
+	EndAt        *time.Time      `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"Event end time"`
+	LocationName string          `json:"location_name" example:"Central Park Basketball Court" description:"Location name"`
+	VenueID      *uint           `json:"venue_id,omitempty" example:"4" description:"Venue the event takes place at"`
+	Capacity     *int            `json:"capacity,omitempty" example:"10" description:"Maximum participants"`
+	Participants int             `json:"participants" example:"5" description:"Current number of participants"`
+	Status       EventStatus     `json:"status" example:"upcoming" description:"Event status"`
+	Visibility   EventVisibility `json:"visibility" example:"public" description:"Who can see the event"`
+	SeriesID     *uint           `json:"series_id,omitempty" example:"3" description:"Recurring series this event is an occurrence of"`
+	DistanceKm   *float64        `json:"distance_km,omitempty" example:"3.42" description:"Great-circle distance from the lat/lng given in the query, in kilometers"`
+}
@@ -0,0 +1,105 @@
+package types
+
+import "time"
+
+// GroupVisibility controls who can see a group's posts and events and how users join it
+type GroupVisibility string
+
+const (
+	// GroupVisibilityPublic groups can be joined directly and their content is visible to everyone
+	GroupVisibilityPublic GroupVisibility = "public"
+	// GroupVisibilityPrivate groups take join requests and only show their content to members
+	GroupVisibilityPrivate GroupVisibility = "private"
+)
+
+func (gv GroupVisibility) IsValid() bool {
+	switch gv {
+	case GroupVisibilityPublic, GroupVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+// GroupRole is the role of a member in a group
+type GroupRole string
+
+const (
+	// GroupRoleAdmin manages the group's settings and member roles
+	GroupRoleAdmin GroupRole = "admin"
+	// GroupRoleModerator answers join requests, removes members and moderates posts
+	GroupRoleModerator GroupRole = "moderator"
+	GroupRoleMember    GroupRole = "member"
+)
+
+func (gr GroupRole) IsValid() bool {
+	switch gr {
+	case GroupRoleAdmin, GroupRoleModerator, GroupRoleMember:
+		return true
+	}
+	return false
+}
+
+// CreateGroupRequest represents the request for creating a group
+// @Description Group creation request payload
+type CreateGroupRequest struct {
+	Name        string          `json:"name" binding:"required,min=3,max=100" example:"Riverside Runners" description:"Group name"`
+	Description string          `json:"description" binding:"max=2000" example:"Weekly runs along the river" description:"Group description"`
+	Sport       string          `json:"sport" binding:"max=100" example:"Running" description:"Main sport of the group"`
+	City        string          `json:"city" binding:"max=100" example:"London" description:"City the group is based in"`
+	Visibility  GroupVisibility `json:"visibility,omitempty" example:"public" description:"public or private (defaults to public)"`
+}
+
+// UpdateGroupRequest represents the request for updating a group; omitted fields are kept
+// @Description Group update request payload
+type UpdateGroupRequest struct {
+	Name        *string          `json:"name,omitempty" binding:"omitempty,min=3,max=100" example:"Riverside Runners" description:"Group name"`
+	Description *string          `json:"description,omitempty" binding:"omitempty,max=2000" example:"Weekly runs along the river" description:"Group description"`
+	Sport       *string          `json:"sport,omitempty" binding:"omitempty,max=100" example:"Running" description:"Main sport of the group"`
+	City        *string          `json:"city,omitempty" binding:"omitempty,max=100" example:"London" description:"City the group is based in"`
+	Visibility  *GroupVisibility `json:"visibility,omitempty" example:"private" description:"public or private"`
+}
+
+// SetGroupRoleRequest represents an admin changing a member's role
+// @Description Group role request payload
+type SetGroupRoleRequest struct {
+	Role GroupRole `json:"role" binding:"required" example:"moderator" description:"admin, moderator or member"`
+}
+
+// GroupResponse represents a group
+// @Description Group response payload
+type GroupResponse struct {
+	ID          uint            `json:"id" example:"5" description:"Group ID"`
+	Name        string          `json:"name" example:"Riverside Runners" description:"Group name"`
+	Description string          `json:"description" example:"Weekly runs along the river" description:"Group description"`
+	Sport       string          `json:"sport" example:"Running" description:"Main sport of the group"`
+	City        string          `json:"city" example:"London" description:"City the group is based in"`
+	Visibility  GroupVisibility `json:"visibility" example:"public" description:"public or private"`
+	CreatedByID uint            `json:"created_by_id" example:"12345" description:"User who created the group"`
+	MemberCount int             `json:"member_count" example:"42" description:"Number of members"`
+	MyRole      *GroupRole      `json:"my_role,omitempty" example:"member" description:"Current user's role, if a member"`
+	MyRequest   *string         `json:"my_request,omitempty" example:"pending" description:"Status of the current user's join request to a private group"`
+	CreatedAt   time.Time       `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt   time.Time       `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
+
+// GroupMemberResponse represents a member of a group
+type GroupMemberResponse struct {
+	UserID      uint      `json:"user_id" example:"12345" description:"Member's user ID"`
+	Username    string    `json:"username" example:"johndoe" description:"Member's username"`
+	DisplayName string    `json:"display_name" example:"John Doe" description:"Member's display name"`
+	AvatarURL   string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"Member's avatar URL"`
+	Role        GroupRole `json:"role" example:"member" description:"admin, moderator or member"`
+	JoinedAt    time.Time `json:"joined_at" example:"2024-01-15T10:30:00Z" description:"When the user joined the group"`
+}
+
+// GroupJoinRequestResponse represents a request to join a private group
+type GroupJoinRequestResponse struct {
+	ID          uint                `json:"id" example:"1" description:"Join request ID"`
+	GroupID     uint                `json:"group_id" example:"5" description:"Group ID"`
+	UserID      uint                `json:"user_id" example:"67890" description:"Requesting user"`
+	Username    string              `json:"username" example:"janedoe" description:"Requesting user's username"`
+	DisplayName string              `json:"display_name" example:"Jane Doe" description:"Requesting user's display name"`
+	AvatarURL   string              `json:"avatar_url" example:"/api/user/67890/avatar" description:"Requesting user's avatar URL"`
+	Status      ParticipationStatus `json:"status" example:"pending" description:"pending, accepted or rejected"`
+	CreatedAt   time.Time           `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the request was made"`
+}
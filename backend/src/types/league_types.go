@@ -0,0 +1,159 @@
+package types
+
+import "time"
+
+// LeagueSeasonStatus represents the stage a league season is in
+type LeagueSeasonStatus string
+
+const (
+	// LeagueSeasonDraft has its teams but no fixtures yet
+	LeagueSeasonDraft    LeagueSeasonStatus = "draft"
+	LeagueSeasonActive   LeagueSeasonStatus = "active"
+	LeagueSeasonComplete LeagueSeasonStatus = "complete"
+)
+
+// LeagueFixtureStatus represents whether a fixture has been played
+type LeagueFixtureStatus string
+
+const (
+	LeagueFixtureScheduled LeagueFixtureStatus = "scheduled"
+	LeagueFixtureComplete  LeagueFixtureStatus = "complete"
+)
+
+// CreateLeagueRequest represents the request for creating a league
+// @Description League creation request payload
+type CreateLeagueRequest struct {
+	Name         string   `json:"name" binding:"required,min=3,max=255" example:"Sunday Football League" description:"League name"`
+	Description  string   `json:"description" binding:"max=2000" example:"Friendly 7-a-side league, every Sunday morning" description:"League description"`
+	Sport        string   `json:"sport" binding:"required,min=2,max=100" example:"Football" description:"Sport played in the league"`
+	LocationName string   `json:"location_name" binding:"max=255" example:"Riverside Park" description:"Home ground of the league"`
+	Latitude     *float64 `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90" example:"40.7829" description:"Home ground latitude (required without venue_id)"`
+	Longitude    *float64 `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180" example:"-73.9654" description:"Home ground longitude (required without venue_id)"`
+	VenueID      *uint    `json:"venue_id,omitempty" example:"1" description:"Venue the fixtures are played at; fills in the location"`
+	Timezone     string   `json:"timezone,omitempty" example:"Europe/London" description:"IANA time zone fixture dates are planned in (defaults to UTC)"`
+}
+
+// CreateLeagueTeamRequest represents the request for entering a team into a league
+// @Description League team creation request payload
+type CreateLeagueTeamRequest struct {
+	Name    string `json:"name" binding:"required,min=1,max=100" example:"Red Lions" description:"Team name, unique within the league"`
+	UserIDs []uint `json:"user_ids,omitempty" binding:"max=50" example:"12,34" description:"Players besides yourself; you become the captain"`
+}
+
+// AddLeagueTeamMemberRequest represents the request for adding a player to a league team
+// @Description League team member request payload
+type AddLeagueTeamMemberRequest struct {
+	UserID uint `json:"user_id" binding:"required" example:"56" description:"Player to add"`
+}
+
+// CreateLeagueSeasonRequest represents the request for starting a new season
+// @Description League season creation request payload
+type CreateLeagueSeasonRequest struct {
+	Name                 string    `json:"name" binding:"required,min=1,max=100" example:"Spring 2025" description:"Season name"`
+	StartAt              time.Time `json:"start_at" binding:"required" example:"2025-03-02T10:00:00Z" description:"Kick-off of the first matchday"`
+	TeamIDs              []uint    `json:"team_ids" binding:"required,min=2,max=40" example:"1,2,3,4" description:"League teams playing this season"`
+	Legs                 int       `json:"legs,omitempty" binding:"omitempty,min=1,max=2" example:"2" description:"1 to meet every team once, 2 for home and away (default)"`
+	MatchIntervalDays    int       `json:"match_interval_days,omitempty" binding:"omitempty,min=1,max=60" example:"7" description:"Days between matchdays (defaults to 7)"`
+	MatchDurationMinutes int       `json:"match_duration_minutes,omitempty" binding:"omitempty,min=15,max=600" example:"90" description:"Length of a fixture (defaults to 90)"`
+	PointsWin            *int      `json:"points_win,omitempty" binding:"omitempty,min=0,max=10" example:"3" description:"Points for a win (defaults to 3)"`
+	PointsDraw           *int      `json:"points_draw,omitempty" binding:"omitempty,min=0,max=10" example:"1" description:"Points for a draw (defaults to 1)"`
+}
+
+// FixtureResultRequest represents the request for entering the result of a fixture
+// @Description Fixture result request payload
+type FixtureResultRequest struct {
+	Home MatchSideScore `json:"home" description:"Score of the home team"`
+	Away MatchSideScore `json:"away" description:"Score of the away team"`
+}
+
+// LeagueTeamResponse represents a team of a league
+// @Description League team response payload
+type LeagueTeamResponse struct {
+	ID        uint                 `json:"id" example:"1" description:"Team ID"`
+	Name      string               `json:"name" example:"Red Lions" description:"Team name"`
+	CaptainID uint                 `json:"captain_id" example:"12345" description:"Captain of the team"`
+	Players   []SidePlayerResponse `json:"players" description:"Players of the team"`
+}
+
+// LeagueSeasonSummaryResponse is a season in a league overview
+type LeagueSeasonSummaryResponse struct {
+	ID      uint               `json:"id" example:"2" description:"Season ID"`
+	Name    string             `json:"name" example:"Spring 2025" description:"Season name"`
+	StartAt time.Time          `json:"start_at" example:"2025-03-02T10:00:00Z" description:"Kick-off of the first matchday"`
+	Status  LeagueSeasonStatus `json:"status" example:"active" description:"draft, active or complete"`
+}
+
+// LeagueResponse represents a league with its teams and seasons
+// @Description League response payload
+type LeagueResponse struct {
+	ID           uint                          `json:"id" example:"1" description:"League ID"`
+	OrganizerID  uint                          `json:"organizer_id" example:"12345" description:"League organizer"`
+	Name         string                        `json:"name" example:"Sunday Football League" description:"League name"`
+	Description  string                        `json:"description" example:"Friendly 7-a-side league, every Sunday morning" description:"League description"`
+	Sport        string                        `json:"sport" example:"Football" description:"Sport played in the league"`
+	LocationName string                        `json:"location_name" example:"Riverside Park" description:"Home ground"`
+	Latitude     float64                       `json:"latitude" example:"40.7829" description:"Home ground latitude"`
+	Longitude    float64                       `json:"longitude" example:"-73.9654" description:"Home ground longitude"`
+	VenueID      *uint                         `json:"venue_id,omitempty" example:"1" description:"Venue the fixtures are played at"`
+	Timezone     string                        `json:"timezone" example:"Europe/London" description:"Time zone fixture dates are planned in"`
+	Teams        []LeagueTeamResponse          `json:"teams" description:"Teams of the league"`
+	Seasons      []LeagueSeasonSummaryResponse `json:"seasons" description:"Seasons, latest first"`
+	CreatedAt    time.Time                     `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt    time.Time                     `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
+
+// LeagueFixtureResponse represents a fixture of a season
+// @Description League fixture response payload
+type LeagueFixtureResponse struct {
+	ID         uint                `json:"id" example:"10" description:"Fixture ID"`
+	Matchday   int                 `json:"matchday" example:"3" description:"Matchday of the season"`
+	HomeTeamID uint                `json:"home_team_id" example:"1" description:"Home team"`
+	HomeTeam   string              `json:"home_team" example:"Red Lions" description:"Home team name"`
+	AwayTeamID uint                `json:"away_team_id" example:"2" description:"Away team"`
+	AwayTeam   string              `json:"away_team" example:"Blue Sharks" description:"Away team name"`
+	EventID    uint                `json:"event_id" example:"42" description:"Game event the fixture is played in"`
+	StartAt    time.Time           `json:"start_at" example:"2025-03-16T10:00:00Z" description:"Kick-off"`
+	Status     LeagueFixtureStatus `json:"status" example:"complete" description:"scheduled or complete"`
+	HomeScore  *int                `json:"home_score,omitempty" example:"2" description:"Goals, points or sets won by the home team"`
+	AwayScore  *int                `json:"away_score,omitempty" example:"1" description:"Goals, points or sets won by the away team"`
+}
+
+// LeagueMatchdayResponse groups the fixtures of a matchday
+type LeagueMatchdayResponse struct {
+	Matchday int                     `json:"matchday" example:"3" description:"Matchday of the season"`
+	Fixtures []LeagueFixtureResponse `json:"fixtures" description:"Fixtures of the matchday"`
+}
+
+// LeagueStandingResponse is a team's row in the standings table
+// @Description League standing response payload
+type LeagueStandingResponse struct {
+	Rank           int    `json:"rank" example:"1" description:"Position in the table"`
+	TeamID         uint   `json:"team_id" example:"1" description:"Team ID"`
+	Name           string `json:"name" example:"Red Lions" description:"Team name"`
+	Played         int    `json:"played" example:"6" description:"Fixtures played"`
+	Wins           int    `json:"wins" example:"4" description:"Fixtures won"`
+	Draws          int    `json:"draws" example:"1" description:"Fixtures drawn"`
+	Losses         int    `json:"losses" example:"1" description:"Fixtures lost"`
+	GoalsFor       int    `json:"goals_for" example:"14" description:"Goals (or points, sets) scored"`
+	GoalsAgainst   int    `json:"goals_against" example:"6" description:"Goals (or points, sets) conceded"`
+	GoalDifference int    `json:"goal_difference" example:"8" description:"Goals for minus goals against"`
+	Points         int    `json:"points" example:"13" description:"Table points"`
+}
+
+// LeagueSeasonResponse represents a season with its fixtures and standings
+// @Description League season response payload
+type LeagueSeasonResponse struct {
+	ID                   uint                     `json:"id" example:"2" description:"Season ID"`
+	LeagueID             uint                     `json:"league_id" example:"1" description:"League ID"`
+	Name                 string                   `json:"name" example:"Spring 2025" description:"Season name"`
+	StartAt              time.Time                `json:"start_at" example:"2025-03-02T10:00:00Z" description:"Kick-off of the first matchday"`
+	Legs                 int                      `json:"legs" example:"2" description:"Times every pair of teams meets"`
+	MatchIntervalDays    int                      `json:"match_interval_days" example:"7" description:"Days between matchdays"`
+	MatchDurationMinutes int                      `json:"match_duration_minutes" example:"90" description:"Length of a fixture"`
+	PointsWin            int                      `json:"points_win" example:"3" description:"Points for a win"`
+	PointsDraw           int                      `json:"points_draw" example:"1" description:"Points for a draw"`
+	Status               LeagueSeasonStatus       `json:"status" example:"active" description:"draft, active or complete"`
+	Matchdays            []LeagueMatchdayResponse `json:"matchdays" description:"Fixtures by matchday (empty until generated)"`
+	Standings            []LeagueStandingResponse `json:"standings" description:"Table ordered by points, then head-to-head, goal difference and goals scored"`
+	CreatedAt            time.Time                `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+}
@@ -0,0 +1,71 @@
+package types
+
+import "time"
+
+// StartConversationRequest opens (or returns) the conversation with another user
+// @Description Start conversation request payload
+type StartConversationRequest struct {
+	UserID uint `json:"user_id" binding:"required" example:"67890" description:"User to talk to"`
+}
+
+// SendMessageRequest represents a new direct message
+// @Description Send message request payload
+type SendMessageRequest struct {
+	Body string `json:"body" binding:"required,min=1,max=2000" example:"Still up for tennis on Saturday?" description:"Message text"`
+}
+
+// DirectMessageResponse represents a direct message
+// @Description Direct message response payload
+type DirectMessageResponse struct {
+	ID             uint       `json:"id" example:"1" description:"Message ID"`
+	ConversationID uint       `json:"conversation_id" example:"3" description:"Conversation ID"`
+	SenderID       uint       `json:"sender_id" example:"12345" description:"Sender's user ID"`
+	Body           string     `json:"body" example:"Still up for tennis on Saturday?" description:"Message text"`
+	ReadAt         *time.Time `json:"read_at,omitempty" example:"2024-01-15T10:31:00Z" description:"When the recipient read the message"`
+	CreatedAt      time.Time  `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the message was sent"`
+}
+
+// ConversationUserResponse is the other participant of a conversation
+type ConversationUserResponse struct {
+	UserID      uint   `json:"user_id" example:"67890" description:"User ID"`
+	Username    string `json:"username" example:"janedoe" description:"Username"`
+	DisplayName string `json:"display_name" example:"Jane Doe" description:"Display name"`
+	AvatarURL   string `json:"avatar_url" example:"/api/user/67890/avatar" description:"Avatar URL"`
+}
+
+// ConversationResponse represents a conversation in the inbox
+// @Description Conversation response payload
+type ConversationResponse struct {
+	ID            uint                     `json:"id" example:"3" description:"Conversation ID"`
+	OtherUser     ConversationUserResponse `json:"other_user" description:"The other participant"`
+	LastMessage   *DirectMessageResponse   `json:"last_message,omitempty" description:"Most recent message"`
+	UnreadCount   int                      `json:"unread_count" example:"2" description:"Messages the current user hasn't read yet"`
+	LastMessageAt *time.Time               `json:"last_message_at,omitempty" example:"2024-01-15T10:30:00Z" description:"Time of the latest message"`
+}
+
+// DirectMessagePageResponse is a page of messages, newest first
+// @Description Message history page
+type DirectMessagePageResponse struct {
+	Messages   []DirectMessageResponse `json:"messages" description:"Messages, newest first"`
+	NextCursor *uint                   `json:"next_cursor,omitempty" example:"41" description:"Pass as before to load older messages; omitted on the last page"`
+}
+
+// EventMessageResponse represents a message in an event chat
+// @Description Event chat message response payload
+type EventMessageResponse struct {
+	ID          uint      `json:"id" example:"1" description:"Message ID"`
+	EventID     uint      `json:"event_id" example:"7" description:"Event ID"`
+	SenderID    uint      `json:"sender_id" example:"12345" description:"Sender's user ID"`
+	Username    string    `json:"username" example:"johndoe" description:"Sender's username"`
+	DisplayName string    `json:"display_name" example:"John Doe" description:"Sender's display name"`
+	AvatarURL   string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"Sender's avatar URL"`
+	Body        string    `json:"body" example:"I'll bring the ball" description:"Message text"`
+	CreatedAt   time.Time `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the message was sent"`
+}
+
+// EventMessagePageResponse is a page of event chat messages, newest first
+// @Description Event chat history page
+type EventMessagePageResponse struct {
+	Messages   []EventMessageResponse `json:"messages" description:"Messages, newest first"`
+	NextCursor *uint                  `json:"next_cursor,omitempty" example:"41" description:"Pass as before to load older messages; omitted on the last page"`
+}
@@ -8,6 +8,7 @@ type CreatePostRequest struct {
 	Title string `json:"title" validate:"required" example:"Game" description:"Title of post"`
 	Body  string `json:"body" validate:"required,min=5,max=255" example:"Had fun" description:"Body text of post"`
 	Mentions []string `json:"mentions,omitempty" description:"Optional list of mentioned usernames (e.g. [\"alice\", \"bob\"])"`
+	GroupID  *uint    `json:"group_id,omitempty" example:"5" description:"Optional group to post in; requires membership"`
 }
 
 // UpdatePostRequest represents the request for updating a post
@@ -23,6 +24,7 @@ type UpdatePostRequest struct {
 type PostResponse struct {
 	ID        uint       `json:"id" example:"1" description:"Post unique identifier"`
 	UserID    uint       `json:"user_id" example:"12345" description:"Post publisher ID"`
+	GroupID   *uint      `json:"group_id,omitempty" example:"5" description:"Group the post belongs to, if any"`
 	Title     string     `json:"title" example:"Game" description:"Title of post"`
 	Body      string     `json:"body" example:"Had fun" description:"Body text of post"`
 	Status    PostStatus `json:"status" example:"archived" description:"Status of the post"`
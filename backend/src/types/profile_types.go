@@ -12,12 +12,19 @@ type ProfileResponse struct {
 	Bio            string    `json:"bio" example:"I love playing sports and meeting new people!" description:"User's biography"`
 	City           string    `json:"city" example:"New York" description:"User's city"`
 	Country        string    `json:"country" example:"USA" description:"User's country"`
+	HomeLatitude   *float64  `json:"home_latitude,omitempty" example:"40.7829" description:"Home location latitude, used to rank nearby activities"`
+	HomeLongitude  *float64  `json:"home_longitude,omitempty" example:"-73.9654" description:"Home location longitude"`
 	Sports         []string  `json:"sports" example:"[\"football\",\"basketball\"]" description:"List of sports user is interested in"`
 	AvatarURL      string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"URL to user's avatar image"`
 	HasAvatar      bool      `json:"has_avatar" example:"true" description:"Whether user has uploaded an avatar"`
 	FollowersCount int       `json:"followers_count" example:"42" description:"Number of followers"`
 	FollowingCount int       `json:"following_count" example:"15" description:"Number of users being followed"`
 	ActivitiesCount int      `json:"activities_count" example:"5" description:"Number of non-cancelled activities created by the user"`
+	GameRecord     GameRecordResponse `json:"game_record" description:"Win/loss record over confirmed game results"`
+	Reliability    *int      `json:"reliability,omitempty" example:"92" description:"Percentage of checked activities the user attended (absent until one is tracked)"`
+	TrackedActivities int    `json:"tracked_activities" example:"13" description:"Number of joined activities where attendance was checked"`
+	EventReminders bool      `json:"event_reminders" example:"true" description:"Whether the user gets reminders before activities they take part in"`
+	IsPrivate      bool      `json:"is_private" example:"false" description:"Whether new followers need the user's approval"`
 	CreatedAt      time.Time `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Account creation timestamp"`
 	UpdatedAt      time.Time `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last profile update timestamp"`
 }
@@ -29,7 +36,11 @@ type ProfileUpdateRequest struct {
 	Bio         string   `json:"bio" example:"I love playing sports and meeting new people!" description:"Updated biography"`
 	City        string   `json:"city" example:"New York" description:"Updated city"`
 	Country     string   `json:"country" example:"USA" description:"Updated country"`
+	HomeLatitude  *float64 `json:"home_latitude,omitempty" binding:"omitempty,min=-90,max=90" example:"40.7829" description:"Home location latitude, set together with home_longitude (unchanged if omitted)"`
+	HomeLongitude *float64 `json:"home_longitude,omitempty" binding:"omitempty,min=-180,max=180" example:"-73.9654" description:"Home location longitude, set together with home_latitude (unchanged if omitted)"`
 	Sports      []string `json:"sports" example:"[\"football\",\"basketball\"]" description:"Updated list of sports interests"`
+	EventReminders *bool `json:"event_reminders,omitempty" example:"false" description:"Turn activity reminders on or off (unchanged if omitted)"`
+	IsPrivate   *bool    `json:"is_private,omitempty" example:"true" description:"Make the account private or public; going public approves pending follow requests (unchanged if omitted)"`
 }
 
 // PublicProfileResponse represents the response for public profile operations (other users' profiles)
@@ -47,7 +58,14 @@ type PublicProfileResponse struct {
 	FollowersCount int       `json:"followers_count" example:"42" description:"Number of followers"`
 	FollowingCount int       `json:"following_count" example:"15" description:"Number of users being followed"`
 	ActivitiesCount int      `json:"activities_count" example:"5" description:"Number of non-cancelled activities created by the user"`
+	GameRecord     GameRecordResponse `json:"game_record" description:"Win/loss record over confirmed game results"`
+	Reliability    *int      `json:"reliability,omitempty" example:"92" description:"Percentage of checked activities the user attended (absent until one is tracked)"`
+	TrackedActivities int    `json:"tracked_activities" example:"13" description:"Number of joined activities where attendance was checked"`
 	CreatedAt      time.Time `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Account creation timestamp"`
 	UpdatedAt      time.Time `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last profile update timestamp"`
 	IsFollowing    bool      `json:"is_following" example:"false" description:"Whether current user is following this user"`
+	IsPrivate      bool      `json:"is_private" example:"false" description:"Whether the account is private"`
+	FollowRequested bool     `json:"follow_requested" example:"false" description:"Whether current user's follow request is waiting for approval"`
+	IsLimited      bool      `json:"is_limited" example:"false" description:"Whether only the basic profile is shown because the account is private and not followed"`
+	Ratings        []SportRatingResponse `json:"ratings" description:"Skill rating per sport, highest first"`
 }
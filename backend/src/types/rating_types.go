@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+// SportRatingResponse represents a user's skill rating in one sport
+// @Description Sport rating response payload
+type SportRatingResponse struct {
+	Sport       string `json:"sport" example:"Tennis" description:"Sport name"`
+	Rating      int    `json:"rating" example:"1342" description:"Elo rating (new players start at 1200)"`
+	GamesPlayed int    `json:"games_played" example:"14" description:"Rated games played"`
+	Provisional bool   `json:"provisional" example:"false" description:"Whether the rating is still settling (fewer than 10 rated games)"`
+}
+
+// RatingHistoryEntryResponse represents one rating change
+// @Description Rating history entry response payload
+type RatingHistoryEntryResponse struct {
+	Sport        string    `json:"sport" example:"Tennis" description:"Sport name"`
+	EventID      uint      `json:"event_id" example:"1" description:"Game that changed the rating"`
+	RatingBefore int       `json:"rating_before" example:"1320" description:"Rating before the game"`
+	RatingAfter  int       `json:"rating_after" example:"1342" description:"Rating after the game"`
+	Change       int       `json:"change" example:"22" description:"Rating change"`
+	CreatedAt    time.Time `json:"created_at" example:"2024-12-21T10:00:00Z" description:"When the rating changed"`
+}
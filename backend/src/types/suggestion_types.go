@@ -0,0 +1,18 @@
+package types
+
+// UserSuggestionResponse represents a user the current user may know
+// @Description "People you may know" suggestion
+type UserSuggestionResponse struct {
+	ID            uint     `json:"id" example:"12345" description:"User's unique identifier"`
+	Username      string   `json:"username" example:"johndoe" description:"User's username"`
+	DisplayName   string   `json:"display_name" example:"John Doe" description:"User's display name"`
+	AvatarURL     string   `json:"avatar_url" example:"/api/user/12345/avatar" description:"URL to user's avatar"`
+	HasAvatar     bool     `json:"has_avatar" example:"true" description:"Whether user has an avatar"`
+	Reason        string   `json:"reason" example:"5 mutual follows, plays Tennis" description:"Why the user is suggested"`
+	Score         int      `json:"score" example:"17" description:"Ranking score, higher is a better match"`
+	MutualFollows int      `json:"mutual_follows" example:"5" description:"Number of people you follow who follow this user"`
+	SharedSports  []string `json:"shared_sports" example:"[\"Tennis\"]" description:"Sports you both play"`
+	SharedEvents  int      `json:"shared_events" example:"2" description:"Number of past activities you both took part in"`
+	SameCity      bool     `json:"same_city" example:"true" description:"Whether the user lives in your city"`
+	SameCountry   bool     `json:"same_country" example:"true" description:"Whether the user lives in your country"`
+}
@@ -0,0 +1,120 @@
+package types
+
+import "time"
+
+// TeamRole is the role of a player on a team
+type TeamRole string
+
+const (
+	// TeamRoleCaptain manages the roster and signs the team up for events
+	TeamRoleCaptain TeamRole = "captain"
+	TeamRoleMember  TeamRole = "member"
+)
+
+func (tr TeamRole) IsValid() bool {
+	switch tr {
+	case TeamRoleCaptain, TeamRoleMember:
+		return true
+	}
+	return false
+}
+
+// CreateTeamRequest represents the request for creating a team
+// @Description Team creation request payload
+type CreateTeamRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=100" example:"Red Lions" description:"Team name"`
+	Sport       string `json:"sport" binding:"max=100" example:"Football" description:"Sport the team plays"`
+	Description string `json:"description" binding:"max=2000" example:"Five-a-side on Tuesday nights" description:"Team description"`
+}
+
+// UpdateTeamRequest represents the request for updating a team; omitted fields are kept
+// @Description Team update request payload
+type UpdateTeamRequest struct {
+	Name        *string `json:"name,omitempty" binding:"omitempty,min=1,max=100" example:"Red Lions" description:"Team name"`
+	Sport       *string `json:"sport,omitempty" binding:"omitempty,max=100" example:"Football" description:"Sport the team plays"`
+	Description *string `json:"description,omitempty" binding:"omitempty,max=2000" example:"Five-a-side on Tuesday nights" description:"Team description"`
+}
+
+// InviteToTeamRequest represents a captain inviting users to a team
+// @Description Team invitation request payload
+type InviteToTeamRequest struct {
+	UserIDs []uint `json:"user_ids" binding:"required,min=1,max=50" example:"12,34" description:"Users to invite"`
+}
+
+// SetTeamRoleRequest represents a captain changing a player's role
+// @Description Team role request payload
+type SetTeamRoleRequest struct {
+	Role TeamRole `json:"role" binding:"required" example:"captain" description:"captain or member"`
+}
+
+// TeamMemberResponse represents a player on a team
+type TeamMemberResponse struct {
+	UserID      uint      `json:"user_id" example:"12345" description:"Player's user ID"`
+	Username    string    `json:"username" example:"johndoe" description:"Player's username"`
+	DisplayName string    `json:"display_name" example:"John Doe" description:"Player's display name"`
+	AvatarURL   string    `json:"avatar_url" example:"/api/user/12345/avatar" description:"Player's avatar URL"`
+	Role        TeamRole  `json:"role" example:"member" description:"captain or member"`
+	JoinedAt    time.Time `json:"joined_at" example:"2024-01-15T10:30:00Z" description:"When the player joined the team"`
+}
+
+// TeamResponse represents a team with its roster
+// @Description Team response payload
+type TeamResponse struct {
+	ID          uint                 `json:"id" example:"7" description:"Team ID"`
+	Name        string               `json:"name" example:"Red Lions" description:"Team name"`
+	Sport       string               `json:"sport" example:"Football" description:"Sport the team plays"`
+	Description string               `json:"description" example:"Five-a-side on Tuesday nights" description:"Team description"`
+	AvatarURL   string               `json:"avatar_url" example:"/api/teams/7/avatar" description:"URL to the team's avatar image"`
+	HasAvatar   bool                 `json:"has_avatar" example:"true" description:"Whether the team has uploaded an avatar"`
+	CreatedByID uint                 `json:"created_by_id" example:"12345" description:"User who created the team"`
+	MemberCount int                  `json:"member_count" example:"8" description:"Number of players"`
+	Members     []TeamMemberResponse `json:"members" description:"Players, captains first"`
+	MyRole      *TeamRole            `json:"my_role,omitempty" example:"captain" description:"Current user's role, if on the team"`
+	CreatedAt   time.Time            `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt   time.Time            `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
+
+// TeamInvitationResponse represents an invitation to join a team
+// @Description Team invitation response payload
+type TeamInvitationResponse struct {
+	ID          uint             `json:"id" example:"1" description:"Invitation ID"`
+	TeamID      uint             `json:"team_id" example:"7" description:"Team ID"`
+	TeamName    string           `json:"team_name" example:"Red Lions" description:"Team name"`
+	InviterID   uint             `json:"inviter_id" example:"12345" description:"Captain who sent the invitation"`
+	InviteeID   uint             `json:"invitee_id" example:"67890" description:"Invited user"`
+	Username    string           `json:"username" example:"janedoe" description:"Invited user's username"`
+	DisplayName string           `json:"display_name" example:"Jane Doe" description:"Invited user's display name"`
+	Status      InvitationStatus `json:"status" example:"pending" description:"pending, accepted or declined"`
+	CreatedAt   time.Time        `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the invitation was sent"`
+	RespondedAt *time.Time       `json:"responded_at,omitempty" example:"2024-01-16T08:00:00Z" description:"When the invitation was answered"`
+}
+
+// TeamJoinRequestResponse represents a user's request to join a team
+// @Description Team join request response payload
+type TeamJoinRequestResponse struct {
+	ID          uint                `json:"id" example:"1" description:"Join request ID"`
+	TeamID      uint                `json:"team_id" example:"7" description:"Team ID"`
+	UserID      uint                `json:"user_id" example:"67890" description:"Requesting user"`
+	Username    string              `json:"username" example:"janedoe" description:"Requesting user's username"`
+	DisplayName string              `json:"display_name" example:"Jane Doe" description:"Requesting user's display name"`
+	AvatarURL   string              `json:"avatar_url" example:"/api/user/67890/avatar" description:"Requesting user's avatar URL"`
+	Status      ParticipationStatus `json:"status" example:"pending" description:"pending, accepted or rejected"`
+	CreatedAt   time.Time           `json:"created_at" example:"2024-01-15T10:30:00Z" description:"When the request was made"`
+	RespondedAt *time.Time          `json:"responded_at,omitempty" example:"2024-01-16T08:00:00Z" description:"When the request was answered"`
+}
+
+// TeamSkippedMemberResponse is a team member who could not be signed up for an event
+type TeamSkippedMemberResponse struct {
+	UserID uint   `json:"user_id" example:"67890" description:"Skipped player"`
+	Reason string `json:"reason" example:"already_joined" description:"organizer, already_joined, banned, blocked, not_visible or low_reliability"`
+}
+
+// TeamEventRegistrationResponse represents a team signed up for an event
+// @Description Team event registration response payload
+type TeamEventRegistrationResponse struct {
+	Message string                      `json:"message" example:"Team joined the event" description:"Response message"`
+	EventID uint                        `json:"event_id" example:"42" description:"Event ID"`
+	TeamID  uint                        `json:"team_id" example:"7" description:"Team ID"`
+	Joined  []uint                      `json:"joined" example:"12345,67890" description:"Players who joined the event"`
+	Skipped []TeamSkippedMemberResponse `json:"skipped" description:"Players left out, with the reason"`
+}
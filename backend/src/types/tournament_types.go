@@ -0,0 +1,199 @@
+package types
+
+import "time"
+
+// TournamentFormat represents how a tournament's matches are drawn
+type TournamentFormat string
+
+const (
+	TournamentSingleElimination TournamentFormat = "single_elimination"
+	TournamentDoubleElimination TournamentFormat = "double_elimination"
+	TournamentRoundRobin        TournamentFormat = "round_robin"
+)
+
+func (tf TournamentFormat) IsValid() bool {
+	switch tf {
+	case TournamentSingleElimination, TournamentDoubleElimination, TournamentRoundRobin:
+		return true
+	}
+	return false
+}
+
+// TournamentEntryType represents who registers for a tournament: single players or teams
+type TournamentEntryType string
+
+const (
+	TournamentEntryPlayer TournamentEntryType = "player"
+	TournamentEntryTeam   TournamentEntryType = "team"
+)
+
+func (te TournamentEntryType) IsValid() bool {
+	switch te {
+	case TournamentEntryPlayer, TournamentEntryTeam:
+		return true
+	}
+	return false
+}
+
+// TournamentSeeding represents how entries are seeded when the bracket is drawn
+type TournamentSeeding string
+
+const (
+	// TournamentSeedByRegistration seeds entries in the order they registered
+	TournamentSeedByRegistration TournamentSeeding = "registration"
+	// TournamentSeedByRating seeds entries by the average rating of their players in the event's sport
+	TournamentSeedByRating TournamentSeeding = "rating"
+	// TournamentSeedManual uses the seeds set by the organizer
+	TournamentSeedManual TournamentSeeding = "manual"
+)
+
+func (ts TournamentSeeding) IsValid() bool {
+	switch ts {
+	case TournamentSeedByRegistration, TournamentSeedByRating, TournamentSeedManual:
+		return true
+	}
+	return false
+}
+
+// TournamentStatus represents the stage a tournament is in
+type TournamentStatus string
+
+const (
+	TournamentStatusRegistration TournamentStatus = "registration"
+	TournamentStatusInProgress   TournamentStatus = "in_progress"
+	TournamentStatusComplete     TournamentStatus = "complete"
+)
+
+// TournamentBracket is the part of a tournament a match belongs to
+type TournamentBracket string
+
+const (
+	TournamentBracketWinners    TournamentBracket = "winners"
+	TournamentBracketLosers     TournamentBracket = "losers"
+	TournamentBracketFinal      TournamentBracket = "final"
+	TournamentBracketRoundRobin TournamentBracket = "round_robin"
+)
+
+// TournamentMatchStatus represents the state of a tournament match
+type TournamentMatchStatus string
+
+const (
+	// TournamentMatchPending is waiting for the matches that decide its entries
+	TournamentMatchPending TournamentMatchStatus = "pending"
+	// TournamentMatchScheduled has both entries and a game event to play it in
+	TournamentMatchScheduled TournamentMatchStatus = "scheduled"
+	TournamentMatchComplete  TournamentMatchStatus = "complete"
+	// TournamentMatchBye has at most one entry, who moves on without playing
+	TournamentMatchBye TournamentMatchStatus = "bye"
+)
+
+// CreateTournamentRequest represents the request for turning an event into a tournament
+// @Description Tournament creation request payload
+type CreateTournamentRequest struct {
+	EventID              uint                `json:"event_id" binding:"required" example:"1" description:"Event the tournament is held at"`
+	Format               TournamentFormat    `json:"format" binding:"required" example:"single_elimination" description:"single_elimination, double_elimination or round_robin"`
+	EntryType            TournamentEntryType `json:"entry_type,omitempty" example:"player" description:"player (default) or team"`
+	TeamSize             int                 `json:"team_size,omitempty" binding:"omitempty,min=1,max=50" example:"2" description:"Players per team entry (team entries only)"`
+	MaxEntries           *int                `json:"max_entries,omitempty" binding:"omitempty,min=2,max=256" example:"16" description:"Maximum number of entries"`
+	SeedBy               TournamentSeeding   `json:"seed_by,omitempty" example:"rating" description:"registration (default), rating or manual"`
+	MatchDurationMinutes int                 `json:"match_duration_minutes,omitempty" binding:"omitempty,min=5,max=1440" example:"45" description:"Time slot per round of matches (defaults to 60)"`
+}
+
+// RegisterTournamentEntryRequest represents the request for registering a player or team
+// @Description Tournament registration request payload
+type RegisterTournamentEntryRequest struct {
+	Name    string `json:"name,omitempty" binding:"max=100" example:"Smash Bros" description:"Team name (player entries default to the player's name)"`
+	UserIDs []uint `json:"user_ids,omitempty" binding:"max=50" example:"12,34" description:"Players of a team entry, including yourself"`
+}
+
+// SetTournamentSeedsRequest represents the request for seeding entries manually
+// @Description Tournament seeding request payload
+type SetTournamentSeedsRequest struct {
+	EntryIDs []uint `json:"entry_ids" binding:"required,min=2" example:"4,1,3,2" description:"Every entry, from first seed to last"`
+}
+
+// TournamentScoreRequest represents the request for entering the score of a match
+// @Description Tournament match score request payload
+type TournamentScoreRequest struct {
+	Entry1 MatchSideScore `json:"entry1" description:"Score of the first entry"`
+	Entry2 MatchSideScore `json:"entry2" description:"Score of the second entry"`
+}
+
+// TournamentEntryResponse represents a registered player or team
+// @Description Tournament entry response payload
+type TournamentEntryResponse struct {
+	ID             uint                 `json:"id" example:"3" description:"Entry ID"`
+	Name           string               `json:"name" example:"Smash Bros" description:"Player or team name"`
+	Seed           *int                 `json:"seed,omitempty" example:"1" description:"Seed (set when the bracket is drawn or seeded manually)"`
+	RegisteredByID uint                 `json:"registered_by_id" example:"12345" description:"User who registered the entry"`
+	Players        []SidePlayerResponse `json:"players" description:"Players of the entry"`
+}
+
+// TournamentMatchResponse represents a match of the bracket
+// @Description Tournament match response payload
+type TournamentMatchResponse struct {
+	ID               uint                  `json:"id" example:"7" description:"Match ID"`
+	Bracket          TournamentBracket     `json:"bracket" example:"winners" description:"winners, losers, final or round_robin"`
+	Round            int                   `json:"round" example:"2" description:"Round within the bracket, starting at 1"`
+	Position         int                   `json:"position" example:"0" description:"Position within the round, top to bottom"`
+	Status           TournamentMatchStatus `json:"status" example:"scheduled" description:"pending, scheduled, complete or bye"`
+	ScheduledAt      time.Time             `json:"scheduled_at" example:"2024-12-20T19:00:00Z" description:"Planned start of the match"`
+	EventID          *uint                 `json:"event_id,omitempty" example:"42" description:"Game event the match is played in, once both entries are known"`
+	Entry1ID         *uint                 `json:"entry1_id,omitempty" example:"3" description:"First entry"`
+	Entry2ID         *uint                 `json:"entry2_id,omitempty" example:"5" description:"Second entry"`
+	Score1           *int                  `json:"score1,omitempty" example:"2" description:"Score (or sets won) of the first entry"`
+	Score2           *int                  `json:"score2,omitempty" example:"1" description:"Score (or sets won) of the second entry"`
+	WinnerEntryID    *uint                 `json:"winner_entry_id,omitempty" example:"3" description:"Winner (empty for a draw or an empty bye)"`
+	NextMatchID      *uint                 `json:"next_match_id,omitempty" example:"11" description:"Match the winner moves on to"`
+	NextSlot         int                   `json:"next_slot,omitempty" example:"1" description:"Slot (1 or 2) the winner takes in the next match"`
+	LoserNextMatchID *uint                 `json:"loser_next_match_id,omitempty" example:"14" description:"Losers bracket match the loser drops to (double elimination)"`
+	LoserNextSlot    int                   `json:"loser_next_slot,omitempty" example:"2" description:"Slot (1 or 2) the loser takes in the losers bracket"`
+}
+
+// TournamentRoundResponse groups the matches of one round
+// @Description Tournament round response payload
+type TournamentRoundResponse struct {
+	Bracket TournamentBracket         `json:"bracket" example:"winners" description:"Bracket the round belongs to"`
+	Round   int                       `json:"round" example:"2" description:"Round within the bracket"`
+	Name    string                    `json:"name" example:"Semifinals" description:"Display name of the round"`
+	Matches []TournamentMatchResponse `json:"matches" description:"Matches of the round, top to bottom"`
+}
+
+// TournamentStandingResponse is an entry's record in a round robin
+// @Description Tournament standing response payload
+type TournamentStandingResponse struct {
+	Rank            int    `json:"rank" example:"1" description:"Position in the table"`
+	EntryID         uint   `json:"entry_id" example:"3" description:"Entry ID"`
+	Name            string `json:"name" example:"Smash Bros" description:"Player or team name"`
+	Played          int    `json:"played" example:"3" description:"Matches played"`
+	Wins            int    `json:"wins" example:"2" description:"Matches won"`
+	Draws           int    `json:"draws" example:"1" description:"Matches drawn"`
+	Losses          int    `json:"losses" example:"0" description:"Matches lost"`
+	ScoreFor        int    `json:"score_for" example:"7" description:"Goals, points or sets scored"`
+	ScoreAgainst    int    `json:"score_against" example:"3" description:"Goals, points or sets conceded"`
+	Points          int    `json:"points" example:"7" description:"3 per win, 1 per draw"`
+	ScoreDifference int    `json:"score_difference" example:"4" description:"Score for minus score against"`
+}
+
+// TournamentResponse represents a tournament and its bracket
+// @Description Tournament response payload
+type TournamentResponse struct {
+	ID                   uint                         `json:"id" example:"1" description:"Tournament ID"`
+	EventID              uint                         `json:"event_id" example:"1" description:"Event the tournament is held at"`
+	Title                string                       `json:"title" example:"Winter Padel Open" description:"Event title"`
+	Sport                string                       `json:"sport" example:"Padel" description:"Sport name"`
+	StartAt              time.Time                    `json:"start_at" example:"2024-12-20T18:00:00Z" description:"Start of the first round"`
+	Format               TournamentFormat             `json:"format" example:"single_elimination" description:"Tournament format"`
+	EntryType            TournamentEntryType          `json:"entry_type" example:"team" description:"player or team"`
+	TeamSize             int                          `json:"team_size" example:"2" description:"Players per entry"`
+	MaxEntries           *int                         `json:"max_entries,omitempty" example:"16" description:"Maximum number of entries"`
+	SeedBy               TournamentSeeding            `json:"seed_by" example:"rating" description:"How entries are seeded"`
+	MatchDurationMinutes int                          `json:"match_duration_minutes" example:"45" description:"Time slot per round of matches"`
+	Status               TournamentStatus             `json:"status" example:"in_progress" description:"registration, in_progress or complete"`
+	WinnerEntryID        *uint                        `json:"winner_entry_id,omitempty" example:"3" description:"Tournament winner once complete"`
+	Entries              []TournamentEntryResponse    `json:"entries" description:"Registered entries, by seed once seeded"`
+	Rounds               []TournamentRoundResponse    `json:"rounds" description:"Bracket rounds: winners bracket, losers bracket, then the final (empty until the tournament starts)"`
+	Standings            []TournamentStandingResponse `json:"standings,omitempty" description:"Round robin table"`
+	CreatedAt            time.Time                    `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt            time.Time                    `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
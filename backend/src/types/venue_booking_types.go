@@ -0,0 +1,95 @@
+package types
+
+import "time"
+
+// BookingConflictReason explains why a booking conflicts
+type BookingConflictReason string
+
+const (
+	// BookingConflictBooked means another event has booked the resource at an overlapping time
+	BookingConflictBooked BookingConflictReason = "booked"
+	// BookingConflictClosed means the event falls (partly) outside the venue's opening hours
+	BookingConflictClosed BookingConflictReason = "closed"
+)
+
+// CreateVenueResourceRequest represents the request for adding a bookable resource to a venue
+// @Description Venue resource creation request payload
+type CreateVenueResourceRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=100" example:"Court 3" description:"Name of the court, field or lane"`
+	Sport string `json:"sport" binding:"max=100" example:"Basketball" description:"Sport the resource is meant for"`
+}
+
+// UpdateVenueResourceRequest represents the request for updating a bookable resource
+// @Description Venue resource update request payload
+type UpdateVenueResourceRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=1,max=100" example:"Court 3" description:"Updated name"`
+	Sport *string `json:"sport,omitempty" binding:"omitempty,max=100" example:"Basketball" description:"Updated sport"`
+}
+
+// VenueResourceResponse represents a bookable resource of a venue
+// @Description Venue resource response payload
+type VenueResourceResponse struct {
+	ID      uint   `json:"id" example:"2" description:"Resource unique identifier"`
+	VenueID uint   `json:"venue_id" example:"1" description:"Venue the resource belongs to"`
+	Name    string `json:"name" example:"Court 3" description:"Name of the court, field or lane"`
+	Sport   string `json:"sport,omitempty" example:"Basketball" description:"Sport the resource is meant for"`
+}
+
+// OpeningHoursEntry is one opening interval on a day of the week, in the venue's time zone
+// @Description Opening hours interval
+type OpeningHoursEntry struct {
+	Weekday int    `json:"weekday" binding:"min=0,max=6" example:"1" description:"Day of the week (0 = Sunday)"`
+	Opens   string `json:"opens" binding:"required" example:"08:00" description:"Opening time (HH:MM)"`
+	Closes  string `json:"closes" binding:"required" example:"22:00" description:"Closing time (HH:MM, 24:00 for midnight)"`
+}
+
+// SetOpeningHoursRequest replaces the weekly opening hours of a venue
+// @Description Venue opening hours request payload
+type SetOpeningHoursRequest struct {
+	Timezone *string             `json:"timezone,omitempty" example:"Europe/Amsterdam" description:"IANA time zone the hours are in (unchanged when omitted)"`
+	Hours    []OpeningHoursEntry `json:"hours" binding:"max=70,dive" description:"Opening intervals; an empty list means the venue is always open"`
+}
+
+// TimeSlot is a time interval
+// @Description Time interval
+type TimeSlot struct {
+	StartAt time.Time `json:"start_at" example:"2024-12-20T08:00:00Z" description:"Start of the interval"`
+	EndAt   time.Time `json:"end_at" example:"2024-12-20T10:30:00Z" description:"End of the interval"`
+}
+
+// BookingConflictResponse describes why an event's booking of a resource conflicts
+// @Description Booking conflict
+type BookingConflictResponse struct {
+	Reason  BookingConflictReason `json:"reason" example:"booked" description:"booked (overlaps another event) or closed (outside opening hours)"`
+	EventID *uint                 `json:"event_id,omitempty" example:"42" description:"Overlapping event"`
+	Title   string                `json:"title,omitempty" example:"Friday Basketball Game" description:"Title of the overlapping event"`
+	StartAt *time.Time            `json:"start_at,omitempty" example:"2024-12-20T18:00:00Z" description:"Start of the overlapping event"`
+	EndAt   *time.Time            `json:"end_at,omitempty" example:"2024-12-20T20:00:00Z" description:"End of the overlapping event"`
+}
+
+// BookingConflictErrorResponse is returned when an event would double-book a resource
+// @Description Booking conflict error response
+type BookingConflictErrorResponse struct {
+	Error     string                    `json:"error" example:"Booking conflict" description:"Error type"`
+	Message   string                    `json:"message" example:"The resource is not available at this time" description:"Error message"`
+	Conflicts []BookingConflictResponse `json:"conflicts" description:"What the booking conflicts with"`
+}
+
+// ResourceAvailabilityResponse represents the availability of one resource on a day
+// @Description Resource availability
+type ResourceAvailabilityResponse struct {
+	ResourceID uint       `json:"resource_id" example:"2" description:"Resource unique identifier"`
+	Name       string     `json:"name" example:"Court 3" description:"Resource name"`
+	Open       []TimeSlot `json:"open" description:"When the venue is open on the day"`
+	Booked     []TimeSlot `json:"booked" description:"When the resource is booked by events"`
+	Free       []TimeSlot `json:"free" description:"Open and not booked intervals at least min_minutes long"`
+}
+
+// VenueAvailabilityResponse represents the availability of a venue's resources on a day
+// @Description Venue availability response payload
+type VenueAvailabilityResponse struct {
+	VenueID   uint                           `json:"venue_id" example:"1" description:"Venue unique identifier"`
+	Date      string                         `json:"date" example:"2024-12-20" description:"Day the availability is for, in the venue's time zone"`
+	Timezone  string                         `json:"timezone" example:"Europe/Amsterdam" description:"Time zone of the venue"`
+	Resources []ResourceAvailabilityResponse `json:"resources" description:"Availability per resource"`
+}
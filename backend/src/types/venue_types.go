@@ -0,0 +1,94 @@
+package types
+
+import "time"
+
+// VenueSurface is the playing surface of a venue
+type VenueSurface string
+
+const (
+	VenueSurfaceGrass      VenueSurface = "grass"
+	VenueSurfaceArtificial VenueSurface = "artificial"
+	VenueSurfaceHard       VenueSurface = "hard"
+	VenueSurfaceClay       VenueSurface = "clay"
+	VenueSurfaceWood       VenueSurface = "wood"
+	VenueSurfaceSand       VenueSurface = "sand"
+	VenueSurfaceWater      VenueSurface = "water"
+	VenueSurfaceIce        VenueSurface = "ice"
+	VenueSurfaceOther      VenueSurface = "other"
+)
+
+func (vs VenueSurface) IsValid() bool {
+	switch vs {
+	case VenueSurfaceGrass, VenueSurfaceArtificial, VenueSurfaceHard, VenueSurfaceClay, VenueSurfaceWood,
+		VenueSurfaceSand, VenueSurfaceWater, VenueSurfaceIce, VenueSurfaceOther:
+		return true
+	}
+	return false
+}
+
+// CreateVenueRequest represents the request for creating a venue
+// @Description Venue creation request payload
+type CreateVenueRequest struct {
+	Name      string       `json:"name" binding:"required,min=3,max=255" example:"Central Park Basketball Court 3" description:"Venue name"`
+	Address   string       `json:"address" binding:"max=500" example:"Central Park, New York, NY 10024" description:"Street address"`
+	Latitude  float64      `json:"latitude" binding:"min=-90,max=90" example:"40.7829" description:"Venue latitude"`
+	Longitude float64      `json:"longitude" binding:"min=-180,max=180" example:"-73.9654" description:"Venue longitude"`
+	Sports    []string     `json:"sports" binding:"max=20,dive,min=2,max=100" example:"Basketball" description:"Sports that can be played at the venue"`
+	Surface   VenueSurface `json:"surface,omitempty" example:"hard" description:"Playing surface: grass, artificial, hard, clay, wood, sand, water, ice or other"`
+	Indoor    bool         `json:"indoor" example:"false" description:"Whether the venue is indoors"`
+	Lit       bool         `json:"lit" example:"true" description:"Whether the venue has lighting for evening play"`
+	Timezone  string       `json:"timezone,omitempty" example:"Europe/Amsterdam" description:"IANA time zone of the venue's opening hours (defaults to UTC)"`
+}
+
+// UpdateVenueRequest represents the request for updating a venue
+// @Description Venue update request payload
+type UpdateVenueRequest struct {
+	Name      *string       `json:"name,omitempty" binding:"omitempty,min=3,max=255" example:"Central Park Basketball Court 3" description:"Updated venue name"`
+	Address   *string       `json:"address,omitempty" binding:"omitempty,max=500" example:"Central Park, New York, NY 10024" description:"Updated street address"`
+	Latitude  *float64      `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90" example:"40.7829" description:"Updated latitude"`
+	Longitude *float64      `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180" example:"-73.9654" description:"Updated longitude"`
+	Sports    *[]string     `json:"sports,omitempty" binding:"omitempty,max=20,dive,min=2,max=100" example:"Basketball" description:"Replaces the venue's sports"`
+	Surface   *VenueSurface `json:"surface,omitempty" example:"hard" description:"Updated playing surface (empty clears it)"`
+	Indoor    *bool         `json:"indoor,omitempty" example:"false" description:"Updated indoor flag"`
+	Lit       *bool         `json:"lit,omitempty" example:"true" description:"Updated lighting flag"`
+	Timezone  *string       `json:"timezone,omitempty" example:"Europe/Amsterdam" description:"Updated time zone"`
+}
+
+// VenueResponse represents a venue
+// @Description Venue response payload
+type VenueResponse struct {
+	ID             uint                    `json:"id" example:"1" description:"Venue unique identifier"`
+	CreatedByID    *uint                   `json:"created_by_id,omitempty" example:"12345" description:"User who added the venue (empty for venues merged from existing events)"`
+	Name           string                  `json:"name" example:"Central Park Basketball Court 3" description:"Venue name"`
+	Address        string                  `json:"address" example:"Central Park, New York, NY 10024" description:"Street address"`
+	Latitude       float64                 `json:"latitude" example:"40.7829" description:"Venue latitude"`
+	Longitude      float64                 `json:"longitude" example:"-73.9654" description:"Venue longitude"`
+	Sports         []string                `json:"sports" example:"Basketball" description:"Sports that can be played at the venue"`
+	Surface        VenueSurface            `json:"surface,omitempty" example:"hard" description:"Playing surface"`
+	Indoor         bool                    `json:"indoor" example:"false" description:"Whether the venue is indoors"`
+	Lit            bool                    `json:"lit" example:"true" description:"Whether the venue has lighting for evening play"`
+	Timezone       string                  `json:"timezone" example:"Europe/Amsterdam" description:"Time zone of the opening hours"`
+	OpeningHours   []OpeningHoursEntry     `json:"opening_hours" description:"Weekly opening hours (empty when always open)"`
+	Resources      []VenueResourceResponse `json:"resources" description:"Bookable courts, fields or lanes"`
+	Photos         []VenuePhotoResponse    `json:"photos" description:"Photos of the venue"`
+	UpcomingEvents int                     `json:"upcoming_events" example:"4" description:"Number of upcoming public events at the venue"`
+	DistanceKm     *float64                `json:"distance_km,omitempty" example:"1.27" description:"Great-circle distance from the lat/lng given in the query, in kilometers"`
+	CreatedAt      time.Time               `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Creation timestamp"`
+	UpdatedAt      time.Time               `json:"updated_at" example:"2024-01-20T14:45:00Z" description:"Last update timestamp"`
+}
+
+// VenueDetailResponse represents a venue page with its upcoming events
+// @Description Venue page response payload
+type VenueDetailResponse struct {
+	VenueResponse
+	Events []EventWithOrganizerResponse `json:"events" description:"Upcoming events at the venue visible to the current user, soonest first"`
+}
+
+// VenuePhotoResponse represents a photo of a venue
+// @Description Venue photo response payload
+type VenuePhotoResponse struct {
+	ID           uint      `json:"id" example:"7" description:"Photo unique identifier"`
+	URL          string    `json:"url" example:"/api/venues/1/photos/7" description:"URL of the image"`
+	UploadedByID uint      `json:"uploaded_by_id" example:"12345" description:"User who uploaded the photo"`
+	CreatedAt    time.Time `json:"created_at" example:"2024-01-15T10:30:00Z" description:"Upload timestamp"`
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"math"
+	"strconv"
+)
+
+// EarthRadiusKm is the mean radius of the Earth
+const EarthRadiusKm = 6371.0088
+
+// GeoBounds is a latitude/longitude box enclosing a circle on the Earth's surface.
+// When the circle crosses the ±180° meridian MinLng is greater than MaxLng and the box
+// covers longitudes >= MinLng or <= MaxLng.
+type GeoBounds struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+// CrossesAntimeridian reports whether the box wraps around the ±180° meridian
+func (b GeoBounds) CrossesAntimeridian() bool {
+	return b.MinLng > b.MaxLng
+}
+
+// HaversineKm returns the great-circle distance between two points in kilometers
+func HaversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+	a := math.Pow(math.Sin(dLat/2), 2) + math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(math.Min(1, a)))
+}
+
+// HaversineSQL returns a SQL expression computing the great-circle distance in kilometers
+// between the given latitude/longitude columns and a point. It takes three arguments:
+// the point's latitude, latitude again and longitude. Differences in longitude wrap
+// naturally, so it is correct across the ±180° meridian.
+func HaversineSQL(latColumn, lngColumn string) string {
+	return "(2 * " + formatFloat(EarthRadiusKm) + " * ASIN(SQRT(LEAST(1, " +
+		"POWER(SIN(RADIANS(" + latColumn + " - ?) / 2), 2) + " +
+		"COS(RADIANS(?)) * COS(RADIANS(" + latColumn + ")) * POWER(SIN(RADIANS(" + lngColumn + " - ?) / 2), 2)))))"
+}
+
+// BoundingBox returns the box enclosing every point within radiusKm of the center, used to
+// pre-filter rows before the exact distance check. Near a pole the box spans all longitudes
+// and extends to the pole; across the ±180° meridian it wraps (see GeoBounds).
+func BoundingBox(lat, lng, radiusKm float64) GeoBounds {
+	angular := radiusKm / EarthRadiusKm
+	minLat := lat - toDegrees(angular)
+	maxLat := lat + toDegrees(angular)
+
+	// The circle contains a pole (or the whole planet): every longitude is in range
+	if minLat <= -90 || maxLat >= 90 || angular >= math.Pi {
+		return GeoBounds{MinLat: math.Max(minLat, -90), MaxLat: math.Min(maxLat, 90), MinLng: -180, MaxLng: 180}
+	}
+
+	deltaLng := toDegrees(math.Asin(math.Sin(angular) / math.Cos(toRadians(lat))))
+	minLng := NormalizeLongitude(lng - deltaLng)
+	maxLng := NormalizeLongitude(lng + deltaLng)
+	if deltaLng >= 180 {
+		minLng, maxLng = -180, 180
+	}
+
+	return GeoBounds{MinLat: minLat, MaxLat: maxLat, MinLng: minLng, MaxLng: maxLng}
+}
+
+// NormalizeLongitude wraps a longitude into [-180, 180]
+func NormalizeLongitude(lng float64) float64 {
+	if lng >= -180 && lng <= 180 {
+		return lng
+	}
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+	return lng - 180
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ICalEvent is the calendar view of an activity used to render a VEVENT
+type ICalEvent struct {
+	UID          string
+	Title        string
+	Description  string
+	LocationName string
+	Latitude     *float64
+	Longitude    *float64
+	StartAt      time.Time
+	EndAt        time.Time
+	Cancelled    bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+const icalTimeFormat = "20060102T150405Z"
+
+// BuildICalendar renders events as an RFC 5545 VCALENDAR document.
+// Lines are CRLF-terminated and folded at 75 octets as required by the spec.
+func BuildICalendar(calendarName string, events []ICalEvent) string {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//Link2Sport//Activities//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	if calendarName != "" {
+		writeICalLine(&b, "X-WR-CALNAME:"+EscapeICalText(calendarName))
+	}
+
+	stamp := time.Now().UTC().Format(icalTimeFormat)
+	for _, e := range events {
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:"+e.UID)
+		writeICalLine(&b, "DTSTAMP:"+stamp)
+		writeICalLine(&b, "DTSTART:"+e.StartAt.UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "DTEND:"+e.EndAt.UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "SUMMARY:"+EscapeICalText(e.Title))
+		if e.Description != "" {
+			writeICalLine(&b, "DESCRIPTION:"+EscapeICalText(e.Description))
+		}
+		if e.LocationName != "" {
+			writeICalLine(&b, "LOCATION:"+EscapeICalText(e.LocationName))
+		}
+		if e.Latitude != nil && e.Longitude != nil {
+			writeICalLine(&b, fmt.Sprintf("GEO:%.6f;%.6f", *e.Latitude, *e.Longitude))
+		}
+		if e.Cancelled {
+			writeICalLine(&b, "STATUS:CANCELLED")
+		} else {
+			writeICalLine(&b, "STATUS:CONFIRMED")
+		}
+		writeICalLine(&b, "CREATED:"+e.CreatedAt.UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "LAST-MODIFIED:"+e.UpdatedAt.UTC().Format(icalTimeFormat))
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// EscapeICalText escapes a TEXT property value (backslash, semicolon, comma and newlines)
+func EscapeICalText(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	s = strings.ReplaceAll(s, "\r", "\\n")
+	return s
+}
+
+// writeICalLine writes a content line, folding it so no line exceeds 75 octets.
+// Continuation lines start with a space, and folds never split a multi-byte UTF-8 character.
+func writeICalLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isUTF8Start(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isUTF8Start(c byte) bool {
+	return c&0xC0 != 0x80
+}
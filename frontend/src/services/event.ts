@@ -254,7 +254,7 @@ export class EventService {
     eventId: string,
   ): Promise<EventParticipant[]> {
     const response = await this.makeAuthenticatedRequest(
-      `${API_BASE_URL}/api/events/${eventId}/participants`,
+      `${API_BASE_URL}/api/events/${eventId}/participants?status=accepted`,
       {
         method: "GET",
       },
@@ -269,7 +269,7 @@ export class EventService {
       const user = participant.User || participant.user || {};
       const username = participant.username || user.username || "";
       const displayName =
-        participant.name || user.display_name || user.username || username || "";
+        participant.name || participant.display_name || user.display_name || user.username || username || "";
       const avatarPath: string | undefined =
         participant.avatar || participant.avatar_url || user.avatar_url;
       const avatar = avatarPath
@@ -62,13 +62,29 @@ export class PostService {
     };
   }
 
+  // Post images are only served with the auth header, so they are fetched and shown from object URLs
+  private static async loadImage(url?: string): Promise<string | undefined> {
+    if (!url) return undefined;
+    const token = this.validateAuthentication();
+    const response = await fetch(url, {
+      headers: { Authorization: `Bearer ${token}` },
+      credentials: "include",
+    });
+    if (!response.ok) return undefined;
+    return URL.createObjectURL(await response.blob());
+  }
+
+  private static async withImage(post: Post): Promise<Post> {
+    return { ...post, image_url: await this.loadImage(post.image_url) };
+  }
+
   static async createPost(payload: CreatePostData): Promise<Post> {
     const response = await this.makeRequest(`${API_BASE_URL}/api/posts/`, {
       method: "POST",
       headers: this.createJsonHeaders(this.validateAuthentication()),
       body: JSON.stringify(payload),
     });
-    return this.mapPostResponse(response);
+    return this.withImage(this.mapPostResponse(response));
   }
 
   static async uploadPostImage(postId: string, file: File): Promise<string> {
@@ -84,7 +100,8 @@ export class PostService {
       credentials: "include",
     });
     const data = await this.handleResponse(response);
-    return data?.image_url ? `${API_BASE_URL}${data.image_url}` : `${API_BASE_URL}/api/posts/${postId}/image`;
+    const imageURL = data?.image_url ? `${API_BASE_URL}${data.image_url}` : `${API_BASE_URL}/api/posts/${postId}/image`;
+    return (await this.loadImage(imageURL)) ?? imageURL;
   }
 
   static async getPosts(limit = 20, offset = 0, scope: "all" | "following" = "all"): Promise<Post[]> {
@@ -93,7 +110,7 @@ export class PostService {
       { method: "GET" },
     );
     if (!Array.isArray(response)) return [];
-    return response.map((p: any) => this.mapPostResponse(p));
+    return Promise.all(response.map((p: any) => this.withImage(this.mapPostResponse(p))));
   }
 
   static async getMyPosts(): Promise<Post[]> {
@@ -101,7 +118,7 @@ export class PostService {
       method: "GET",
     });
     if (!Array.isArray(response)) return [];
-    return response.map((p: any) => this.mapPostResponse(p));
+    return Promise.all(response.map((p: any) => this.withImage(this.mapPostResponse(p))));
   }
 
   static async getUserPostsById(userId: string): Promise<Post[]> {
@@ -109,14 +126,14 @@ export class PostService {
       method: "GET",
     });
     if (!Array.isArray(response)) return [];
-    return response.map((p: any) => this.mapPostResponse(p));
+    return Promise.all(response.map((p: any) => this.withImage(this.mapPostResponse(p))));
   }
 
   static async getPost(postId: string): Promise<Post> {
     const response = await this.makeRequest(`${API_BASE_URL}/api/posts/${postId}`, {
       method: "GET",
     });
-    return this.mapPostResponse(response);
+    return this.withImage(this.mapPostResponse(response));
   }
 
   static async updatePost(postId: string, payload: UpdatePostData): Promise<Post> {
@@ -125,7 +142,7 @@ export class PostService {
       headers: this.createJsonHeaders(this.validateAuthentication()),
       body: JSON.stringify(payload),
     });
-    return this.mapPostResponse(response);
+    return this.withImage(this.mapPostResponse(response));
   }
 
   static async deletePost(postId: string): Promise<void> {